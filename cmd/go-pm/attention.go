@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// attentionIcons marks each reason a work item needs attention in text output
+var attentionIcons = map[pm.AttentionReason]string{
+	pm.AttentionOverdue: "⏰",
+	pm.AttentionStale:   "💤",
+	pm.AttentionInvalid: "❌",
+}
+
+// newListAttentionCommand creates the list attention command for morning triage
+func newListAttentionCommand(manager *pm.DefaultManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attention",
+		Short: "List work items that are overdue, stale, or fail validation",
+		Long: `List every backlog work item that needs attention, tagged with the reasons
+why: overdue (past its due date), stale (in progress but not updated within
+phase_timeout_days), or invalid (validation errors, as reported by
+'go-pm validate'). Validation warnings don't count.
+
+--output json prints an array of {item, reasons, problems} objects; jsonl
+prints one object per line.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if outputFormat != "text" && outputFormat != "json" && outputFormat != "jsonl" {
+				return fmt.Errorf("unsupported output format %q (supported: text, json, jsonl)", outputFormat)
+			}
+
+			items, err := manager.ListNeedingAttention(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list work items: %w", err)
+			}
+
+			switch outputFormat {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(items)
+			case "jsonl":
+				encoder := json.NewEncoder(os.Stdout)
+				for _, item := range items {
+					if err := encoder.Encode(item); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			fmt.Println("Work items needing attention:")
+			if len(items) == 0 {
+				fmt.Println("  Nothing needs attention")
+				return nil
+			}
+			for _, attention := range items {
+				fmt.Printf("  📋 %s", attention.Item.Name)
+				if attention.Item.Title != "" {
+					fmt.Printf(" - %s", attention.Item.Title)
+				}
+				fmt.Printf(" [%s]\n", colors().Status(attention.Item.Status))
+				for _, reason := range attention.Reasons {
+					switch reason {
+					case pm.AttentionOverdue:
+						fmt.Printf("     %s %s\n", attentionIcons[reason], colors().Red("overdue since "+attention.Item.DueDate.Format("2006-01-02")))
+					case pm.AttentionStale:
+						fmt.Printf("     %s %s\n", attentionIcons[reason], colors().Yellow("stale, last updated "+attention.Item.UpdatedAt.Format("2006-01-02")))
+					case pm.AttentionInvalid:
+						for _, problem := range attention.Problems {
+							fmt.Printf("     %s %s\n", attentionIcons[reason], problem)
+						}
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newBundleCommand creates the bundle command that packages a work item into a zip
+func newBundleCommand(manager *pm.DefaultManager) *cobra.Command {
+	bundleCmd := &cobra.Command{
+		Use:   "bundle [name] [out.zip]",
+		Short: "Package a work item into a zip to share outside the repository",
+		Long: `Package a work item's directory, with its README, notes, postmortem and
+history, into a self-contained zip for a collaborator who doesn't have the
+repository. The zip is written to <name>.zip unless another path is given.
+
+Unpack a bundle into the backlog with 'go-pm bundle import [in.zip]'.`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			out := name + ".zip"
+			if len(args) == 2 {
+				out = args[1]
+			}
+
+			file, err := os.Create(out)
+			if err != nil {
+				return fmt.Errorf("failed to create bundle: %w", err)
+			}
+			if err := manager.ExportBundle(cmd.Context(), name, file); err != nil {
+				_ = file.Close()
+				_ = os.Remove(out)
+				return fmt.Errorf("failed to bundle work item: %w", err)
+			}
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("failed to write bundle: %w", err)
+			}
+
+			fmt.Printf("📦 Bundled '%s' into %s\n", name, out)
+			return nil
+		},
+	}
+
+	importCmd := &cobra.Command{
+		Use:   "import [in.zip]",
+		Short: "Unpack a work item bundle into the backlog",
+		Long: `Unpack a zip written by 'go-pm bundle' into the backlog.
+
+When a work item with the same name already exists the import fails; import
+it under another name with --as, or replace the existing item with --overwrite.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			as, _ := cmd.Flags().GetString("as")
+			overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open bundle: %w", err)
+			}
+			defer func() { _ = file.Close() }()
+			info, err := file.Stat()
+			if err != nil {
+				return fmt.Errorf("failed to open bundle: %w", err)
+			}
+
+			item, err := manager.ImportBundle(cmd.Context(), file, info.Size(), pm.BundleImportOptions{Name: as, Overwrite: overwrite})
+			if err != nil {
+				return fmt.Errorf("failed to import bundle: %w", err)
+			}
+			fmt.Printf("📥 Imported '%s' (%s)\n", item.Name, item.Status)
+			fmt.Printf("📁 Directory: %s\n", item.Path)
+			return nil
+		},
+	}
+	importCmd.Flags().String("as", "", "Import the work item under this name instead of the bundled one")
+	importCmd.Flags().Bool("overwrite", false, "Replace an existing work item with the same name")
+	bundleCmd.AddCommand(importCmd)
+
+	return bundleCmd
+}
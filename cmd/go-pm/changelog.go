@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newChangelogCommand creates the changelog command that writes release notes from completed work items
+func newChangelogCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changelog",
+		Short: "Print markdown release notes for work items completed since a date or git tag",
+		Long: `Print a markdown changelog of the work items, in the backlog or archived,
+completed since --since, grouped into features, bug fixes and experiments,
+with each item's title and overview summary.
+
+--since takes a date (YYYY-MM-DD) or a git tag, branch or commit, whose commit
+date is used.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			sinceFlag, _ := cmd.Flags().GetString("since")
+			if sinceFlag == "" {
+				return fmt.Errorf("--since is required, e.g. --since v1.2.0 or --since 2024-05-01")
+			}
+
+			since, err := time.ParseInLocation("2006-01-02", sinceFlag, time.Local)
+			if err != nil {
+				if since, err = manager.ResolveRefDate(ctx, sinceFlag); err != nil {
+					return fmt.Errorf("--since is neither a YYYY-MM-DD date nor a git ref: %w", err)
+				}
+			}
+
+			changelog, err := manager.GenerateChangelog(ctx, since)
+			if err != nil {
+				return fmt.Errorf("failed to generate changelog: %w", err)
+			}
+			fmt.Print(changelog)
+			return nil
+		},
+	}
+	cmd.Flags().String("since", "", "Date (YYYY-MM-DD) or git tag to list completions after")
+
+	return cmd
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+)
+
+// colors returns the colorizer for stdout based on --color.
+// JSON and JSON Lines output never contain color codes.
+func colors() *pm.Colorizer {
+	if outputFormat == "json" || outputFormat == "jsonl" {
+		return pm.NewColorizer(pm.ColorNever, os.Stdout)
+	}
+	mode, err := pm.ParseColorMode(colorMode)
+	if err != nil {
+		mode = pm.ColorAuto
+	}
+	return pm.NewColorizer(mode, os.Stdout)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// statusCompletions are the status values accepted by `status update`
+var statusCompletions = []string{"proposed", "discovery", "planning", "execution", "cleanup", "review", "completed"}
+
+// phaseCompletions are the phase values accepted by `phase set`
+var phaseCompletions = []string{"discovery", "planning", "execution", "cleanup"}
+
+// typeCompletions are the work item types accepted by `list --type`
+var typeCompletions = []string{"feature", "bug", "experiment"}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate shell completion script",
+	Long: `Generate a shell completion script for go-pm.
+
+Work item names, statuses and phases are completed from the current backlog.
+
+  bash:       source <(go-pm completion bash)
+  zsh:        go-pm completion zsh > "${fpath[1]}/_go-pm"
+  fish:       go-pm completion fish | source
+  powershell: go-pm completion powershell | Out-String | Invoke-Expression`,
+	Args:                  cobra.ExactArgs(1),
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	DisableFlagsInUseLine: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch args[0] {
+		case "bash":
+			return rootCmd.GenBashCompletionV2(os.Stdout, true)
+		case "zsh":
+			return rootCmd.GenZshCompletion(os.Stdout)
+		case "fish":
+			return rootCmd.GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
+		default:
+			return fmt.Errorf("unsupported shell: %s. Valid shells: bash, zsh, fish, powershell", args[0])
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+// completeWorkItemNames completes the first positional argument with existing work item names
+func completeWorkItemNames(manager pm.Manager) cobra.CompletionFunc {
+	return completeWorkItemNameAnd(manager, nil)
+}
+
+// completeWorkItemNameAnd completes the first positional argument with existing work item
+// names and the second with the given fixed values
+func completeWorkItemNameAnd(manager pm.Manager, values []string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		switch len(args) {
+		case 0:
+			if err := loadConfig(cmd); err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			items, err := manager.ListWorkItems(cmd.Context(), pm.ListFilter{})
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			names := make([]cobra.Completion, 0, len(items))
+			for _, item := range items {
+				names = append(names, item.Name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		case 1:
+			return values, cobra.ShellCompDirectiveNoFileComp
+		default:
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+}
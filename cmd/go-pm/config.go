@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+// configFlags maps persistent flags onto the config keys they override
+var configFlags = map[string]string{
+	"enable_git":            "enable-git",
+	"auto_detect_repo_root": "auto-detect-repo-root",
+	"list_concurrency":      "concurrency",
+}
+
+// effectiveConfigOutput is the structured form of `config show`
+type effectiveConfigOutput struct {
+	ConfigFile string           `json:"config_file" yaml:"config_file"`
+	Settings   []pm.ConfigValue `json:"settings" yaml:"settings"`
+}
+
+// newConfigCommand creates the config command for inspecting the effective configuration
+func newConfigCommand(config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect go-pm configuration",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "show",
+		Short: "Show the effective configuration and where each value comes from",
+		Long: `Show every config setting as resolved from defaults, the config file,
+PM_* environment variables and CLI flags, including the computed backlog and
+completed directory paths. Each value is annotated with its source
+(default, file, env or flag). Use --output json or --output yaml for
+machine-readable output. Secrets such as api_token are redacted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings := pm.EffectiveConfig(*config)
+			for i, setting := range settings {
+				if flag, ok := configFlags[setting.Key]; ok && cmd.Flags().Changed(flag) {
+					settings[i].Source = "flag"
+				}
+			}
+			out := effectiveConfigOutput{ConfigFile: pm.ConfigFileUsed(), Settings: settings}
+
+			switch outputFormat {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(out)
+			case "yaml":
+				return yaml.NewEncoder(os.Stdout).Encode(out)
+			case "text":
+			default:
+				return fmt.Errorf("unsupported output format %q (supported: text, json, yaml)", outputFormat)
+			}
+
+			configFile := out.ConfigFile
+			if configFile == "" {
+				configFile = "(none found)"
+			}
+			fmt.Printf("📄 Config file: %s\n\n", configFile)
+			for _, setting := range settings {
+				fmt.Printf("%-22s %-40v %s\n", setting.Key, setting.Value, colors().Bold("("+setting.Source+")"))
+			}
+			return nil
+		},
+	})
+
+	return cmd
+}
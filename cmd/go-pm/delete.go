@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newDeleteCommand creates the delete command that permanently removes a work item
+func newDeleteCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Permanently delete a work item and, with git enabled, its branches",
+		Long: `Permanently delete a work item's directory from the backlog or the completed
+directory. With enable_git set, its item branch and any per-phase branches are
+deleted too.
+
+You are asked to confirm first. Only COMPLETED work items can be deleted;
+--force skips the confirmation and deletes unfinished work items as well.
+To remove a work item in a way that can be undone, use 'go-pm trash [name]'.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			force, _ := cmd.Flags().GetBool("force")
+
+			if !force {
+				fmt.Printf("Permanently delete '%s'? This can't be undone [y/N]: ", name)
+				answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+					fmt.Println("Cancelled")
+					return nil
+				}
+			}
+
+			if err := manager.DeleteWorkItem(cmd.Context(), name, force); err != nil {
+				return fmt.Errorf("failed to delete work item: %w", err)
+			}
+			fmt.Printf("❌ Deleted '%s'\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().Bool("force", false, "Skip the confirmation and delete work items that aren't COMPLETED")
+
+	return cmd
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newDependsCommand creates the depends command group for work item dependencies
+func newDependsCommand(manager *pm.DefaultManager) *cobra.Command {
+	dependsCmd := &cobra.Command{
+		Use:   "depends",
+		Short: "Manage work item dependencies",
+		Long: `Manage the work items a work item depends on, kept on its "## Depends On:"
+line. A PROPOSED work item can't advance into discovery until every dependency
+is COMPLETED; 'go-pm list blocked' shows the work items still waiting.`,
+	}
+
+	dependsCmd.AddCommand(&cobra.Command{
+		Use:               "add [name] [dependency]",
+		Short:             "Make a work item depend on another",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := manager.AddDependency(cmd.Context(), args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to add dependency: %w", err)
+			}
+
+			fmt.Printf("⛓️  '%s' now depends on '%s'\n", args[0], args[1])
+			return nil
+		},
+	})
+
+	dependsCmd.AddCommand(&cobra.Command{
+		Use:               "remove [name] [dependency]",
+		Short:             "Remove a dependency from a work item",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := manager.RemoveDependency(cmd.Context(), args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to remove dependency: %w", err)
+			}
+
+			fmt.Printf("⛓️  '%s' no longer depends on '%s'\n", args[0], args[1])
+			return nil
+		},
+	})
+
+	return dependsCmd
+}
+
+// newListBlockedCommand creates the list blocked command for work items waiting on dependencies
+func newListBlockedCommand(manager *pm.DefaultManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "blocked",
+		Short: "List work items with dependencies that aren't completed",
+		Long: `List every unfinished work item with dependencies that aren't COMPLETED yet,
+with the status of each. A dependency that doesn't exist counts as unfinished.
+
+--output json prints an array of {item, blocked_by} objects.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("unsupported output format %q (supported: text, json)", outputFormat)
+			}
+
+			blocked, err := manager.ListBlocked(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list work items: %w", err)
+			}
+
+			if outputFormat == "json" {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(blocked)
+			}
+
+			fmt.Println("Blocked work items:")
+			if len(blocked) == 0 {
+				fmt.Println("  No blocked work items found")
+				return nil
+			}
+			for _, b := range blocked {
+				fmt.Printf("  📋 %s", b.Item.Name)
+				if b.Item.Title != "" {
+					fmt.Printf(" - %s", b.Item.Title)
+				}
+				fmt.Printf(" [%s]\n", colors().Status(b.Item.Status))
+				for _, dependency := range b.BlockedBy {
+					fmt.Printf("     ⛓️  waiting on %s\n", dependency)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newDiffCommand creates the diff command that compares two work items' tasks
+func newDiffCommand(manager *pm.DefaultManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [nameA] [nameB]",
+		Short: "Compare two work items' status, phase, progress and tasks",
+		Long: `Compare two work items, such as an experiment and its clone, to audit how
+their checklists have drifted. Tasks are matched by phase and description:
+"+" marks a task only in nameB, "-" a task only in nameA, and "~" a task
+completed in only one of them.
+
+--output json prints the differences as a JSON object instead.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			diff, err := manager.DiffWorkItems(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to diff work items: %w", err)
+			}
+
+			switch outputFormat {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(diff)
+			case "text":
+				renderDiff(os.Stdout, diff)
+				return nil
+			default:
+				return fmt.Errorf("unsupported output format %q (supported: text, json)", outputFormat)
+			}
+		},
+	}
+}
+
+// renderDiff writes a readable diff of two work items to w, with tasks grouped by phase
+func renderDiff(w io.Writer, diff *pm.WorkItemDiff) {
+	if diff.Empty() {
+		_, _ = fmt.Fprintf(w, "✅ '%s' and '%s' have the same status, phase, progress and tasks\n", diff.A, diff.B)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "🔍 Comparing '%s' with '%s'\n", diff.A, diff.B)
+	for _, field := range diff.Fields {
+		_, _ = fmt.Fprintf(w, "  %s: %s → %s\n", field.Field, field.A, field.B)
+	}
+
+	var phase pm.WorkPhase
+	for _, task := range diff.Tasks {
+		if task.Phase != phase {
+			phase = task.Phase
+			_, _ = fmt.Fprintf(w, "\n%s tasks:\n", phase)
+		}
+		switch task.Change {
+		case pm.TaskAdded:
+			_, _ = fmt.Fprintln(w, colors().Green("  + "+task.Description))
+		case pm.TaskRemoved:
+			_, _ = fmt.Fprintln(w, colors().Red("  - "+task.Description))
+		case pm.TaskCompletionChanged:
+			_, _ = fmt.Fprintf(w, "%s (%s → %s)\n", colors().Yellow("  ~ "+task.Description), checkbox(task.CompletedA), checkbox(task.CompletedB))
+		}
+	}
+}
+
+// checkbox renders a task's completion as a markdown checkbox
+func checkbox(completed bool) string {
+	if completed {
+		return "[x]"
+	}
+	return "[ ]"
+}
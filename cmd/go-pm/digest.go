@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newDigestCommand creates the digest command summarizing items that need follow-up
+func newDigestCommand(manager *pm.DefaultManager, config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "digest",
+		Short: "Print or post a markdown digest of stale, overdue and blocked work items",
+		Long: `Compose a markdown digest of work items that need follow-up.
+
+The digest is written to stdout (pipe it to mail) or, with --post, sent to the
+configured webhook_url (PM_WEBHOOK_URL).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sectionNames, _ := cmd.Flags().GetStringSlice("section")
+			post, _ := cmd.Flags().GetBool("post")
+
+			var sections []pm.DigestSection
+			for _, name := range sectionNames {
+				section := pm.DigestSection(name)
+				switch section {
+				case pm.DigestSectionStale, pm.DigestSectionOverdue, pm.DigestSectionBlocked:
+					sections = append(sections, section)
+				default:
+					return fmt.Errorf("invalid section: %s. Valid sections: stale, overdue, blocked", name)
+				}
+			}
+
+			digest, err := manager.BuildDigest(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to build digest: %w", err)
+			}
+			report := digest.Markdown(sections)
+
+			if !post {
+				fmt.Print(report)
+				return nil
+			}
+
+			if config.WebhookURL == "" {
+				return fmt.Errorf("no webhook configured; set webhook_url or PM_WEBHOOK_URL")
+			}
+			if err := pm.NewWebhookNotifier(config.WebhookURL, nil).Send(cmd.Context(), report); err != nil {
+				return fmt.Errorf("failed to post digest: %w", err)
+			}
+
+			fmt.Println("✅ Digest posted to webhook")
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice("section", []string{"stale", "overdue", "blocked"}, "Sections to include (stale, overdue, blocked)")
+	cmd.Flags().Bool("post", false, "Post the digest to the configured webhook instead of printing it")
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+)
+
+// errorPayload is the structured form of a CLI failure for programmatic consumers
+type errorPayload struct {
+	Error errorDetail `json:"error"`
+}
+
+// errorDetail describes a failure derived from the pm package's typed errors
+type errorDetail struct {
+	Type    string `json:"type"`
+	Op      string `json:"op,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// jsonErrorsEnabled reports whether failures should be printed as JSON,
+// either because of `--output json`/`--output jsonl` or the PM_JSON_ERRORS environment variable
+func jsonErrorsEnabled(outputFormat string) bool {
+	if outputFormat == "json" || outputFormat == "jsonl" {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv("PM_JSON_ERRORS"))
+	return enabled
+}
+
+// newErrorDetail maps an error onto its structured representation
+func newErrorDetail(err error) errorDetail {
+	detail := errorDetail{Type: "error", Message: err.Error()}
+
+	var validationErr *pm.ValidationError
+	var phaseErr *pm.PhaseError
+	var completedErr *pm.AlreadyCompletedError
+	var workItemErr *pm.WorkItemError
+
+	switch {
+	case errors.As(err, &validationErr):
+		detail.Type = "validation"
+		detail.Field = validationErr.Field
+	case errors.As(err, &phaseErr):
+		detail.Type = "phase"
+		detail.Op = "advance_phase"
+		detail.Name = phaseErr.WorkItem
+	case errors.As(err, &completedErr):
+		detail.Type = "phase"
+		detail.Op = "advance_phase"
+		detail.Name = completedErr.WorkItem
+	case errors.As(err, &workItemErr):
+		detail.Type = "work_item"
+		detail.Op = workItemErr.Op
+		detail.Name = workItemErr.Name
+	}
+
+	return detail
+}
+
+// writeJSONError writes the structured form of err to w
+func writeJSONError(w io.Writer, err error) {
+	data, marshalErr := json.Marshal(errorPayload{Error: newErrorDetail(err)})
+	if marshalErr != nil {
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+	_, _ = fmt.Fprintln(w, string(data))
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newEstimateCommand creates the estimate command group for work item effort estimates
+func newEstimateCommand(manager *pm.DefaultManager) *cobra.Command {
+	estimateCmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Manage work item effort estimates",
+	}
+
+	estimateCmd.AddCommand(&cobra.Command{
+		Use:   "set [name] [hours]",
+		Short: "Set a work item's total effort estimate in hours",
+		Long: `Set a work item's total effort estimate, kept as its "## Estimate:" heading.
+'go-pm progress show' compares it against the time recorded in the item's
+phase history.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hours, err := strconv.ParseFloat(strings.TrimSuffix(args[1], "h"), 64)
+			if err != nil {
+				return fmt.Errorf("invalid hours: %s", args[1])
+			}
+			if err := manager.SetEstimate(cmd.Context(), args[0], hours); err != nil {
+				return fmt.Errorf("failed to set estimate: %w", err)
+			}
+
+			fmt.Printf("⏱️  Set '%s' estimate to: %sh\n", args[0], strconv.FormatFloat(hours, 'f', -1, 64))
+			return nil
+		},
+	})
+
+	return estimateCmd
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newExperimentCommand creates the experiment command group for experiment-only workflows
+func newExperimentCommand(manager *pm.DefaultManager) *cobra.Command {
+	experimentCmd := &cobra.Command{
+		Use:   "experiment",
+		Short: "Experiment workflows",
+	}
+
+	concludeCmd := &cobra.Command{
+		Use:   "conclude [name] [outcome]",
+		Short: "Record an experiment's outcome (succeeded, failed, inconclusive)",
+		Long: `Record an experiment's outcome as a "## Outcome:" heading.
+
+--note appends the reasoning to the experiment's notes. A succeeded experiment
+can graduate to a linked feature work item with --graduate-as <feature-name>.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNameAnd(manager, []string{string(pm.OutcomeSucceeded), string(pm.OutcomeFailed), string(pm.OutcomeInconclusive)}),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			note, _ := cmd.Flags().GetString("note")
+			graduateAs, _ := cmd.Flags().GetString("graduate-as")
+			outcome := pm.Outcome(strings.ToLower(args[1]))
+
+			if err := manager.ConcludeExperiment(ctx, args[0], outcome, note); err != nil {
+				return fmt.Errorf("failed to conclude experiment: %w", err)
+			}
+			fmt.Printf("🧪 Concluded '%s' as %s\n", args[0], outcome)
+
+			if outcome != pm.OutcomeSucceeded {
+				return nil
+			}
+			if graduateAs == "" {
+				fmt.Printf("💡 Graduate it to a feature with: go-pm experiment conclude %s %s --graduate-as <feature-name>\n", args[0], outcome)
+				return nil
+			}
+
+			feature, err := manager.GraduateExperiment(ctx, args[0], graduateAs)
+			if err != nil {
+				return fmt.Errorf("failed to graduate experiment: %w", err)
+			}
+			fmt.Printf("🎓 Graduated to %s\n", feature.Name)
+			fmt.Printf("📁 Directory: %s\n", feature.Path)
+			return nil
+		},
+	}
+	concludeCmd.Flags().String("note", "", "Why the experiment reached this outcome, appended to its notes")
+	concludeCmd.Flags().String("graduate-as", "", "Create a feature with this name linked to the succeeded experiment")
+
+	experimentCmd.AddCommand(concludeCmd)
+	return experimentCmd
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryCommand creates the history command group for the status and phase change log
+func newHistoryCommand(manager *pm.DefaultManager) *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Work with the recorded status and phase change history",
+	}
+
+	exportCmd := &cobra.Command{
+		Use:   "export [name]",
+		Short: "Export history entries as newline-delimited JSON",
+		Long: `Export the status and phase changes recorded in history.jsonl as
+newline-delimited JSON, one object per change with the work item name,
+timestamp, actor, field, and old and new values. Records are written as they
+are read, so large backlogs can be piped straight into other tools.
+
+Without a name, every work item in the backlog and the completed directory is
+exported.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString("format")
+			if format != "ndjson" {
+				return fmt.Errorf("unsupported format %q (supported: ndjson)", format)
+			}
+
+			name := ""
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			err := manager.ExportHistory(cmd.Context(), name, func(record pm.HistoryRecord) error {
+				return encoder.Encode(record)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export history: %w", err)
+			}
+			return nil
+		},
+	}
+	exportCmd.Flags().String("format", "ndjson", "Export format (ndjson)")
+
+	historyCmd.AddCommand(exportCmd)
+	return historyCmd
+}
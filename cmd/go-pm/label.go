@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newLabelCommand creates the label command group for a work item's free-form labels
+func newLabelCommand(manager *pm.DefaultManager) *cobra.Command {
+	labelCmd := &cobra.Command{
+		Use:   "label",
+		Short: "Manage free-form labels (backend, q3-goal, ...) on work items",
+		Long: `Manage free-form labels kept on the work item's "## Labels:" line, to group
+work items across types. List the work items with a label with
+'go-pm list all --label backend'; repeat --label to require several.`,
+	}
+
+	labelCmd.AddCommand(&cobra.Command{
+		Use:               "add [name] [label]",
+		Short:             "Add a label to a work item",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := manager.AddLabel(cmd.Context(), args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to add label: %w", err)
+			}
+
+			fmt.Printf("🏷️  Added label %q to '%s'\n", args[1], args[0])
+			return nil
+		},
+	})
+
+	labelCmd.AddCommand(&cobra.Command{
+		Use:               "remove [name] [label]",
+		Short:             "Remove a label from a work item",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := manager.RemoveLabel(cmd.Context(), args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to remove label: %w", err)
+			}
+
+			fmt.Printf("🏷️  Removed label %q from '%s'\n", args[1], args[0])
+			return nil
+		},
+	})
+
+	return labelCmd
+}
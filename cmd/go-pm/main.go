@@ -3,13 +3,21 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bryankaraffa/go-pm/pkg/pm"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var rootCmd = &cobra.Command{
@@ -20,11 +28,56 @@ var rootCmd = &cobra.Command{
 
 var enableGit bool
 var autoDetectRepoRoot bool
-var baseDir string
+var repoPath string
+var concurrency int
+var gitRef string
+var outputFormat string
+var colorMode string
+var listCreatedBy string
+var listIncludeCompleted bool
+var listNamePrefix string
+var listNameGlob string
+var listType string
+var listPriority string
+var listLabels []string
+
+// The commands are built before cobra parses the command line, so they are
+// given these, which loadConfig fills in from the parsed flags before any
+// command runs
+var (
+	cliConfig  = &pm.Config{}
+	cliManager = &pm.DefaultManager{}
+	cliHelper  = &pm.CLIHelper{}
+)
 
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&enableGit, "enable-git", false, "Enable git integration")
 	rootCmd.PersistentFlags().BoolVar(&autoDetectRepoRoot, "auto-detect-repo-root", true, "Auto-detect repository root directory")
+	rootCmd.PersistentFlags().StringVar(&repoPath, "repo", "", "Operate on the repository containing this path instead of the current directory")
+	rootCmd.PersistentFlags().IntVar(&concurrency, "concurrency", runtime.NumCPU(), "How many work items listing, search and validate parse in parallel (1 for sequential)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text, json; config show also accepts yaml, list commands jsonl and table, stats table); json and jsonl report failures as structured errors on stderr")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", "auto", "Colorize output (auto, always, never); auto respects NO_COLOR and disables color when not a terminal")
+	listCmd.PersistentFlags().StringVar(&listCreatedBy, "created-by", "", "Only list work items created by this author")
+	listCmd.PersistentFlags().BoolVar(&listIncludeCompleted, "include-completed", false, "Also list archived work items from the completed directory")
+	listCmd.PersistentFlags().StringVar(&listNamePrefix, "name-prefix", "", "Only list work items whose name, with or without the type prefix, starts with this")
+	listCmd.PersistentFlags().StringVar(&gitRef, "ref", "", "List work items as of this git ref (branch, tag or commit) instead of the working tree")
+	listCmd.PersistentFlags().StringVar(&listNameGlob, "name-glob", "", "Only list work items whose name, with or without the type prefix, matches this glob (e.g. 'mobile-*')")
+	listCmd.PersistentFlags().StringVar(&listType, "type", "", "Only list work items of this type (feature, bug, experiment)")
+	_ = listCmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(typeCompletions, cobra.ShellCompDirectiveNoFileComp))
+	listCmd.PersistentFlags().StringVar(&listPriority, "priority", "", "Only list work items with this priority (low, medium, high, critical)")
+	_ = listCmd.RegisterFlagCompletionFunc("priority", cobra.FixedCompletions(priorityCompletions, cobra.ShellCompDirectiveNoFileComp))
+	listCmd.PersistentFlags().StringArrayVar(&listLabels, "label", nil, "Only list work items with this label (repeatable; items must have every label)")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// Structured errors replace cobra's human-readable error and usage output
+		if jsonErrorsEnabled(outputFormat) {
+			rootCmd.SilenceErrors = true
+			rootCmd.SilenceUsage = true
+		}
+		if _, err := pm.ParseColorMode(colorMode); err != nil {
+			return err
+		}
+		return loadConfig(cmd)
+	}
 }
 
 var newCmd = &cobra.Command{
@@ -47,18 +100,67 @@ var progressCmd = &cobra.Command{
 	Short: "Track work item progress",
 }
 
+var notesCmd = &cobra.Command{
+	Use:   "notes",
+	Short: "Manage scratch notes kept outside the work item README",
+}
+
 // createWorkItemCommand creates a cobra command for creating work items of a specific type
 func createWorkItemCommand(manager *pm.DefaultManager, itemType pm.ItemType, description string) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("%s [name]", strings.ToLower(string(itemType))),
 		Short: fmt.Sprintf("Create new %s", description),
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
+			title, _ := cmd.Flags().GetString("title")
+			priority, _ := cmd.Flags().GetString("priority")
+			labels, _ := cmd.Flags().GetStringArray("label")
+			assignee, _ := cmd.Flags().GetString("assign")
+			assignMe, _ := cmd.Flags().GetBool("assign-me")
+			ifNotExists, _ := cmd.Flags().GetBool("if-not-exists")
+			sets, _ := cmd.Flags().GetStringArray("set")
+			strictTemplate, _ := cmd.Flags().GetBool("strict-template")
+			statusFlag, _ := cmd.Flags().GetString("status")
+			phase, _ := cmd.Flags().GetString("phase")
+			parent, _ := cmd.Flags().GetString("parent")
+
+			templateVars, err := parseTemplateVars(sets)
+			if err != nil {
+				return err
+			}
+
+			var status pm.ItemStatus
+			if statusFlag != "" {
+				if status, err = parseStatus(statusFlag); err != nil {
+					return err
+				}
+			}
+
+			if ifNotExists {
+				if existing, err := manager.GetWorkItem(ctx, fmt.Sprintf("%s-%s", itemType, args[0])); err == nil {
+					fmt.Printf("ℹ️  Work item already exists: %s\n", existing.Path)
+					return nil
+				}
+			}
+
+			if assignMe {
+				assignee = manager.CurrentUser()
+			}
+
 			req := pm.CreateRequest{
-				Type: itemType,
-				Name: args[0],
+				Type:          itemType,
+				Name:          args[0],
+				Title:         title,
+				Priority:      pm.Priority(strings.ToUpper(priority)),
+				Labels:        labels,
+				Assignee:      assignee,
+				TemplateVars:  templateVars,
+				InitialStatus: status,
+				InitialPhase:  pm.WorkPhase(strings.ToLower(phase)),
+				IfNotExists:   ifNotExists,
+				Parent:        parent,
 			}
 
 			item, err := manager.CreateWorkItem(ctx, req)
@@ -71,7 +173,20 @@ func createWorkItemCommand(manager *pm.DefaultManager, itemType pm.ItemType, des
 			if item.Title != "" {
 				fmt.Printf("📝 Title: %s\n", item.Title)
 			}
-			fmt.Printf("🌿 Branch: %s/%s\n", item.Type, item.Name)
+			if assignMe {
+				fmt.Printf("👤 Assigned to: %s (you)\n", assignee)
+			}
+			if parent != "" {
+				fmt.Printf("🔗 Parent: %s\n", parent)
+			}
+			fmt.Printf("🌿 Branch: %s\n", manager.BranchName(item.Type, req.Name))
+			if strictTemplate {
+				if content, err := os.ReadFile(item.Path); err == nil {
+					if keys := pm.UnreplacedPlaceholders(string(content)); len(keys) > 0 {
+						fmt.Printf("⚠️  Unreplaced template placeholders: %s (set them with --set key=value)\n", strings.Join(keys, ", "))
+					}
+				}
+			}
 			fmt.Printf("\nNext steps:\n")
 			fmt.Printf("1. Edit %s with details\n", item.Path)
 			fmt.Printf("2. Update status as work progresses\n")
@@ -80,23 +195,257 @@ func createWorkItemCommand(manager *pm.DefaultManager, itemType pm.ItemType, des
 			return nil
 		},
 	}
+	cmd.Flags().String("title", "", "Title for the work item (defaults to the name)")
+	cmd.Flags().String("priority", "", "Priority (low, medium, high, critical)")
+	cmd.Flags().StringArray("label", nil, "Label to attach (repeatable)")
+	cmd.Flags().String("assign", "", "Initial assignee (defaults to the template assignee)")
+	cmd.Flags().Bool("assign-me", false, "Assign the work item to yourself (your git user name, or OS user)")
+	cmd.MarkFlagsMutuallyExclusive("assign", "assign-me")
+	cmd.Flags().Bool("if-not-exists", false, "Succeed without changes if the work item already exists")
+	cmd.Flags().StringArray("set", nil, "Replace a custom {{key}} template placeholder, as key=value (repeatable)")
+	cmd.Flags().Bool("strict-template", false, "Warn about template placeholders left unreplaced")
+	cmd.Flags().String("status", "", "Initial status for work already underway, e.g. planning (defaults to proposed)")
+	cmd.Flags().String("phase", "", "Initial phase; must match the status (defaults to the status's phase)")
+	cmd.Flags().String("parent", "", "Link the new work item as a child of this existing work item")
+	_ = cmd.RegisterFlagCompletionFunc("parent", completeWorkItemNames(manager))
+	_ = cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(statusCompletions, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("phase", cobra.FixedCompletions(phaseCompletions, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
 }
 
-func main() {
-	// Check for flags and set env vars
-	for _, arg := range os.Args {
-		if arg == "--enable-git" {
-			_ = os.Setenv("PM_ENABLE_GIT", "true")
+// listScope returns the directories the list commands scan
+func listScope() pm.ListScope {
+	if listIncludeCompleted {
+		return pm.ScopeAll
+	}
+	return pm.ScopeBacklog
+}
+
+// listFilter adds the list command's persistent filter flags to filter
+func listFilter(filter pm.ListFilter) (pm.ListFilter, error) {
+	if listType != "" {
+		itemType, err := parseItemType(listType)
+		if err != nil {
+			return filter, err
 		}
-		if arg == "--auto-detect-repo-root=false" {
-			_ = os.Setenv("PM_AUTO_DETECT_REPO_ROOT", "false")
+		filter.Type = itemType
+	}
+	if listPriority != "" {
+		priority, err := parsePriority(listPriority)
+		if err != nil {
+			return filter, err
 		}
+		filter.Priority = priority
 	}
+	filter.Labels = listLabels
+	filter.CreatedBy = listCreatedBy
+	filter.Scope = listScope()
+	filter.NamePrefix = listNamePrefix
+	filter.NameGlob = listNameGlob
+	return filter, nil
+}
 
-	ctx := context.Background()
+// activeStatuses are the statuses `list active` shows, in display order
+var activeStatuses = []pm.ItemStatus{
+	pm.StatusInProgressDiscovery,
+	pm.StatusInProgressPlanning,
+	pm.StatusInProgressExecution,
+	pm.StatusInProgressCleanup,
+	pm.StatusInProgressReview,
+}
+
+// isActive reports whether item is in progress
+func isActive(item pm.WorkItem) bool {
+	return slices.Contains(activeStatuses, item.Status)
+}
+
+// streamJSONLines writes each work item matching filter to stdout as a JSON
+// object on its own line, as items are parsed, for `--output jsonl`. When keep
+// is non-nil, only items it accepts are written.
+func streamJSONLines(ctx context.Context, manager pm.Manager, filter pm.ListFilter, keep func(pm.WorkItem) bool) error {
+	encoder := json.NewEncoder(os.Stdout)
+	err := manager.StreamWorkItems(ctx, filter, func(item pm.WorkItem) error {
+		if keep != nil && !keep(item) {
+			return nil
+		}
+		return encoder.Encode(item)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list work items: %w", err)
+	}
+	return nil
+}
+
+// keepItems returns the items keep accepts, or all items when keep is nil.
+// The result is never nil, so an empty listing encodes as [] in JSON.
+func keepItems(items []pm.WorkItem, keep func(pm.WorkItem) bool) []pm.WorkItem {
+	kept := make([]pm.WorkItem, 0, len(items))
+	for _, item := range items {
+		if keep == nil || keep(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// writeJSON writes v to stdout as indented JSON, for `--output json`
+func writeJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
 
-	config := pm.DefaultConfig()
+// listSummaryLength is how many characters of a summary `list all` shows
+const listSummaryLength = 60
+
+// listDescription returns the title to show next to a work item in listings.
+// When the title only repeats the name, the summary is shown instead, truncated.
+func listDescription(item pm.WorkItem) string {
+	slug := strings.TrimPrefix(item.Name, string(item.Type)+"-")
+	if item.Title != "" && item.Title != slug && item.Title != item.Name {
+		return item.Title
+	}
+	if item.Summary == "" {
+		return item.Title
+	}
+
+	summary := []rune(item.Summary)
+	if len(summary) > listSummaryLength {
+		return strings.TrimSpace(string(summary[:listSummaryLength-1])) + "…"
+	}
+	return item.Summary
+}
+
+// previewAdvance prints what `phase advance` would do to a work item without
+// changing it, and fails when the advance would be blocked
+func previewAdvance(cmd *cobra.Command, manager pm.Manager, name string) error {
+	preview, err := manager.PreviewAdvance(cmd.Context(), name)
+	if err != nil {
+		return fmt.Errorf("failed to preview phase advance: %w", err)
+	}
+
+	fmt.Printf("🔍 Advancing '%s' would change:\n", name)
+	if preview.NextStatus != "" {
+		fmt.Printf("   Phase:  %s → %s\n", preview.CurrentPhase, preview.NextPhase)
+		fmt.Printf("   Status: %s → %s\n", colors().Status(preview.CurrentStatus), colors().Status(preview.NextStatus))
+	} else {
+		fmt.Printf("   Nothing (phase %s, status %s)\n", preview.CurrentPhase, colors().Status(preview.CurrentStatus))
+	}
+	if preview.NewAssignee != "" {
+		fmt.Printf("   Assignee: → reviewer %s\n", preview.NewAssignee)
+	}
+	if len(preview.IncompleteTasks) > 0 {
+		fmt.Printf("⚠️  %d incomplete task(s) in the %s phase:\n", len(preview.IncompleteTasks), preview.CurrentPhase)
+		for _, task := range preview.IncompleteTasks {
+			fmt.Printf("   ⏳ %s\n", task.Description)
+		}
+	}
+	for _, requirement := range preview.UnmetRequirements {
+		fmt.Printf("📋 Unmet requirement: %s\n", requirement)
+	}
+
+	if preview.CanAdvance() {
+		fmt.Println("✅ The advance would succeed")
+		return nil
+	}
+	fmt.Println(colors().Red("❌ The advance would fail:"))
+	for _, blocker := range preview.Blockers {
+		fmt.Printf("   %s\n", blocker)
+	}
+	// The blockers are the report; usage would only obscure them
+	cmd.SilenceUsage = true
+	return fmt.Errorf("advance of %s would fail", name)
+}
+
+// parseStatus converts a status argument, either the full constant or its short
+// form (e.g. "planning"), to an ItemStatus
+func parseStatus(value string) (pm.ItemStatus, error) {
+	switch strings.ToLower(value) {
+	case "proposed":
+		return pm.StatusProposed, nil
+	case "in_progress_discovery", "discovery":
+		return pm.StatusInProgressDiscovery, nil
+	case "in_progress_planning", "planning":
+		return pm.StatusInProgressPlanning, nil
+	case "in_progress_execution", "execution":
+		return pm.StatusInProgressExecution, nil
+	case "in_progress_cleanup", "cleanup":
+		return pm.StatusInProgressCleanup, nil
+	case "in_progress_review", "review":
+		return pm.StatusInProgressReview, nil
+	case "completed":
+		return pm.StatusCompleted, nil
+	}
+	return "", fmt.Errorf("invalid status: %s. Valid statuses: proposed, discovery, planning, execution, cleanup, review, completed", value)
+}
+
+// parseItemType converts a type argument (e.g. "bug") to an ItemType
+func parseItemType(value string) (pm.ItemType, error) {
+	if slices.Contains(typeCompletions, strings.ToLower(value)) {
+		return pm.ItemType(strings.ToLower(value)), nil
+	}
+	return "", fmt.Errorf("invalid type: %s. Valid types: %s", value, strings.Join(typeCompletions, ", "))
+}
+
+// parseTemplateVars parses repeated --set key=value flags into a substitution map
+func parseTemplateVars(sets []string) (map[string]string, error) {
+	if len(sets) == 0 {
+		return nil, nil
+	}
+	vars := make(map[string]string, len(sets))
+	for _, set := range sets {
+		key, value, ok := strings.Cut(set, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --set %q: expected key=value", set)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// loadConfig loads the config with the overrides set by cmd's parsed flags and
+// fills in cliConfig, cliManager and cliHelper with it. With --ref, work items
+// are read from git without touching the working tree. Dynamic completions
+// call it again, since cobra only parses the completed command's flags after
+// the persistent hooks ran.
+func loadConfig(cmd *cobra.Command) error {
+	config, err := pm.DefaultConfigWithOverrides(configOverrides(cmd.Flags()))
+	if err != nil {
+		return err
+	}
 	manager := pm.NewDefaultManager(config)
+	if gitRef != "" {
+		if manager, err = pm.NewDefaultManagerAtRef(config, gitRef); err != nil {
+			return err
+		}
+	}
+
+	*cliConfig = config
+	*cliManager = *manager
+	*cliHelper = *pm.NewCLIHelper(cliManager, config)
+	return nil
+}
+
+// configOverrides returns the config settings explicitly set by flags
+func configOverrides(flags *pflag.FlagSet) pm.ConfigOverrides {
+	overrides := pm.ConfigOverrides{BaseDir: repoPath}
+	if flags.Changed("enable-git") {
+		overrides.EnableGit = &enableGit
+	}
+	if flags.Changed("auto-detect-repo-root") {
+		overrides.AutoDetectRepoRoot = &autoDetectRepoRoot
+	}
+	if flags.Changed("concurrency") {
+		overrides.ListConcurrency = &concurrency
+	}
+	return overrides
+}
+
+func main() {
+	ctx := context.Background()
+	config, manager, helper := cliConfig, cliManager, cliHelper
 	newCmd.AddCommand(createWorkItemCommand(manager, pm.TypeFeature, "feature"))
 	newCmd.AddCommand(createWorkItemCommand(manager, pm.TypeBug, "bug report"))
 	newCmd.AddCommand(createWorkItemCommand(manager, pm.TypeExperiment, "experiment"))
@@ -104,12 +453,24 @@ func main() {
 		Use:   "proposed",
 		Short: "List proposed work items",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			filter := pm.ListFilter{Status: pm.StatusProposed}
+			filter, err := listFilter(pm.ListFilter{Status: pm.StatusProposed})
+			if err != nil {
+				return err
+			}
+			if outputFormat == "jsonl" {
+				return streamJSONLines(ctx, manager, filter, nil)
+			}
 
 			items, err := manager.ListWorkItems(ctx, filter)
 			if err != nil {
 				return fmt.Errorf("failed to list work items: %w", err)
 			}
+			if outputFormat == "table" {
+				return helper.WriteTable(os.Stdout, keepItems(items, nil))
+			}
+			if outputFormat == "json" {
+				return writeJSON(keepItems(items, nil))
+			}
 
 			fmt.Println("Proposed work items:")
 			if len(items) == 0 {
@@ -133,19 +494,23 @@ func main() {
 		Use:   "active",
 		Short: "List active work items (in progress)",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			filter := pm.ListFilter{} // Empty filter gets all items
+			filter, err := listFilter(pm.ListFilter{}) // No status filter gets all items
+			if err != nil {
+				return err
+			}
+			if outputFormat == "jsonl" {
+				return streamJSONLines(ctx, manager, filter, isActive)
+			}
 
 			items, err := manager.ListWorkItems(ctx, filter)
 			if err != nil {
 				return fmt.Errorf("failed to list work items: %w", err)
 			}
-
-			activeStatuses := []pm.ItemStatus{
-				pm.StatusInProgressDiscovery,
-				pm.StatusInProgressPlanning,
-				pm.StatusInProgressExecution,
-				pm.StatusInProgressCleanup,
-				pm.StatusInProgressReview,
+			if outputFormat == "table" {
+				return helper.WriteTable(os.Stdout, keepItems(items, isActive))
+			}
+			if outputFormat == "json" {
+				return writeJSON(keepItems(items, isActive))
 			}
 
 			statusGroups := make(map[pm.ItemStatus][]pm.WorkItem)
@@ -164,7 +529,7 @@ func main() {
 			for _, status := range activeStatuses {
 				if items, exists := statusGroups[status]; exists && len(items) > 0 {
 					hasActive = true
-					fmt.Printf("\n%s:\n", status)
+					fmt.Printf("\n%s:\n", colors().Status(status))
 					for _, item := range items {
 						fmt.Printf("  📋 %s", item.Name)
 						if item.Title != "" {
@@ -191,12 +556,26 @@ func main() {
 		Use:   "completed",
 		Short: "List completed work items",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			filter := pm.ListFilter{Status: pm.StatusCompleted}
+			filter, err := listFilter(pm.ListFilter{Status: pm.StatusCompleted})
+			if err != nil {
+				return err
+			}
+			// Completed items usually live in the completed directory once archived
+			filter.Scope = pm.ScopeAll
+			if outputFormat == "jsonl" {
+				return streamJSONLines(ctx, manager, filter, nil)
+			}
 
 			items, err := manager.ListWorkItems(ctx, filter)
 			if err != nil {
 				return fmt.Errorf("failed to list work items: %w", err)
 			}
+			if outputFormat == "table" {
+				return helper.WriteTable(os.Stdout, keepItems(items, nil))
+			}
+			if outputFormat == "json" {
+				return writeJSON(keepItems(items, nil))
+			}
 
 			fmt.Println("Completed work items:")
 			if len(items) == 0 {
@@ -209,6 +588,9 @@ func main() {
 				if item.Title != "" {
 					fmt.Printf(" - %s", item.Title)
 				}
+				if item.Archived {
+					fmt.Print(" (archived)")
+				}
 				fmt.Println()
 			}
 
@@ -220,12 +602,24 @@ func main() {
 		Use:   "all",
 		Short: "List all work items with status",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			filter := pm.ListFilter{} // Empty filter gets all items
+			filter, err := listFilter(pm.ListFilter{}) // No status filter gets all items
+			if err != nil {
+				return err
+			}
+			if outputFormat == "jsonl" {
+				return streamJSONLines(ctx, manager, filter, nil)
+			}
 
 			items, err := manager.ListWorkItems(ctx, filter)
 			if err != nil {
 				return fmt.Errorf("failed to list work items: %w", err)
 			}
+			if outputFormat == "table" {
+				return helper.WriteTable(os.Stdout, keepItems(items, nil))
+			}
+			if outputFormat == "json" {
+				return writeJSON(keepItems(items, nil))
+			}
 
 			fmt.Println("All work items:")
 
@@ -242,11 +636,11 @@ func main() {
 			statuses := []pm.ItemStatus{pm.StatusProposed, pm.StatusInProgressDiscovery, pm.StatusInProgressPlanning, pm.StatusInProgressExecution, pm.StatusInProgressCleanup, pm.StatusInProgressReview, pm.StatusCompleted}
 			for _, status := range statuses {
 				if items, exists := statusGroups[status]; exists && len(items) > 0 {
-					fmt.Printf("\n%s:\n", status)
+					fmt.Printf("\n%s:\n", colors().Status(status))
 					for _, item := range items {
 						fmt.Printf("  📋 %s", item.Name)
-						if item.Title != "" {
-							fmt.Printf(" - %s", item.Title)
+						if description := listDescription(item); description != "" {
+							fmt.Printf(" - %s", description)
 						}
 						fmt.Printf(" [%s]", item.Phase)
 						if item.Progress > 0 {
@@ -261,12 +655,94 @@ func main() {
 		},
 	})
 
+	listCmd.AddCommand(newListAttentionCommand(manager))
+	listCmd.AddCommand(newListBlockedCommand(manager))
+
+	listCmd.AddCommand(&cobra.Command{
+		Use:       "phase [phase]",
+		Short:     "List work items in a phase, regardless of status",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: phaseCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			phase := pm.WorkPhase(strings.ToLower(args[0]))
+			if !slices.Contains(phaseCompletions, string(phase)) {
+				return fmt.Errorf("invalid phase: %s. Valid phases: %s", args[0], strings.Join(phaseCompletions, ", "))
+			}
+
+			filter, err := listFilter(pm.ListFilter{Phase: phase})
+			if err != nil {
+				return err
+			}
+			if outputFormat == "jsonl" {
+				return streamJSONLines(ctx, manager, filter, nil)
+			}
+
+			items, err := manager.ListWorkItems(ctx, filter)
+			if err != nil {
+				return fmt.Errorf("failed to list work items: %w", err)
+			}
+			if outputFormat == "table" {
+				return helper.WriteTable(os.Stdout, keepItems(items, nil))
+			}
+			if outputFormat == "json" {
+				return writeJSON(keepItems(items, nil))
+			}
+
+			fmt.Printf("Work items in the %s phase:\n", phase)
+			if len(items) == 0 {
+				fmt.Printf("  No work items found in the %s phase\n", phase)
+				return nil
+			}
+
+			for _, item := range items {
+				fmt.Printf("  📋 %s", item.Name)
+				if item.Title != "" {
+					fmt.Printf(" - %s", item.Title)
+				}
+				fmt.Printf(" [%s]", colors().Status(item.Status))
+				fmt.Printf(" (%d%%)", item.Progress)
+				fmt.Println()
+			}
+
+			return nil
+		},
+	})
+
 	// Archive command
-	rootCmd.AddCommand(&cobra.Command{
-		Use:   "archive [name]",
-		Short: "Archive completed work item",
-		Args:  cobra.ExactArgs(1),
+	archiveCmd := &cobra.Command{
+		Use:               "archive [name]",
+		Short:             "Archive completed work item",
+		Long:              "Archive a completed work item, or every COMPLETED work item in the backlog with --completed.\nArchiving an item again finishes an archive that was interrupted before its postmortem was written.",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			completed, _ := cmd.Flags().GetBool("completed")
+			if completed {
+				if len(args) > 0 {
+					return fmt.Errorf("--completed cannot be combined with a work item name")
+				}
+
+				archived, err := manager.ArchiveCompletedWorkItems(ctx)
+				for _, name := range archived {
+					fmt.Printf("✅ Archived '%s' to %s/\n", name, config.CompletedDir)
+				}
+				if len(archived) == 0 && err == nil {
+					fmt.Println("No completed work items to archive")
+					return nil
+				}
+				fmt.Printf("\n📦 Archived %d completed work item(s)\n", len(archived))
+				if err != nil {
+					return fmt.Errorf("failed to archive some work items: %w", err)
+				}
+				fmt.Printf("📝 Consider filling out the postmortems\n")
+
+				return nil
+			}
+
+			if len(args) != 1 {
+				return fmt.Errorf("requires a work item name or --completed")
+			}
+
 			if err := manager.ArchiveWorkItem(ctx, args[0]); err != nil {
 				return fmt.Errorf("failed to archive work item: %w", err)
 			}
@@ -276,7 +752,10 @@ func main() {
 
 			return nil
 		},
-	})
+	}
+	archiveCmd.Flags().Bool("completed", false, "Archive every work item in COMPLETED status")
+	rootCmd.AddCommand(archiveCmd)
+	rootCmd.AddCommand(newUnarchiveCommand(manager))
 
 	// Status command
 	statusCmd := &cobra.Command{
@@ -285,53 +764,83 @@ func main() {
 	}
 
 	statusCmd.AddCommand(&cobra.Command{
-		Use:   "update [name] [status]",
-		Short: "Update work item status",
-		Args:  cobra.ExactArgs(2),
+		Use:               "update [name] [status]",
+		Short:             "Update work item status",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNameAnd(manager, statusCompletions),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var status pm.ItemStatus
-			switch strings.ToLower(args[1]) {
-			case "proposed":
-				status = pm.StatusProposed
-			case "in_progress_discovery", "discovery":
-				status = pm.StatusInProgressDiscovery
-			case "in_progress_planning", "planning":
-				status = pm.StatusInProgressPlanning
-			case "in_progress_execution", "execution":
-				status = pm.StatusInProgressExecution
-			case "in_progress_cleanup", "cleanup":
-				status = pm.StatusInProgressCleanup
-			case "in_progress_review", "review":
-				status = pm.StatusInProgressReview
-			case "completed":
-				status = pm.StatusCompleted
-			default:
-				return fmt.Errorf("invalid status: %s. Valid statuses: proposed, discovery, planning, execution, cleanup, review, completed", args[1])
+			status, err := parseStatus(args[1])
+			if err != nil {
+				return err
 			}
 			if err := manager.UpdateStatus(ctx, args[0], status); err != nil {
 				return fmt.Errorf("failed to update status: %w", err)
 			}
 
-			fmt.Printf("✅ Updated '%s' status to: %s\n", args[0], status)
+			fmt.Printf("✅ Updated '%s' status to: %s\n", args[0], colors().Status(status))
 			return nil
 		},
 	})
 
 	statusCmd.AddCommand(&cobra.Command{
-		Use:   "show [name]",
-		Short: "Show work item details",
-		Args:  cobra.ExactArgs(1),
+		Use:               "timeline [name]",
+		Short:             "Show how long a work item has spent in each status",
+		Long:              "Show how long a work item has spent in each status, from its recorded history, including the current status up to now.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			item, err := manager.GetWorkItem(ctx, args[0])
+			durations, err := manager.GetStatusDurations(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get status durations: %w", err)
+			}
+
+			if len(durations) == 0 {
+				fmt.Printf("No status history recorded for '%s'\n", args[0])
+				return nil
+			}
+
+			fmt.Printf("Time in status for '%s':\n", args[0])
+			for _, status := range append([]pm.ItemStatus{pm.StatusProposed}, activeStatuses...) {
+				if d, ok := durations[status]; ok {
+					fmt.Printf("  %-22s %s\n", status, formatDuration(d))
+				}
+			}
+
+			return nil
+		},
+	})
+
+	statusShowCmd := &cobra.Command{
+		Use:               "show [name]",
+		Short:             "Show work item details",
+		Long:              "Show work item details by backlog name, or by directory with --path (works for completed items too).",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := cmd.Flags().GetString("path")
+			if (path == "") == (len(args) == 0) {
+				return fmt.Errorf("requires exactly one of a work item name or --path")
+			}
+
+			var item *pm.WorkItem
+			var err error
+			if path != "" {
+				item, err = manager.GetWorkItemByPath(ctx, path)
+			} else {
+				item, err = manager.GetWorkItem(ctx, args[0])
+			}
 			if err != nil {
 				return fmt.Errorf("failed to get work item: %w", err)
 			}
+			if outputFormat == "json" {
+				return writeJSON(item)
+			}
 
 			fmt.Printf("📋 Work Item: %s\n", item.Name)
 			if item.Title != "" {
 				fmt.Printf("📝 Title: %s\n", item.Title)
 			}
-			fmt.Printf("⏱️  Status: %s\n", item.Status)
+			fmt.Printf("⏱️  Status: %s\n", colors().Status(item.Status))
 			fmt.Printf("� Phase: %s\n", item.Phase)
 			if item.Progress > 0 {
 				fmt.Printf("📈 Progress: %d%%\n", item.Progress)
@@ -339,35 +848,87 @@ func main() {
 			if item.AssignedTo != "" {
 				fmt.Printf("👤 Assigned To: %s\n", item.AssignedTo)
 			}
+			if item.CreatedBy != "" {
+				fmt.Printf("✍️  Created By: %s\n", item.CreatedBy)
+			}
+			if !item.DueDate.IsZero() {
+				dueDate := item.DueDate.Format("2006-01-02")
+				if item.IsOverdue(time.Now()) {
+					dueDate = colors().Red(dueDate + " (overdue)")
+				}
+				fmt.Printf("📅 Due Date: %s\n", dueDate)
+			}
+			if len(item.DependsOn) > 0 {
+				fmt.Printf("⛓️  Depends On: %s\n", strings.Join(item.DependsOn, ", "))
+			}
+			for _, related := range item.RelatedItems {
+				fmt.Printf("🔗 %s: %s\n", related.Relation, related.Name)
+			}
+			for _, ref := range item.References {
+				fmt.Printf("📎 %s: %s\n", ref.Label, ref.URL)
+			}
+			for _, key := range slices.Sorted(maps.Keys(item.Metadata)) {
+				fmt.Printf("🔖 %s: %s\n", key, item.Metadata[key])
+			}
 			fmt.Printf("�📂 Path: %s\n", item.Path)
 			fmt.Printf("📅 Created: %s\n", item.CreatedAt.Format("2006-01-02 15:04"))
 			fmt.Printf("🔄 Updated: %s\n", item.UpdatedAt.Format("2006-01-02 15:04"))
 
 			return nil
 		},
-	})
+	}
+	statusShowCmd.Flags().String("path", "", "Work item directory to read instead of looking up a backlog name")
+	statusShowCmd.Flags().StringVar(&gitRef, "ref", "", "Show the work item as of this git ref (branch, tag or commit) instead of the working tree")
+	statusCmd.AddCommand(statusShowCmd)
 
 	rootCmd.AddCommand(statusCmd)
 
 	// Phase commands
-	phaseCmd.AddCommand(&cobra.Command{
+	advanceCmd := &cobra.Command{
 		Use:   "advance [name]",
 		Short: "Advance work item to next phase",
-		Args:  cobra.ExactArgs(1),
+		Long: `Advance a work item to its next phase and status.
+
+With --preview nothing is changed: the command reports the would-be phase and
+status, incomplete tasks, unmet phase requirements and any reviewer handoff,
+and exits non-zero when the advance would fail.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := manager.AdvancePhase(ctx, args[0]); err != nil {
+			if preview, _ := cmd.Flags().GetBool("preview"); preview {
+				return previewAdvance(cmd, manager, args[0])
+			}
+
+			before, err := manager.GetWorkItem(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to advance phase: %w", err)
+			}
+			incomplete, err := manager.AdvancePhaseWithWarnings(ctx, args[0])
+			if err != nil {
 				return fmt.Errorf("failed to advance phase: %w", err)
 			}
 
 			fmt.Printf("✅ Advanced '%s' to next phase\n", args[0])
+			if len(incomplete) > 0 {
+				fmt.Printf("⚠️  Advanced with %d incomplete task(s):\n", len(incomplete))
+				for _, task := range incomplete {
+					fmt.Printf("   ⏳ %s\n", task.Description)
+				}
+			}
+			if after, err := manager.GetWorkItem(ctx, args[0]); err == nil && after.AssignedTo != before.AssignedTo {
+				fmt.Printf("👤 Assigned to reviewer %s\n", after.AssignedTo)
+			}
 			return nil
 		},
-	})
+	}
+	advanceCmd.Flags().Bool("preview", false, "Show what advancing would change and whether it would succeed, without changing anything")
+	phaseCmd.AddCommand(advanceCmd)
 
 	phaseCmd.AddCommand(&cobra.Command{
-		Use:   "set [name] [phase]",
-		Short: "Set work item phase (admin override)",
-		Args:  cobra.ExactArgs(2),
+		Use:               "set [name] [phase]",
+		Short:             "Set work item phase (admin override)",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNameAnd(manager, phaseCompletions),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var phase pm.WorkPhase
 			switch strings.ToLower(args[1]) {
@@ -392,14 +953,18 @@ func main() {
 	})
 
 	phaseCmd.AddCommand(&cobra.Command{
-		Use:   "tasks [name]",
-		Short: "Show current phase tasks",
-		Args:  cobra.ExactArgs(1),
+		Use:               "tasks [name]",
+		Short:             "Show current phase tasks",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			tasks, err := manager.GetPhaseTasks(ctx, args[0])
 			if err != nil {
 				return fmt.Errorf("failed to get phase tasks: %w", err)
 			}
+			if outputFormat == "json" {
+				return writeJSON(tasks)
+			}
 
 			if len(tasks) == 0 {
 				fmt.Printf("No tasks found for current phase of '%s'\n", args[0])
@@ -407,15 +972,42 @@ func main() {
 			}
 
 			fmt.Printf("Tasks for '%s' current phase:\n", args[0])
+			group := ""
 			for i, task := range tasks {
+				// Task IDs stay in file order; group headings are only inserted for display
+				if task.Group != group {
+					group = task.Group
+					if group != "" {
+						fmt.Printf("  %s:\n", group)
+					}
+				}
+				indent := "  "
+				if group != "" {
+					indent = "    "
+				}
 				status := "[ ]"
 				if task.Completed {
-					status = "[x]"
+					status = colors().Green("[x]")
+				} else if task.NotApplicable {
+					status = "[~]"
 				}
-				fmt.Printf("  %d. %s %s", i, status, task.Description)
+				fmt.Printf("%s%d. %s %s", indent, i, status, task.Description)
 				if task.AssignedTo != "" {
 					fmt.Printf(" (%s)", task.AssignedTo)
 				}
+				if !task.DueDate.IsZero() {
+					due := "due " + task.DueDate.Format("2006-01-02")
+					if task.IsOverdue(time.Now()) {
+						due = colors().Red(due + ", overdue")
+					}
+					fmt.Printf(" [%s]", due)
+				}
+				if task.Completed && !task.CompletedAt.IsZero() {
+					fmt.Printf(" [done %s]", task.CompletedAt.Format("2006-01-02"))
+				}
+				if task.Estimate > 0 {
+					fmt.Printf(" [~%s]", pm.FormatEstimate(task.Estimate))
+				}
 				fmt.Println()
 			}
 
@@ -423,11 +1015,115 @@ func main() {
 		},
 	})
 
+	syncTasksCmd := &cobra.Command{
+		Use:               "sync-tasks [name]",
+		Short:             "Add tasks from the current template that are missing from the current phase",
+		Long:              "Merge tasks added to the work item template since the item was created into its current phase section, appended unchecked. Use --all to sync every in-progress work item.",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, _ := cmd.Flags().GetBool("all")
+			if all == (len(args) == 1) {
+				return fmt.Errorf("requires exactly one of a work item name or --all")
+			}
+
+			names := args
+			if all {
+				items, err := manager.ListWorkItems(ctx, pm.ListFilter{})
+				if err != nil {
+					return fmt.Errorf("failed to list work items: %w", err)
+				}
+				names = nil
+				for _, item := range items {
+					if strings.HasPrefix(string(item.Status), "IN_PROGRESS_") {
+						names = append(names, item.Name)
+					}
+				}
+				if len(names) == 0 {
+					fmt.Println("No in-progress work items to sync")
+					return nil
+				}
+			}
+
+			for _, name := range names {
+				before, err := manager.GetPhaseTasks(ctx, name)
+				if err != nil {
+					return fmt.Errorf("failed to get phase tasks: %w", err)
+				}
+				if err := manager.SyncPhaseTasks(ctx, name); err != nil {
+					return fmt.Errorf("failed to sync tasks: %w", err)
+				}
+				after, err := manager.GetPhaseTasks(ctx, name)
+				if err != nil {
+					return fmt.Errorf("failed to get phase tasks: %w", err)
+				}
+
+				added := len(after) - len(before)
+				if added == 0 {
+					fmt.Printf("✅ '%s' already has all template tasks\n", name)
+				} else {
+					fmt.Printf("✅ Added %d task(s) to '%s'\n", added, name)
+				}
+			}
+
+			return nil
+		},
+	}
+	syncTasksCmd.Flags().Bool("all", false, "Sync every in-progress work item")
+	phaseCmd.AddCommand(syncTasksCmd)
+
 	phaseCmd.AddCommand(&cobra.Command{
-		Use:   "complete [name] [task-id]",
-		Short: "Mark task as completed",
-		Args:  cobra.ExactArgs(2),
+		Use:               "timeline [name]",
+		Short:             "Show the phases a work item has visited and how long each took",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeline, err := manager.GetPhaseTimeline(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get phase timeline: %w", err)
+			}
+
+			if len(timeline) == 0 {
+				fmt.Printf("No phase history recorded for '%s'\n", args[0])
+				return nil
+			}
+
+			fmt.Printf("Phase timeline for '%s':\n", args[0])
+			for i, transition := range timeline {
+				fmt.Printf("  %-10s entered %s  %s", transition.Phase, transition.EnteredAt.Local().Format("2006-01-02 15:04"), formatDuration(transition.Duration))
+				if i == len(timeline)-1 {
+					fmt.Print(" (current)")
+				}
+				fmt.Println()
+			}
+
+			return nil
+		},
+	})
+
+	completeTaskCmd := &cobra.Command{
+		Use:               "complete [name] [task-id]",
+		Short:             "Mark task as completed",
+		Long:              "Mark a current-phase task as completed by its ID, or with --match by a case-insensitive substring of its description that matches exactly one incomplete task.",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			match, _ := cmd.Flags().GetString("match")
+			if match != "" {
+				if len(args) != 1 {
+					return fmt.Errorf("--match cannot be combined with a task ID")
+				}
+				if err := manager.CompleteTaskByDescription(ctx, args[0], match); err != nil {
+					return fmt.Errorf("failed to complete task: %w", err)
+				}
+
+				fmt.Printf("✅ Marked task matching %q as completed for '%s'\n", match, args[0])
+				return nil
+			}
+			if len(args) != 2 {
+				return fmt.Errorf("requires a task ID or --match")
+			}
+
 			taskId, err := strconv.Atoi(args[1])
 			if err != nil {
 				return fmt.Errorf("invalid task ID: %s", args[1])
@@ -439,13 +1135,81 @@ func main() {
 			fmt.Printf("✅ Marked task %d as completed for '%s'\n", taskId, args[0])
 			return nil
 		},
+	}
+	completeTaskCmd.Flags().String("match", "", "Complete the only incomplete task whose description contains this text")
+	phaseCmd.AddCommand(completeTaskCmd)
+
+	phaseCmd.AddCommand(&cobra.Command{
+		Use:               "uncomplete [name] [task-id]",
+		Short:             "Mark a completed task as not done",
+		Long:              "Reopen a current-phase task that was marked completed by mistake (- [x] back to - [ ]) and recalculate progress.",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			taskId, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %s", args[1])
+			}
+			if err := manager.UncompleteTask(ctx, args[0], taskId); err != nil {
+				return fmt.Errorf("failed to uncomplete task: %w", err)
+			}
+
+			fmt.Printf("↩️  Marked task %d as not done for '%s'\n", taskId, args[0])
+			return nil
+		},
+	})
+
+	phaseCmd.AddCommand(&cobra.Command{
+		Use:               "na [name] [task-id]",
+		Short:             "Mark a task as not applicable",
+		Long:              "Mark a current-phase task that doesn't apply to this work item as not applicable (- [~]). N/A tasks don't block advancing the phase and are left out of the progress percentage.",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			taskId, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %s", args[1])
+			}
+			if err := manager.MarkTaskNotApplicable(ctx, args[0], taskId); err != nil {
+				return fmt.Errorf("failed to mark task not applicable: %w", err)
+			}
+
+			fmt.Printf("➖ Marked task %d as not applicable for '%s'\n", taskId, args[0])
+			return nil
+		},
+	})
+
+	phaseCmd.AddCommand(&cobra.Command{
+		Use:               "assign-task [name] [task-id] [assignee]",
+		Short:             "Assign a task to someone",
+		Long:              `Assign a current-phase task by annotating it with "(@assignee)", replacing any existing assignee. Pass "" as the assignee to remove it, so the task falls back to the work item assignee.`,
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			taskId, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid task ID: %s", args[1])
+			}
+			if err := manager.AssignTask(ctx, args[0], taskId, args[2]); err != nil {
+				return fmt.Errorf("failed to assign task: %w", err)
+			}
+
+			assignee := strings.TrimPrefix(strings.TrimSpace(args[2]), "@")
+			if assignee == "" {
+				fmt.Printf("👤 Unassigned task %d for '%s'\n", taskId, args[0])
+			} else {
+				fmt.Printf("👤 Assigned task %d to %s for '%s'\n", taskId, assignee, args[0])
+			}
+			return nil
+		},
 	})
 
 	// Progress commands
 	progressCmd.AddCommand(&cobra.Command{
-		Use:   "update [name] [percentage]",
-		Short: "Update work item progress percentage",
-		Args:  cobra.ExactArgs(2),
+		Use:               "update [name] [percentage]",
+		Short:             "Update work item progress percentage",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Parse progress percentage
 			var progress int
@@ -463,44 +1227,187 @@ func main() {
 	})
 
 	progressCmd.AddCommand(&cobra.Command{
-		Use:   "show [name]",
-		Short: "Show detailed progress metrics for a work item",
-		Args:  cobra.ExactArgs(1),
+		Use:               "recalc [name]",
+		Short:             "Recalculate work item progress from its task completion",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			progress, err := manager.RecalculateProgress(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to recalculate progress: %w", err)
+			}
+
+			fmt.Printf("✅ Recalculated '%s' progress: %d%%\n", args[0], progress)
+			return nil
+		},
+	})
+
+	progressShowCmd := &cobra.Command{
+		Use:               "show [name]",
+		Short:             "Show detailed progress metrics for a work item",
+		Long:              "Show detailed progress metrics for a work item. Use --format markdown for a report to paste into a PR or wiki.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString("format")
+			if format != "text" && format != "markdown" {
+				return fmt.Errorf("unsupported format %q (supported: text, markdown)", format)
+			}
+
 			metrics, err := manager.GetProgressMetrics(ctx, args[0])
 			if err != nil {
 				return fmt.Errorf("failed to get progress metrics: %w", err)
 			}
+			if outputFormat == "json" {
+				return writeJSON(metrics)
+			}
 
 			// Create a progress tracker to generate the report
 			tracker := pm.NewProgressTracker(pm.NewOSFileSystem())
+			if format == "markdown" {
+				fmt.Print(tracker.GetProgressReportMarkdown(*metrics))
+				return nil
+			}
 			report := tracker.GetProgressReport(*metrics)
 			fmt.Print(report)
 
+			return nil
+		},
+	}
+	progressShowCmd.Flags().String("format", "text", "Report format (text, markdown)")
+	progressCmd.AddCommand(progressShowCmd)
+	progressCmd.AddCommand(newProgressReportCommand(manager))
+
+	// Notes commands
+	notesCmd.AddCommand(&cobra.Command{
+		Use:               "edit [name] [note...]",
+		Short:             "Append a note, or open NOTES.md in $EDITOR when no note is given",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				if err := manager.AppendNote(ctx, args[0], strings.Join(args[1:], " ")); err != nil {
+					return fmt.Errorf("failed to append note: %w", err)
+				}
+
+				fmt.Printf("✅ Added note to '%s'\n", args[0])
+				return nil
+			}
+
+			editor := os.Getenv("EDITOR")
+			if editor == "" {
+				return fmt.Errorf("$EDITOR is not set; pass the note text as arguments instead")
+			}
+
+			item, err := manager.GetWorkItem(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get work item: %w", err)
+			}
+
+			editorCmd := exec.Command(editor, filepath.Join(filepath.Dir(item.Path), "NOTES.md"))
+			editorCmd.Stdin = os.Stdin
+			editorCmd.Stdout = os.Stdout
+			editorCmd.Stderr = os.Stderr
+			if err := editorCmd.Run(); err != nil {
+				return fmt.Errorf("failed to run editor: %w", err)
+			}
+
 			return nil
 		},
 	})
 
-	// Assign commands
+	notesCmd.AddCommand(&cobra.Command{
+		Use:               "show [name]",
+		Short:             "Show work item notes",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			notes, err := manager.GetNotes(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get notes: %w", err)
+			}
+
+			if notes == "" {
+				fmt.Printf("No notes found for '%s'\n", args[0])
+				return nil
+			}
+
+			fmt.Print(notes)
+			return nil
+		},
+	})
+
+	// Split command
 	rootCmd.AddCommand(&cobra.Command{
+		Use:               "split [name] [new-name...]",
+		Short:             "Split a work item into linked child work items",
+		Long:              "Create child work items of the same type, each linked back to the parent with a child-of related item. The parent lists its children as parent-of related items and is labeled tracking. Children start from the template; move tasks between them by hand.",
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			children, err := manager.SplitWorkItem(ctx, args[0], args[1:])
+			if err != nil {
+				return fmt.Errorf("failed to split work item: %w", err)
+			}
+
+			fmt.Printf("✅ Split '%s' into %d work items:\n", args[0], len(children))
+			for _, child := range children {
+				fmt.Printf("  - %s\n", child.Name)
+			}
+			return nil
+		},
+	})
+
+	// Assign commands
+	assignCmd := &cobra.Command{
 		Use:   "assign [name] [assignee]",
 		Short: "Assign work item to human/agent",
-		Args:  cobra.ExactArgs(2),
+		Long: `Assign a work item to a human or agent.
+
+--assign-me assigns it to you instead of a named assignee: your git user name,
+or your OS user when git has no user configured.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if assignMe, _ := cmd.Flags().GetBool("assign-me"); assignMe {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		ValidArgsFunction: completeWorkItemNames(manager),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := manager.AssignWorkItem(ctx, args[0], args[1]); err != nil {
+			assignMe, _ := cmd.Flags().GetBool("assign-me")
+			var assignee string
+			if assignMe {
+				assignee = manager.CurrentUser()
+			} else {
+				assignee = args[1]
+			}
+
+			if err := manager.AssignWorkItem(ctx, args[0], assignee); err != nil {
 				return fmt.Errorf("failed to assign work item: %w", err)
 			}
 
-			fmt.Printf("✅ Assigned '%s' to %s\n", args[0], args[1])
+			if assignMe {
+				fmt.Printf("✅ Assigned '%s' to %s (you)\n", args[0], assignee)
+				return nil
+			}
+			fmt.Printf("✅ Assigned '%s' to %s\n", args[0], assignee)
 			return nil
 		},
-	}) // Instructions command
+	}
+	assignCmd.Flags().Bool("assign-me", false, "Assign the work item to yourself (your git user name, or OS user) instead of a named assignee")
+	rootCmd.AddCommand(assignCmd)
+	rootCmd.AddCommand(newPriorityCommand(manager))
+	rootCmd.AddCommand(newEstimateCommand(manager))
+	rootCmd.AddCommand(newLabelCommand(manager))
+	rootCmd.AddCommand(newDependsCommand(manager))
+	rootCmd.AddCommand(newRenameCommand(manager))
+
+	// Instructions command
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "instructions",
 		Short: "Print comprehensive guidelines for project contributors and AI agents",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			config := pm.DefaultConfig()
-			instructions := pm.GetInstructions(config)
+			instructions := pm.GetInstructions(*config)
 			fmt.Print(instructions)
 			return nil
 		},
@@ -510,10 +1417,36 @@ func main() {
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(phaseCmd)
 	rootCmd.AddCommand(progressCmd)
+	rootCmd.AddCommand(notesCmd)
+	rootCmd.AddCommand(newDigestCommand(manager, config))
+	rootCmd.AddCommand(newSyncCommand(manager, config))
+	rootCmd.AddCommand(newServeCommand(manager, config))
+	rootCmd.AddCommand(newMigrateCommand(manager, config))
+	rootCmd.AddCommand(newTasksCommand(manager))
+	rootCmd.AddCommand(newConfigCommand(config))
+	rootCmd.AddCommand(newTemplateCommand(config))
+	rootCmd.AddCommand(newRepairCommand(manager))
+	rootCmd.AddCommand(newOpenCommand(manager))
+	rootCmd.AddCommand(newExperimentCommand(manager))
+	rootCmd.AddCommand(newValidateCommand(manager, config))
+	rootCmd.AddCommand(newRefCommand(manager))
+	rootCmd.AddCommand(newMetaCommand(manager))
+	rootCmd.AddCommand(newHistoryCommand(manager))
+	rootCmd.AddCommand(newStatsCommand(manager, helper))
+	rootCmd.AddCommand(newTrashCommand(manager))
+	rootCmd.AddCommand(newDeleteCommand(manager))
+	rootCmd.AddCommand(newBundleCommand(manager))
+	rootCmd.AddCommand(newDiffCommand(manager))
+	rootCmd.AddCommand(newChangelogCommand(manager))
+	rootCmd.AddCommand(newMetricsCommand(manager))
 	rootCmd.AddCommand(versionCmd)
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		if jsonErrorsEnabled(outputFormat) {
+			writeJSONError(os.Stderr, err)
+		} else {
+			fmt.Println(err)
+		}
 		os.Exit(1)
 	}
 }
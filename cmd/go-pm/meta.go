@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newMetaCommand creates the meta command group for a work item's custom key-value fields
+func newMetaCommand(manager *pm.DefaultManager) *cobra.Command {
+	metaCmd := &cobra.Command{
+		Use:   "meta",
+		Short: "Manage custom key-value fields (sprint, cost center, ...) on work items",
+		Long: `Manage custom key-value fields kept as "- key: value" lines under the work
+item's "## Metadata" heading. Keys go-pm doesn't know about are preserved, and
+'meta list --output json' prints them as a JSON object, and list commands
+include them as "metadata" with '--output jsonl'.`,
+	}
+
+	metaCmd.AddCommand(&cobra.Command{
+		Use:               "set [name] [key] [value]",
+		Short:             "Set a custom field, replacing its current value",
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := manager.SetMetadata(cmd.Context(), args[0], args[1], args[2]); err != nil {
+				return fmt.Errorf("failed to set metadata: %w", err)
+			}
+
+			fmt.Printf("🔖 Set %s on '%s' to %s\n", args[1], args[0], args[2])
+			return nil
+		},
+	})
+
+	metaCmd.AddCommand(&cobra.Command{
+		Use:               "get [name] [key]",
+		Short:             "Print the value of a custom field",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		// A missing key is the answer, not a usage mistake
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			metadata, err := manager.GetMetadata(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get metadata: %w", err)
+			}
+			value, ok := metadata[args[1]]
+			if !ok {
+				return fmt.Errorf("work item '%s' has no metadata key %q", args[0], args[1])
+			}
+
+			if outputFormat == "json" {
+				return json.NewEncoder(os.Stdout).Encode(value)
+			}
+			fmt.Println(value)
+			return nil
+		},
+	})
+
+	metaCmd.AddCommand(&cobra.Command{
+		Use:               "list [name]",
+		Short:             "List a work item's custom fields",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			metadata, err := manager.GetMetadata(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get metadata: %w", err)
+			}
+
+			if outputFormat == "json" {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(metadata)
+			}
+			if len(metadata) == 0 {
+				fmt.Printf("No metadata on '%s'\n", args[0])
+				return nil
+			}
+			for _, key := range slices.Sorted(maps.Keys(metadata)) {
+				fmt.Printf("🔖 %s: %s\n", key, metadata[key])
+			}
+			return nil
+		},
+	})
+
+	return metaCmd
+}
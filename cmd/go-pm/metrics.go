@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newMetricsCommand creates the metrics command exporting backlog stats for monitoring systems
+func newMetricsCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Print backlog metrics for monitoring systems",
+		Long: `Print backlog statistics in a format scraped by monitoring systems.
+
+The prometheus format emits gauges in the text exposition format, e.g.
+gopm_workitems{status="IN_PROGRESS_EXECUTION"} 4 and gopm_overdue_total.
+The command renders once; run it from a scrape wrapper or textfile collector.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString("format")
+			if format != "prometheus" {
+				return fmt.Errorf("unsupported format %q (supported: prometheus)", format)
+			}
+
+			stats, err := manager.GetBacklogStats(cmd.Context(), pm.ScopeBacklog)
+			if err != nil {
+				return fmt.Errorf("failed to compute metrics: %w", err)
+			}
+
+			fmt.Print(stats.Prometheus())
+			return nil
+		},
+	}
+	cmd.Flags().String("format", "prometheus", "Output format (prometheus)")
+
+	return cmd
+}
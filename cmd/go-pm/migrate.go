@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newMigrateCommand creates the migrate command upgrading READMEs to the current schema version
+func newMigrateCommand(manager *pm.DefaultManager, config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [name|all]",
+		Short: "Upgrade work item READMEs to the current format",
+		Long: fmt.Sprintf(`Upgrade work item READMEs written by older versions of go-pm to the current
+format (schema version %d), adding missing metadata headings and recording a
+"## Schema Version:" heading. Use "all" to migrate every backlog item and
+--dry-run to preview the lines that would be added without writing them.`, pm.CurrentSchemaVersion),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			names := args
+			if args[0] == "all" {
+				items, err := manager.ListWorkItems(ctx, pm.ListFilter{})
+				if err != nil {
+					return fmt.Errorf("failed to list work items: %w", err)
+				}
+				names = nil
+				for _, item := range items {
+					// Archived items are read-only history
+					if strings.HasPrefix(item.Path, config.BacklogDir+string(filepath.Separator)) {
+						names = append(names, item.Name)
+					}
+				}
+			}
+
+			migrated := 0
+			for _, name := range names {
+				if dryRun {
+					after, changed, err := manager.PreviewMigration(ctx, name)
+					if err != nil {
+						return fmt.Errorf("failed to preview migration: %w", err)
+					}
+					if changed {
+						item, err := manager.GetWorkItem(ctx, name)
+						if err != nil {
+							return fmt.Errorf("failed to preview migration: %w", err)
+						}
+						migrated++
+						printMigrationPreview(name, item.Path, after)
+					}
+					continue
+				}
+
+				changed, err := manager.MigrateWorkItem(ctx, name)
+				if err != nil {
+					return fmt.Errorf("failed to migrate work item: %w", err)
+				}
+				if changed {
+					migrated++
+					fmt.Printf("✅ Migrated '%s' to schema version %d\n", name, pm.CurrentSchemaVersion)
+				}
+			}
+
+			switch {
+			case migrated == 0:
+				fmt.Println("All work items are up to date")
+			case dryRun:
+				fmt.Printf("ℹ️  %d work item(s) would be migrated (dry run, nothing written)\n", migrated)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("dry-run", false, "Show the changes without writing them")
+
+	return cmd
+}
+
+// printMigrationPreview prints the lines a migration adds to the work item README at readmePath
+func printMigrationPreview(name, readmePath, after string) {
+	before, _ := os.ReadFile(readmePath)
+	beforeLines := strings.Split(string(before), "\n")
+
+	fmt.Printf("📝 %s\n", name)
+	j := 0
+	for _, line := range strings.Split(after, "\n") {
+		if j < len(beforeLines) && line == beforeLines[j] {
+			j++
+			continue
+		}
+		fmt.Printf("  + %s\n", line)
+	}
+}
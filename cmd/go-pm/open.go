@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/russross/blackfriday/v2"
+	"github.com/spf13/cobra"
+)
+
+// newOpenCommand creates the open command launching a work item's README in an editor or browser
+func newOpenCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open [name]",
+		Short: "Open a work item's README in $EDITOR or the browser",
+		Long: `Open a work item's README in $EDITOR.
+
+With --browser the README is rendered to a temporary HTML file that is opened
+in the default browser instead.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			browser, _ := cmd.Flags().GetBool("browser")
+
+			item, err := manager.GetWorkItem(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get work item: %w", err)
+			}
+
+			if !browser {
+				editor := os.Getenv("EDITOR")
+				if editor == "" {
+					return fmt.Errorf("$EDITOR is not set; use --browser to open a rendered copy instead")
+				}
+
+				editorCmd := exec.Command(editor, item.Path)
+				editorCmd.Stdin = os.Stdin
+				editorCmd.Stdout = os.Stdout
+				editorCmd.Stderr = os.Stderr
+				if err := editorCmd.Run(); err != nil {
+					return fmt.Errorf("failed to run editor: %w", err)
+				}
+				return nil
+			}
+
+			content, err := os.ReadFile(item.Path)
+			if err != nil {
+				return fmt.Errorf("failed to read work item: %w", err)
+			}
+
+			htmlPath, err := writeRenderedHTML(item.Name, content)
+			if err != nil {
+				return err
+			}
+			if err := openInBrowser(htmlPath); err != nil {
+				return fmt.Errorf("failed to open browser: %w", err)
+			}
+
+			fmt.Printf("🌐 Opened %s\n", htmlPath)
+			return nil
+		},
+	}
+	cmd.Flags().Bool("browser", false, "Render the README to HTML and open it in the default browser")
+
+	return cmd
+}
+
+// writeRenderedHTML renders markdown to a standalone HTML file in the temp directory
+func writeRenderedHTML(title string, markdown []byte) (string, error) {
+	body := blackfriday.Run(markdown)
+	page := fmt.Sprintf("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n%s</body>\n</html>\n",
+		html.EscapeString(title), body)
+
+	file, err := os.CreateTemp("", "go-pm-*.html")
+	if err != nil {
+		return "", fmt.Errorf("failed to create HTML file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.WriteString(page); err != nil {
+		return "", fmt.Errorf("failed to write HTML file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// openInBrowser opens path with the platform's default handler
+func openInBrowser(path string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// priorityCompletions are the priority levels accepted by `priority set` and `list --priority`
+var priorityCompletions = []string{"low", "medium", "high", "critical"}
+
+// newPriorityCommand creates the priority command group for triaging work items
+func newPriorityCommand(manager *pm.DefaultManager) *cobra.Command {
+	priorityCmd := &cobra.Command{
+		Use:   "priority",
+		Short: "Manage work item triage priority",
+	}
+
+	priorityCmd.AddCommand(&cobra.Command{
+		Use:               "set [name] [level]",
+		Short:             "Set a work item's priority (low, medium, high, critical)",
+		Long:              `Set a work item's triage priority, kept as its "## Priority:" heading. List work items of one priority with 'go-pm list all --priority high'.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNameAnd(manager, priorityCompletions),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			priority, err := parsePriority(args[1])
+			if err != nil {
+				return err
+			}
+			if err := manager.SetPriority(cmd.Context(), args[0], priority); err != nil {
+				return fmt.Errorf("failed to set priority: %w", err)
+			}
+
+			fmt.Printf("🚩 Set '%s' priority to: %s\n", args[0], priority)
+			return nil
+		},
+	})
+
+	return priorityCmd
+}
+
+// parsePriority converts a priority argument (e.g. "high") to a Priority
+func parsePriority(value string) (pm.Priority, error) {
+	if slices.Contains(priorityCompletions, strings.ToLower(value)) {
+		return pm.Priority(strings.ToUpper(value)), nil
+	}
+	return "", fmt.Errorf("invalid priority: %s. Valid priorities: %s", value, strings.Join(priorityCompletions, ", "))
+}
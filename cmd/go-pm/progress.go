@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newProgressReportCommand creates the progress report command that renders
+// progress reports for several work items at once
+func newProgressReportCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report [name...]",
+		Short: "Show progress reports for several work items, e.g. for a status meeting",
+		Long: `Show the progress report of each named work item, or of every backlog work
+item with --all, followed by the combined task completion across them.
+
+--output json prints an array of the items' progress metrics instead.`,
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			all, _ := cmd.Flags().GetBool("all")
+
+			names := args
+			switch {
+			case all && len(names) > 0:
+				return fmt.Errorf("--all can't be combined with work item names")
+			case all:
+				var err error
+				if names, err = backlogNames(ctx, manager); err != nil {
+					return err
+				}
+			case len(names) == 0:
+				return fmt.Errorf("name at least one work item, or use --all")
+			}
+
+			metrics := make([]pm.WorkItemMetrics, 0, len(names))
+			for _, name := range names {
+				m, err := manager.GetProgressMetrics(ctx, name)
+				if err != nil {
+					return fmt.Errorf("failed to get progress metrics for '%s': %w", name, err)
+				}
+				metrics = append(metrics, *m)
+			}
+
+			switch outputFormat {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(metrics)
+			case "text":
+			default:
+				return fmt.Errorf("unsupported output format %q (supported: text, json)", outputFormat)
+			}
+
+			if len(metrics) == 0 {
+				fmt.Println("No work items found")
+				return nil
+			}
+
+			tracker := pm.NewProgressTracker(pm.NewOSFileSystem())
+			total, completed := 0, 0
+			for _, m := range metrics {
+				fmt.Print(tracker.GetProgressReport(m))
+				fmt.Println()
+				total += m.TotalTasks
+				completed += m.CompletedTasks
+			}
+
+			progress := 0
+			if total > 0 {
+				progress = completed * 100 / total
+			}
+			fmt.Printf("📊 %d work item(s): %d%% (%d/%d tasks completed)\n", len(metrics), progress, completed, total)
+			return nil
+		},
+	}
+	cmd.Flags().Bool("all", false, "Report on every backlog work item")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newRefCommand creates the ref command group for a work item's external links
+func newRefCommand(manager *pm.DefaultManager) *cobra.Command {
+	refCmd := &cobra.Command{
+		Use:   "ref",
+		Short: "Manage external references (design docs, tickets, dashboards)",
+	}
+
+	refCmd.AddCommand(&cobra.Command{
+		Use:   "add [name] [label] [url]",
+		Short: "Add an external link to a work item",
+		Long: `Add a "- [label](url)" link under the work item's "## References" heading,
+creating the section if needed. The URL must be an absolute http or https URL.
+
+References are shown by 'go-pm status show' and listed in
+'go-pm progress show --format markdown' reports.`,
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ref := pm.Reference{Label: args[1], URL: args[2]}
+			if err := manager.AddReference(cmd.Context(), args[0], ref); err != nil {
+				return fmt.Errorf("failed to add reference: %w", err)
+			}
+
+			fmt.Printf("📎 Added reference to '%s': %s (%s)\n", args[0], ref.Label, ref.URL)
+			return nil
+		},
+	})
+
+	return refCmd
+}
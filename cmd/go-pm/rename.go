@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newRenameCommand creates the rename command for backlog work items
+func newRenameCommand(manager *pm.DefaultManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename [old] [new]",
+		Short: "Rename a work item",
+		Long: `Rename a backlog work item. Its directory is moved, a title that still matches
+the old name is updated, other work items that depend on it are updated and,
+when git integration is enabled, its branches are renamed. The new name may
+omit the type prefix; the work item keeps its type.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			item, err := manager.RenameWorkItem(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to rename work item: %w", err)
+			}
+
+			fmt.Printf("✏️  Renamed '%s' to '%s'\n", args[0], item.Name)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newRepairCommand creates the repair command for work item directories whose README.md is missing
+func newRepairCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair [name]",
+		Short: "Regenerate missing README.md files for work item directories",
+		Long: `Regenerate a minimal README.md for a work item directory whose README was deleted.
+
+The work item type is inferred from the directory prefix (feature-, bug-,
+experiment-) and the item restarts as PROPOSED. Without a name, every backlog
+directory that is missing a README.md is repaired.
+
+With --fix-phase, the "## Phase:" of the named work item, or of every backlog
+work item, is instead set to match its "## Status:".`,
+		Args: cobra.MaximumNArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := loadConfig(cmd); err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			names, err := manager.FindMissingReadmes(cmd.Context())
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if fixPhase, _ := cmd.Flags().GetBool("fix-phase"); fixPhase {
+				return repairPhases(ctx, manager, args)
+			}
+
+			names := args
+			if len(names) == 0 {
+				missing, err := manager.FindMissingReadmes(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to scan backlog: %w", err)
+				}
+				if len(missing) == 0 {
+					fmt.Println("No work item directories need repair")
+					return nil
+				}
+				names = missing
+			}
+
+			for _, name := range names {
+				item, err := manager.RepairWorkItem(ctx, name)
+				if err != nil {
+					return fmt.Errorf("failed to repair work item: %w", err)
+				}
+				fmt.Printf("🔧 Regenerated %s\n", item.Path)
+			}
+			fmt.Println("📝 Review the regenerated README(s) and restore status, phase and tasks as needed")
+
+			return nil
+		},
+	}
+	cmd.Flags().Bool("fix-phase", false, "Set each work item's phase to match its status instead of regenerating READMEs")
+
+	return cmd
+}
+
+// repairPhases sets the phase of the named work items, or of every backlog
+// work item, to match their status
+func repairPhases(ctx context.Context, manager pm.Manager, names []string) error {
+	if len(names) == 0 {
+		var err error
+		if names, err = backlogNames(ctx, manager); err != nil {
+			return err
+		}
+	}
+
+	fixed := 0
+	for _, name := range names {
+		changed, err := manager.RepairPhase(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to repair phase: %w", err)
+		}
+		if changed {
+			fixed++
+			item, err := manager.GetWorkItem(ctx, name)
+			if err != nil {
+				return fmt.Errorf("failed to get work item: %w", err)
+			}
+			fmt.Printf("🔧 %s: phase set to %s to match %s\n", name, item.Phase, item.Status)
+		}
+	}
+
+	if fixed == 0 {
+		fmt.Println("All phases already match their status")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newServeCommand creates the serve command exposing work items over HTTP
+func newServeCommand(manager *pm.DefaultManager, config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the work item HTTP API",
+		Long: `Serve work items as a JSON HTTP API until interrupted (Ctrl+C).
+
+Reads are open; POST /workitems, PATCH /workitems/{name} and
+POST /workitems/{name}/advance require "Authorization: Bearer <token>"
+matching api_token (PM_API_TOKEN). Without a token, writes are rejected.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, _ := cmd.Flags().GetString("addr")
+
+			if config.APIToken == "" {
+				fmt.Println("⚠️  api_token is not set; write endpoints are disabled")
+			}
+
+			server := &http.Server{
+				Addr:              addr,
+				Handler:           pm.NewAPIHandler(manager, config.APIToken),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			errCh := make(chan error, 1)
+			go func() { errCh <- server.ListenAndServe() }()
+			fmt.Printf("🌐 Serving work item API on %s\n", addr)
+
+			select {
+			case err := <-errCh:
+				return fmt.Errorf("server failed: %w", err)
+			case <-ctx.Done():
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("failed to shut down server: %w", err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("addr", "127.0.0.1:8080", "Address to listen on (reads need no token; use \":8080\" to listen on every interface)")
+
+	return cmd
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// newStatsCommand creates the stats command summarizing the backlog
+func newStatsCommand(manager *pm.DefaultManager, helper *pm.CLIHelper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show backlog statistics",
+		Long: `Show summary statistics for the backlog: work items per status and type,
+average progress, and how many items are stale or overdue. Archived items in
+the completed directory are counted with --include-completed.
+
+With --watch the stats are re-rendered every --interval until interrupted
+(Ctrl+C). When stdout is not a terminal, --watch renders once and exits.
+
+--output table renders the status and type counts as aligned columns.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			watch, _ := cmd.Flags().GetBool("watch")
+			interval, _ := cmd.Flags().GetDuration("interval")
+			includeCompleted, _ := cmd.Flags().GetBool("include-completed")
+
+			scope := pm.ScopeBacklog
+			if includeCompleted {
+				scope = pm.ScopeAll
+			}
+
+			render := func(ctx context.Context, clear bool) error {
+				stats, err := manager.GetBacklogStats(ctx, scope)
+				if err != nil {
+					return fmt.Errorf("failed to compute stats: %w", err)
+				}
+				if outputFormat == "table" {
+					if clear {
+						_, _ = fmt.Fprint(os.Stdout, clearScreen)
+					}
+					return helper.WriteStatsTable(os.Stdout, stats)
+				}
+				renderStats(os.Stdout, stats, clear)
+				return nil
+			}
+
+			if !watch || !pm.IsTerminal(os.Stdout) {
+				return render(cmd.Context(), false)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			return watchStats(ctx, interval, func() error { return render(ctx, true) })
+		},
+	}
+	cmd.Flags().Bool("watch", false, "Continuously refresh the stats until interrupted")
+	cmd.Flags().Duration("interval", 30*time.Second, "Refresh interval for --watch")
+	cmd.Flags().Bool("include-completed", false, "Include archived work items from the completed directory")
+	cmd.AddCommand(newAssigneeStatsCommand(manager))
+	cmd.AddCommand(newVelocityStatsCommand(manager))
+	cmd.AddCommand(newCompletionsStatsCommand(manager))
+	cmd.AddCommand(newBurndownStatsCommand(manager))
+
+	return cmd
+}
+
+// newAssigneeStatsCommand creates the stats subcommand showing workload per assignee
+func newAssigneeStatsCommand(manager *pm.DefaultManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "assignee",
+		Short: "Show unfinished work per assignee, busiest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			workload, err := manager.GetAssigneeWorkload(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to compute workload: %w", err)
+			}
+			renderAssigneeWorkload(os.Stdout, workload)
+			return nil
+		},
+	}
+}
+
+// newVelocityStatsCommand creates the stats subcommand showing tasks completed per week
+func newVelocityStatsCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "velocity",
+		Short: "Show tasks completed per week",
+		Long: `Show how many tasks were completed in each of the last --weeks weeks,
+across active and archived work items. Weeks start on Monday; completion
+dates come from the (done: YYYY-MM-DD) annotations "phase complete" records.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			weeks, _ := cmd.Flags().GetInt("weeks")
+
+			velocity, err := manager.GetVelocity(cmd.Context(), weeks)
+			if err != nil {
+				return fmt.Errorf("failed to compute velocity: %w", err)
+			}
+			renderVelocity(os.Stdout, velocity)
+			return nil
+		},
+	}
+	cmd.Flags().Int("weeks", 6, "Number of weeks to show, ending with the current week")
+
+	return cmd
+}
+
+// completionBuckets maps the --bucket values of `stats completions` to bucket sizes
+var completionBuckets = map[string]time.Duration{
+	"day":  pm.BucketDay,
+	"week": pm.BucketWeek,
+}
+
+// newCompletionsStatsCommand creates the stats subcommand showing tasks completed over time
+func newCompletionsStatsCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completions",
+		Short: "Show tasks completed per day or week, with a running total",
+		Long: `Show how many tasks were completed in each day or week since the first
+completion, across active and archived work items, with the running total for
+burn-up charts. Completion dates come from the (done: YYYY-MM-DD) annotations
+"phase complete" records; tasks without one are not counted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString("bucket")
+			bucket, ok := completionBuckets[name]
+			if !ok {
+				return fmt.Errorf("invalid bucket: %s. Valid buckets: day, week", name)
+			}
+
+			series, err := manager.GetCompletionTimeseries(cmd.Context(), bucket)
+			if err != nil {
+				return fmt.Errorf("failed to compute completions: %w", err)
+			}
+			renderCompletions(os.Stdout, series)
+			return nil
+		},
+	}
+	cmd.Flags().String("bucket", "day", "Bucket size (day, week)")
+	_ = cmd.RegisterFlagCompletionFunc("bucket", cobra.FixedCompletions([]string{"day", "week"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+// renderCompletions writes one line per bucket to w with its completions and running total
+func renderCompletions(w io.Writer, series []pm.TimeBucket) {
+	if len(series) == 0 {
+		_, _ = fmt.Fprintln(w, "No completed tasks with a completion date")
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%-10s %5s %6s\n", "START", "TASKS", "TOTAL")
+	for _, bucket := range series {
+		_, _ = fmt.Fprintf(w, "%-10s %5d %6d\n", bucket.Start.Format("2006-01-02"), bucket.Completed, bucket.Cumulative)
+	}
+}
+
+// newBurndownStatsCommand creates the stats subcommand charting the open tasks of a set of work items
+func newBurndownStatsCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burndown [name...]",
+		Short: "Chart the remaining tasks of a set of work items over time",
+		Long: `Chart how many tasks of the named work items, or of every backlog work item
+with --all, were left on each day, alongside the ideal line that burns them
+down at a steady pace to the latest due date among the items (or today when
+none has one). Completion dates come from the (done: YYYY-MM-DD) annotations
+"phase complete" records; completed tasks without one are left out.
+
+--output json prints the daily points instead, for external charting.`,
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			all, _ := cmd.Flags().GetBool("all")
+
+			names := args
+			switch {
+			case all && len(names) > 0:
+				return fmt.Errorf("--all can't be combined with work item names")
+			case all:
+				var err error
+				if names, err = backlogNames(ctx, manager); err != nil {
+					return err
+				}
+				if len(names) == 0 {
+					fmt.Println("No work items found")
+					return nil
+				}
+			case len(names) == 0:
+				return fmt.Errorf("name at least one work item, or use --all")
+			}
+
+			burndown, err := manager.BuildBurndown(ctx, names)
+			if err != nil {
+				return fmt.Errorf("failed to build burndown: %w", err)
+			}
+
+			switch outputFormat {
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(burndown)
+			case "text":
+				renderBurndown(os.Stdout, burndown)
+				return nil
+			default:
+				return fmt.Errorf("unsupported output format %q (supported: text, json)", outputFormat)
+			}
+		},
+	}
+	cmd.Flags().Bool("all", false, "Chart every backlog work item")
+
+	return cmd
+}
+
+// Burndown chart dimensions; longer burndowns are sampled down to burndownChartWidth days
+const (
+	burndownChartWidth  = 60
+	burndownChartHeight = 10
+)
+
+// renderBurndown writes an ASCII chart of the remaining tasks to w, with the
+// ideal line drawn as dots
+func renderBurndown(w io.Writer, burndown pm.Burndown) {
+	if burndown.TotalTasks == 0 || len(burndown.Points) == 0 {
+		_, _ = fmt.Fprintln(w, "No tasks to burn down")
+		return
+	}
+
+	points := burndown.Points
+	if len(points) > burndownChartWidth {
+		sampled := make([]pm.BurndownPoint, burndownChartWidth)
+		for i := range sampled {
+			sampled[i] = points[i*(len(points)-1)/(burndownChartWidth-1)]
+		}
+		points = sampled
+	}
+
+	total := float64(burndown.TotalTasks)
+	_, _ = fmt.Fprintf(w, "📉 Burndown: %d work item(s), %d tasks, target %s\n\n",
+		len(burndown.Items), burndown.TotalTasks, burndown.Target.Format("2006-01-02"))
+	for row := burndownChartHeight; row >= 1; row-- {
+		lower := total * float64(row-1) / burndownChartHeight
+		upper := total * float64(row) / burndownChartHeight
+
+		var line strings.Builder
+		for _, point := range points {
+			switch {
+			case float64(point.Remaining) > lower:
+				line.WriteString("█")
+			case point.Ideal > lower && point.Ideal <= upper:
+				line.WriteString("·")
+			default:
+				line.WriteString(" ")
+			}
+		}
+		label := ""
+		if row == burndownChartHeight {
+			label = fmt.Sprint(burndown.TotalTasks)
+		}
+		_, _ = fmt.Fprintln(w, strings.TrimRight(fmt.Sprintf("%5s │%s", label, line.String()), " "))
+	}
+	_, _ = fmt.Fprintf(w, "%5d └%s\n", 0, strings.Repeat("─", len(points)))
+	_, _ = fmt.Fprintf(w, "       %s → %s\n\n", points[0].Date.Format("2006-01-02"), points[len(points)-1].Date.Format("2006-01-02"))
+
+	last := burndown.Points[len(burndown.Points)-1]
+	_, _ = fmt.Fprintf(w, "█ remaining  · ideal\n")
+	_, _ = fmt.Fprintf(w, "Remaining: %d of %d tasks (ideal %.1f)\n", last.Remaining, burndown.TotalTasks, last.Ideal)
+}
+
+// velocityBarWidth is the width of the longest bar in the velocity chart
+const velocityBarWidth = 40
+
+// renderVelocity writes one bar per week to w, scaled to the busiest week
+func renderVelocity(w io.Writer, velocity []pm.WeeklyVelocity) {
+	busiest, total := 0, 0
+	for _, week := range velocity {
+		busiest = max(busiest, week.Completed)
+		total += week.Completed
+	}
+
+	_, _ = fmt.Fprintf(w, "%-10s %5s\n", "WEEK OF", "TASKS")
+	for _, week := range velocity {
+		bar := ""
+		if busiest > 0 {
+			bar = strings.Repeat("█", week.Completed*velocityBarWidth/busiest)
+		}
+		line := fmt.Sprintf("%-10s %5d %s", week.WeekStart.Format("2006-01-02"), week.Completed, bar)
+		_, _ = fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+	_, _ = fmt.Fprintf(w, "\nAverage: %.1f tasks/week\n", float64(total)/float64(len(velocity)))
+}
+
+// renderAssigneeWorkload writes the workload table to w, busiest assignee first
+func renderAssigneeWorkload(w io.Writer, workload map[string]pm.AssigneeStats) {
+	if len(workload) == 0 {
+		_, _ = fmt.Fprintln(w, "No unfinished work items")
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%-20s %11s %11s %8s\n", "ASSIGNEE", "IN PROGRESS", "DONE/TASKS", "OVERDUE")
+	for _, assignee := range pm.AssigneesByLoad(workload) {
+		stats := workload[assignee]
+		tasks := fmt.Sprintf("%d/%d", stats.CompletedTasks, stats.TotalTasks)
+		overdue := fmt.Sprintf("%8d", stats.Overdue)
+		if stats.Overdue > 0 {
+			overdue = colors().Red(overdue)
+		}
+		_, _ = fmt.Fprintf(w, "%-20s %11d %11s %s\n", assignee, stats.InProgress, tasks, overdue)
+	}
+}
+
+// renderStats writes the stats report to w, clearing the screen first if requested
+func renderStats(w io.Writer, stats pm.BacklogStats, clear bool) {
+	if clear {
+		_, _ = fmt.Fprint(w, clearScreen)
+	}
+	_, _ = fmt.Fprint(w, stats.Text())
+}
+
+// watchStats calls render immediately and then on every interval until ctx is cancelled.
+// Cancellation (e.g. SIGINT) is a clean exit, not an error.
+func watchStats(ctx context.Context, interval time.Duration, render func() error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := render(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// formatDuration renders a duration as days, hours and minutes (e.g. "2d 3h 15m")
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh %dm", days, hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
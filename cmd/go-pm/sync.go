@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newSyncCommand creates the sync command that mirrors work items to external trackers
+func newSyncCommand(manager *pm.DefaultManager, config *pm.Config) *cobra.Command {
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Mirror work item status to external trackers",
+	}
+
+	syncCmd.AddCommand(&cobra.Command{
+		Use:   "github [name]",
+		Short: "Push work item phases to their linked GitHub issues",
+		Long: `Reflect a work item's current phase on the GitHub issue it links with
+"## Issue: https://github.com/<owner>/<repo>/issues/<number>": the issue gets
+a "phase: <phase>" label, replacing other phase labels, and a comment with the
+phase, status and progress. Issues already labelled with the current phase are
+left alone, so syncing again doesn't repeat the comment.
+
+Without a name, every backlog work item with an issue link is synced. Requires
+github_token (PM_GITHUB_TOKEN) with permission to write issues.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if config.GitHubToken == "" {
+				return fmt.Errorf("no GitHub token configured; set github_token or PM_GITHUB_TOKEN")
+			}
+			github := pm.NewGitHubSync(config.GitHubToken, "", nil)
+
+			if len(args) == 1 {
+				item, err := manager.GetWorkItem(ctx, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to get work item: %w", err)
+				}
+				if err := github.PushStatus(ctx, *item); err != nil {
+					return fmt.Errorf("failed to sync %s: %w", item.Name, err)
+				}
+				fmt.Printf("✅ Synced '%s' to %s\n", item.Name, item.IssueURL)
+				return nil
+			}
+
+			items, err := manager.ListWorkItems(ctx, pm.ListFilter{})
+			if err != nil {
+				return fmt.Errorf("failed to list work items: %w", err)
+			}
+			synced, failed := 0, 0
+			for _, item := range items {
+				if item.IssueURL == "" {
+					continue
+				}
+				if err := github.PushStatus(ctx, item); err != nil {
+					fmt.Printf("❌ %s: %v\n", item.Name, err)
+					failed++
+					continue
+				}
+				fmt.Printf("✅ Synced '%s' to %s\n", item.Name, item.IssueURL)
+				synced++
+			}
+
+			if synced == 0 && failed == 0 {
+				fmt.Println("No work items link a GitHub issue")
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d work item(s) failed to sync", failed, synced+failed)
+			}
+			return nil
+		},
+	})
+
+	return syncCmd
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// dueTask is a task with a due date and the work item it belongs to
+type dueTask struct {
+	item string
+	task pm.Task
+}
+
+// newTasksCommand creates the tasks command listing task deadlines across work items
+func newTasksCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tasks",
+		Short: "List incomplete tasks with due dates across all work items",
+		Long: `List incomplete tasks that carry an inline "(due: YYYY-MM-DD)" annotation,
+across every unfinished work item and phase, soonest first. Use --overdue to
+show only tasks past their due date.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			overdueOnly, _ := cmd.Flags().GetBool("overdue")
+
+			items, err := manager.ListWorkItems(cmd.Context(), pm.ListFilter{})
+			if err != nil {
+				return fmt.Errorf("failed to list work items: %w", err)
+			}
+
+			now := time.Now()
+			var tasks []dueTask
+			for _, item := range items {
+				if item.Status == pm.StatusCompleted {
+					continue
+				}
+				for _, task := range item.Tasks {
+					if task.Resolved() || task.DueDate.IsZero() || (overdueOnly && !task.IsOverdue(now)) {
+						continue
+					}
+					tasks = append(tasks, dueTask{item: item.Name, task: task})
+				}
+			}
+
+			if len(tasks) == 0 {
+				if overdueOnly {
+					fmt.Println("No overdue tasks")
+				} else {
+					fmt.Println("No tasks with due dates")
+				}
+				return nil
+			}
+
+			slices.SortStableFunc(tasks, func(a, b dueTask) int {
+				return cmp.Or(a.task.DueDate.Compare(b.task.DueDate), cmp.Compare(a.item, b.item))
+			})
+			for _, t := range tasks {
+				due := t.task.DueDate.Format("2006-01-02")
+				if t.task.IsOverdue(now) {
+					due = colors().Red(due + " (overdue)")
+				}
+				fmt.Printf("📅 %s  %s [%s] %s\n", due, t.item, t.task.Phase, t.task.Description)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("overdue", false, "Only show tasks past their due date")
+
+	return cmd
+}
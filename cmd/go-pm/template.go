@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newTemplateCommand creates the template command for inspecting work item templates
+func newTemplateCommand(config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Inspect the templates used to create work items",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List work item types that have a template",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			processor := pm.NewTemplateProcessor(pm.NewOSFileSystem(), *config)
+			for _, itemType := range pm.TemplateTypes() {
+				source, err := processor.ResolveTemplate(itemType)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%-12s %s\n", itemType, templateSourceLabel(source))
+			}
+			return nil
+		},
+	})
+
+	showCmd := &cobra.Command{
+		Use:       "show [type]",
+		Short:     "Show where a type's template comes from and how it renders",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: templateTypeNames(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString("name")
+
+			processor := pm.NewTemplateProcessor(pm.NewOSFileSystem(), *config)
+			source, err := processor.ResolveTemplate(pm.ItemType(args[0]))
+			if err != nil {
+				return fmt.Errorf("failed to resolve template: %w", err)
+			}
+			rendered, err := processor.RenderTemplate(name, source.Type, nil)
+			if err != nil {
+				return fmt.Errorf("failed to render template: %w", err)
+			}
+
+			fmt.Printf("📄 Template: %s\n", source.Type)
+			fmt.Printf("📦 Source: %s\n\n", templateSourceLabel(source))
+			fmt.Print(rendered)
+			return nil
+		},
+	}
+	showCmd.Flags().String("name", "example", "Sample work item name substituted for {{name}}")
+	cmd.AddCommand(showCmd)
+
+	return cmd
+}
+
+// templateSourceLabel describes where a template was resolved from
+func templateSourceLabel(source pm.TemplateSource) string {
+	if source.Embedded {
+		return "embedded (" + source.Path + ")"
+	}
+	return source.Path
+}
+
+// templateTypeNames returns the template types for shell completion
+func templateTypeNames() []string {
+	var names []string
+	for _, itemType := range pm.TemplateTypes() {
+		names = append(names, string(itemType))
+	}
+	return names
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newTrashCommand creates the trash command that soft-deletes work items
+func newTrashCommand(manager *pm.DefaultManager) *cobra.Command {
+	trashCmd := &cobra.Command{
+		Use:   "trash [name]",
+		Short: "Move a work item to the trash, or list the trash when no name is given",
+		Long: `Move a backlog work item into the ".trash" directory next to the backlog
+instead of deleting it. Trashed items don't appear in any listing.
+
+Bring one back with 'go-pm trash restore [name]', or delete everything in
+the trash for good with 'go-pm trash empty'.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if len(args) == 1 {
+				dir, err := manager.TrashWorkItem(ctx, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to trash work item: %w", err)
+				}
+				fmt.Printf("🗑️  Moved '%s' to the trash: %s\n", args[0], dir)
+				fmt.Printf("💡 Restore it with: go-pm trash restore %s\n", args[0])
+				return nil
+			}
+
+			trashed, err := manager.ListTrash(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list trash: %w", err)
+			}
+			if len(trashed) == 0 {
+				fmt.Println("The trash is empty")
+				return nil
+			}
+			fmt.Println("Trashed work items:")
+			for _, item := range trashed {
+				fmt.Printf("  🗑️  %s (trashed %s)\n", item.Name, item.TrashedAt.Local().Format("2006-01-02 15:04"))
+			}
+			return nil
+		},
+	}
+
+	trashCmd.AddCommand(&cobra.Command{
+		Use:   "restore [name]",
+		Short: "Move the most recently trashed work item with this name back into the backlog",
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := loadConfig(cmd); err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			trashed, err := manager.ListTrash(cmd.Context())
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			names := make([]cobra.Completion, 0, len(trashed))
+			for _, item := range trashed {
+				names = append(names, item.Name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			item, err := manager.RestoreFromTrash(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to restore work item: %w", err)
+			}
+			fmt.Printf("♻️  Restored '%s' (%s)\n", item.Name, item.Status)
+			fmt.Printf("📁 Directory: %s\n", item.Path)
+			return nil
+		},
+	})
+
+	trashCmd.AddCommand(&cobra.Command{
+		Use:   "empty",
+		Short: "Permanently delete every work item in the trash",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deleted, err := manager.EmptyTrash(cmd.Context())
+			for _, name := range deleted {
+				fmt.Printf("❌ Deleted %s\n", name)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to empty trash: %w", err)
+			}
+			if len(deleted) == 0 {
+				fmt.Println("The trash is already empty")
+			}
+			return nil
+		},
+	})
+
+	return trashCmd
+}
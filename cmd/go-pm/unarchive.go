@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// newUnarchiveCommand creates the unarchive command for moving archived work items back to the backlog
+func newUnarchiveCommand(manager *pm.DefaultManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive [name]",
+		Short: "Move an archived work item back to the backlog",
+		Long: `Move a work item that was archived prematurely from the completed directory
+back to the backlog. Its POSTMORTEM.md is kept. With --reopen it is also set
+back to IN_PROGRESS_REVIEW so it can be finished again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reopen, _ := cmd.Flags().GetBool("reopen")
+
+			item, err := manager.UnarchiveWorkItem(cmd.Context(), args[0], reopen)
+			if err != nil {
+				return fmt.Errorf("failed to unarchive work item: %w", err)
+			}
+
+			fmt.Printf("📤 Unarchived '%s' (%s)\n", item.Name, item.Status)
+			fmt.Printf("📁 Directory: %s\n", item.Path)
+			return nil
+		},
+	}
+	cmd.Flags().Bool("reopen", false, "Set the work item back to IN_PROGRESS_REVIEW")
+	return cmd
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/bryankaraffa/go-pm/pkg/pm"
+	"github.com/spf13/cobra"
+)
+
+// validationSummary is the `--output json` form of a validate run
+type validationSummary struct {
+	Strict   bool                 `json:"strict"`
+	Passed   bool                 `json:"passed"`
+	Checked  int                  `json:"checked"`
+	Errors   int                  `json:"errors"`
+	Warnings int                  `json:"warnings"`
+	Items    []itemValidationInfo `json:"items"`
+}
+
+// itemValidationInfo lists the problems found in one work item
+type itemValidationInfo struct {
+	Name     string              `json:"name"`
+	Problems []validationProblem `json:"problems"`
+}
+
+// validationProblem is one problem with its severity
+type validationProblem struct {
+	Severity pm.Severity `json:"severity"`
+	Field    string      `json:"field"`
+	Value    string      `json:"value"`
+	Message  string      `json:"message"`
+}
+
+// newValidateCommand creates the validate command that reports work item inconsistencies
+func newValidateCommand(manager *pm.DefaultManager, config *pm.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [name|all|--all]",
+		Short: "Check work items for inconsistencies such as a phase that doesn't match the status",
+		Long: `Check a work item, or every backlog work item for "all", --all or when no
+name is given, for inconsistencies the parser accepts silently: a README missing
+its title heading, a valid "## Status:", a "## Phase:", a numeric "## Progress:"
+or any phase task section, or a "## Phase:" that doesn't match the
+"## Status:". With enable_git set, the item's git branch is checked too:
+it must exist, and with branch_per_phase so must the branch for the current
+phase, otherwise the item was likely advanced without go-pm. Checking every item
+also reports backlog directories without a README, and work items an
+interrupted archive left without a postmortem or in both the backlog and the
+completed directory.
+
+Branch problems are warnings and every other problem is an error. The command
+exits non-zero when errors are found, or any problem at all with --strict, so
+'go-pm validate all --strict' works as a CI gate. --output json prints a
+machine-readable summary.
+
+Fix a phase/status mismatch with 'go-pm repair --fix-phase [name]'.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkItemNames(manager),
+		// Problems are reported per item; usage would only obscure them
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			strict, _ := cmd.Flags().GetBool("strict")
+			all, _ := cmd.Flags().GetBool("all")
+			if all && len(args) == 1 && args[0] != "all" {
+				return fmt.Errorf("--all cannot be combined with a work item name")
+			}
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("unsupported output format %q (supported: text, json)", outputFormat)
+			}
+
+			summary := validationSummary{Strict: strict, Items: []itemValidationInfo{}}
+			if len(args) == 1 && args[0] != "all" {
+				problems, err := manager.ValidateWorkItem(ctx, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to validate work item: %w", err)
+				}
+				summary.add(args[0], problems)
+			} else if err := validateBacklog(ctx, manager, config.ListConcurrency, &summary); err != nil {
+				return err
+			}
+
+			invalid := 0
+			for _, item := range summary.Items {
+				if len(item.Problems) > 0 {
+					invalid++
+				}
+			}
+			summary.Passed = summary.Errors == 0 && (!strict || summary.Warnings == 0)
+
+			if outputFormat == "json" {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(summary); err != nil {
+					return err
+				}
+			} else {
+				printValidationSummary(summary)
+			}
+
+			if !summary.Passed {
+				return fmt.Errorf("%d of %d work item(s) have problems (%d error(s), %d warning(s))", invalid, summary.Checked, summary.Errors, summary.Warnings)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("strict", false, "Fail on warnings too, not only errors")
+	cmd.Flags().Bool("all", false, "Validate every backlog work item (same as 'validate all')")
+
+	return cmd
+}
+
+// validateBacklog validates every backlog work item into summary, up to
+// concurrency items at a time. Directories without a README and items that
+// can't be read are reported as errors rather than stopping the run.
+func validateBacklog(ctx context.Context, manager pm.Manager, concurrency int, summary *validationSummary) error {
+	names, err := backlogNames(ctx, manager)
+	if err != nil {
+		return err
+	}
+
+	results := make([][]pm.ValidationError, len(names))
+	slots := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			problems, err := manager.ValidateWorkItem(ctx, name)
+			if err != nil {
+				problems = []pm.ValidationError{{Field: "readme", Value: name, Message: err.Error()}}
+			}
+			results[i] = problems
+		}()
+	}
+	wg.Wait()
+	for i, name := range names {
+		summary.add(name, results[i])
+	}
+
+	missing, err := manager.FindMissingReadmes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find missing READMEs: %w", err)
+	}
+	for _, name := range missing {
+		summary.add(name, []pm.ValidationError{{
+			Field:   "readme",
+			Value:   name,
+			Message: fmt.Sprintf("work item directory has no README (run 'go-pm repair %s' to regenerate it)", name),
+		}})
+	}
+
+	orphaned, err := manager.FindOrphanedWorkItems(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find orphaned work items: %w", err)
+	}
+	for _, problem := range orphaned {
+		summary.add(problem.Value, []pm.ValidationError{problem})
+	}
+	return nil
+}
+
+// add records the problems found in the work item name
+func (s *validationSummary) add(name string, problems []pm.ValidationError) {
+	item := itemValidationInfo{Name: name, Problems: []validationProblem{}}
+	for _, problem := range problems {
+		severity := pm.ValidationSeverity(problem)
+		if severity == pm.SeverityWarning {
+			s.Warnings++
+		} else {
+			s.Errors++
+		}
+		item.Problems = append(item.Problems, validationProblem{
+			Severity: severity,
+			Field:    problem.Field,
+			Value:    problem.Value,
+			Message:  problem.Message,
+		})
+	}
+	s.Checked++
+	s.Items = append(s.Items, item)
+}
+
+// printValidationSummary prints each item's problems, marking items with only
+// warnings as such unless the run is strict
+func printValidationSummary(summary validationSummary) {
+	for _, item := range summary.Items {
+		switch {
+		case len(item.Problems) == 0:
+			fmt.Printf("✅ %s\n", item.Name)
+		case !summary.Strict && !hasErrors(item):
+			fmt.Printf("⚠️  %s\n", item.Name)
+		default:
+			fmt.Printf("❌ %s\n", colors().Red(item.Name))
+		}
+		for _, problem := range item.Problems {
+			fmt.Printf("   %s: %s '%s': %s\n", problem.Severity, problem.Field, problem.Value, problem.Message)
+		}
+	}
+}
+
+// hasErrors reports whether any of the item's problems is an error
+func hasErrors(item itemValidationInfo) bool {
+	for _, problem := range item.Problems {
+		if problem.Severity == pm.SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// backlogNames returns the names of every backlog work item
+func backlogNames(ctx context.Context, manager pm.Manager) ([]string, error) {
+	items, err := manager.ListWorkItems(ctx, pm.ListFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list work items: %w", err)
+	}
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names, nil
+}
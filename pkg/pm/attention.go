@@ -0,0 +1,76 @@
+package pm
+
+import (
+	"context"
+	"time"
+)
+
+// AttentionReason explains why a work item needs attention
+type AttentionReason string
+
+const (
+	AttentionOverdue AttentionReason = "overdue" // Unfinished and past its due date
+	AttentionStale   AttentionReason = "stale"   // In progress but not updated within PhaseTimeoutDays
+	AttentionInvalid AttentionReason = "invalid" // ValidateWorkItem reports errors
+)
+
+// AttentionItem is a work item that needs attention and the reasons why
+type AttentionItem struct {
+	Item     WorkItem          `json:"item"`
+	Reasons  []AttentionReason `json:"reasons"`
+	Problems []string          `json:"problems,omitempty"` // Validation errors, for AttentionInvalid
+}
+
+// ListNeedingAttention returns the backlog work items that are overdue, stale,
+// or fail validation, each tagged with every reason that applies, for a
+// morning triage in one place. Validation warnings, such as git branch
+// problems, don't count.
+//
+// Example:
+//
+//	items, err := service.ListNeedingAttention(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, attention := range items {
+//		fmt.Printf("%s: %v\n", attention.Item.Name, attention.Reasons)
+//	}
+func (s *WorkItemService) ListNeedingAttention(ctx context.Context) ([]AttentionItem, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	attention := []AttentionItem{}
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		entry := AttentionItem{Item: item}
+		if isOverdue(item, now) {
+			entry.Reasons = append(entry.Reasons, AttentionOverdue)
+		}
+		if isStale(item, s.config.PhaseTimeoutDays, now) {
+			entry.Reasons = append(entry.Reasons, AttentionStale)
+		}
+		problems, err := s.ValidateWorkItem(ctx, item.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, problem := range problems {
+			if ValidationSeverity(problem) == SeverityError {
+				entry.Problems = append(entry.Problems, problem.Error())
+			}
+		}
+		if len(entry.Problems) > 0 {
+			entry.Reasons = append(entry.Reasons, AttentionInvalid)
+		}
+
+		if len(entry.Reasons) > 0 {
+			attention = append(attention, entry)
+		}
+	}
+	return attention, nil
+}
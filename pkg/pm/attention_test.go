@@ -0,0 +1,54 @@
+package pm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNeedingAttention(t *testing.T) {
+	dir := t.TempDir()
+	config := DefaultConfig()
+	config.BacklogDir = filepath.Join(dir, "backlog")
+	config.CompletedDir = filepath.Join(dir, "completed")
+	config.PhaseTimeoutDays = 7
+	manager := NewDefaultManagerWithDeps(config, NewOSFileSystem(), NewNoOpGitClient())
+	ctx := context.Background()
+
+	write := func(name, status, phase, extra string) string {
+		itemDir := filepath.Join(config.BacklogDir, name)
+		require.NoError(t, os.MkdirAll(itemDir, 0755))
+		readme := filepath.Join(itemDir, "README.md")
+		content := "# Feature: " + name + "\n\n## Status: " + status + "\n## Phase: " + phase + "\n## Progress: 0%\n" + extra + "\n## Discovery Phase\n- [ ] Research\n"
+		require.NoError(t, os.WriteFile(readme, []byte(content), 0644))
+		return readme
+	}
+	write("feature-fine", "IN_PROGRESS_DISCOVERY", "discovery", "")
+	write("feature-late", "IN_PROGRESS_DISCOVERY", "discovery", "## Due Date: 2020-01-01\n")
+	stale := write("feature-stale", "IN_PROGRESS_PLANNING", "discovery", "")
+	old := time.Now().AddDate(0, 0, -30)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	items, err := manager.ListNeedingAttention(ctx)
+	require.NoError(t, err)
+	reasons := make(map[string][]AttentionReason)
+	for _, attention := range items {
+		reasons[attention.Item.Name] = attention.Reasons
+	}
+	assert.Equal(t, map[string][]AttentionReason{
+		"feature-late":  {AttentionOverdue},
+		"feature-stale": {AttentionStale, AttentionInvalid},
+	}, reasons, "feature-fine needs no attention")
+
+	for _, attention := range items {
+		if attention.Item.Name == "feature-stale" {
+			require.Len(t, attention.Problems, 1)
+			assert.Contains(t, attention.Problems[0], "phase")
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package pm
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxBundleFileSize caps each file unpacked by ImportBundle, so a corrupt or
+// hostile bundle can't fill the disk
+const maxBundleFileSize = 10 << 20
+
+// BundleImportOptions controls how ImportBundle names the imported work item
+// and handles one that already exists
+type BundleImportOptions struct {
+	Name      string // Import under this name instead of the bundled one ("" keeps it)
+	Overwrite bool   // Replace an existing work item with the same name
+}
+
+// ExportBundle writes a work item's directory, with its README, notes,
+// postmortem, history and any other files, to w as a zip archive, so it can be
+// shared with someone who doesn't have the repository. Every entry is stored
+// under a top-level directory named after the work item.
+//
+// Example:
+//
+//	out, err := os.Create("feature-user-auth.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer out.Close()
+//	if err := service.ExportBundle(ctx, "feature-user-auth", out); err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) ExportBundle(ctx context.Context, name string, w io.Writer) error {
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+	dir := s.itemDir(name)
+	if !s.fs.FileExists(filepath.Join(dir, s.config.WorkItemFile)) {
+		return &WorkItemError{Op: "bundle", Name: name, Err: s.missingReadmeError(dir)}
+	}
+
+	files, err := s.bundleFiles(dir, "")
+	if err != nil {
+		return &WorkItemError{Op: "bundle", Name: name, Err: fmt.Errorf("failed to list work item files: %w", err)}
+	}
+
+	zw := zip.NewWriter(w)
+	for _, rel := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := s.fs.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return &WorkItemError{Op: "bundle", Name: name, Err: fmt.Errorf("failed to read %s: %w", rel, err)}
+		}
+		entry, err := zw.CreateHeader(&zip.FileHeader{Name: path.Join(name, rel), Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return &WorkItemError{Op: "bundle", Name: name, Err: fmt.Errorf("failed to add %s: %w", rel, err)}
+		}
+		if _, err := entry.Write(data); err != nil {
+			return &WorkItemError{Op: "bundle", Name: name, Err: fmt.Errorf("failed to add %s: %w", rel, err)}
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return &WorkItemError{Op: "bundle", Name: name, Err: fmt.Errorf("failed to write bundle: %w", err)}
+	}
+	return nil
+}
+
+// bundleFiles returns the files under dir, recursively, as slash-separated
+// paths relative to the work item directory prefixed with rel
+func (s *WorkItemService) bundleFiles(dir, rel string) ([]string, error) {
+	names, err := s.fs.ListFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, name := range names {
+		files = append(files, path.Join(rel, name))
+	}
+
+	subdirs, err := s.fs.ListDirectories(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, subdir := range subdirs {
+		nested, err := s.bundleFiles(filepath.Join(dir, subdir), path.Join(rel, subdir))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, nested...)
+	}
+	return files, nil
+}
+
+// ImportBundle unpacks a zip archive written by ExportBundle into the backlog
+// and returns the imported work item. It fails with a ValidationError if a
+// work item with the same name exists, unless opts names it differently or
+// allows overwriting it. Entries that would land outside the work item
+// directory are rejected.
+//
+// Example:
+//
+//	f, err := os.Open("feature-user-auth.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	info, _ := f.Stat()
+//	item, err := service.ImportBundle(ctx, f, info.Size(), BundleImportOptions{Name: "feature-user-auth-2"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) ImportBundle(ctx context.Context, r io.ReaderAt, size int64, opts BundleImportOptions) (*WorkItem, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, &ValidationError{Field: "bundle", Value: "", Message: fmt.Sprintf("not a zip archive: %v", err)}
+	}
+
+	// Every entry must sit under one top-level directory named after the work item
+	bundled := ""
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		clean := path.Clean(f.Name)
+		root, rel, ok := strings.Cut(clean, "/")
+		if !ok || !filepath.IsLocal(filepath.FromSlash(clean)) {
+			return nil, &ValidationError{Field: "bundle", Value: f.Name, Message: "entry is outside the work item directory"}
+		}
+		if bundled == "" {
+			bundled = root
+		} else if root != bundled {
+			return nil, &ValidationError{Field: "bundle", Value: f.Name, Message: "bundle contains more than one work item"}
+		}
+		files[rel] = f
+	}
+	if _, ok := files[s.config.WorkItemFile]; !ok {
+		return nil, &ValidationError{Field: "bundle", Value: bundled, Message: fmt.Sprintf("bundle has no %s", s.config.WorkItemFile)}
+	}
+
+	name := bundled
+	if opts.Name != "" {
+		name = opts.Name
+	}
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	dir := s.itemDir(name)
+	if s.fs.DirectoryExists(dir) {
+		if !opts.Overwrite {
+			return nil, &ValidationError{Field: "name", Value: name, Message: "work item already exists; import it under another name or overwrite it"}
+		}
+		if err := s.fs.RemoveDirectory(dir); err != nil {
+			return nil, &WorkItemError{Op: "import", Name: name, Err: fmt.Errorf("failed to remove existing work item: %w", err)}
+		}
+	}
+
+	dir = filepath.Join(s.config.BacklogDir, name)
+	if err := s.fs.CreateDirectory(dir); err != nil {
+		return nil, &WorkItemError{Op: "import", Name: name, Err: fmt.Errorf("failed to create work item directory: %w", err)}
+	}
+	for rel, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, err := readBundleFile(f)
+		if err != nil {
+			return nil, &WorkItemError{Op: "import", Name: name, Err: fmt.Errorf("failed to read %s: %w", rel, err)}
+		}
+		dest := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := s.fs.CreateDirectory(filepath.Dir(dest)); err != nil {
+			return nil, &WorkItemError{Op: "import", Name: name, Err: fmt.Errorf("failed to create directory for %s: %w", rel, err)}
+		}
+		if err := s.fs.WriteFile(dest, data); err != nil {
+			return nil, &WorkItemError{Op: "import", Name: name, Err: fmt.Errorf("failed to write %s: %w", rel, err)}
+		}
+	}
+
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.relocateByStatus(name, item.Status); err != nil {
+		return nil, &WorkItemError{Op: "import", Name: name, Err: err}
+	}
+	item.Path = filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	return item, nil
+}
+
+// readBundleFile reads a zip entry, refusing entries larger than maxBundleFileSize
+func readBundleFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxBundleFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxBundleFileSize {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxBundleFileSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,105 @@
+package pm
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AppendNote(ctx, "feature-login", "Talked to the auth team"))
+	dir := filepath.Join(config.BacklogDir, "feature-login")
+	require.NoError(t, fs.CreateDirectory(filepath.Join(dir, "assets")))
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "assets", "flow.txt"), []byte("login flow")))
+
+	var buf bytes.Buffer
+	require.NoError(t, manager.ExportBundle(ctx, "feature-login", &buf))
+	assert.ErrorIs(t, manager.ExportBundle(ctx, "feature-missing", &bytes.Buffer{}), ErrWorkItemNotFound)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Contains(t, names, "feature-login/README.md")
+	assert.Contains(t, names, "feature-login/NOTES.md")
+	assert.Contains(t, names, "feature-login/assets/flow.txt")
+
+	bundle := bytes.NewReader(buf.Bytes())
+	size := int64(buf.Len())
+
+	// The bundled name is taken, so the import needs a new name or --overwrite
+	_, err = manager.ImportBundle(ctx, bundle, size, BundleImportOptions{})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	item, err := manager.ImportBundle(ctx, bundle, size, BundleImportOptions{Name: "feature-login-copy"})
+	require.NoError(t, err)
+	assert.Equal(t, "feature-login-copy", item.Name)
+	assert.Equal(t, StatusProposed, item.Status)
+	data, err := fs.ReadFile(filepath.Join(config.BacklogDir, "feature-login-copy", "assets", "flow.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "login flow", string(data))
+	notes, err := manager.GetNotes(ctx, "feature-login-copy")
+	require.NoError(t, err)
+	assert.Contains(t, notes, "Talked to the auth team")
+
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "stray.txt"), []byte("local only")))
+	_, err = manager.ImportBundle(ctx, bundle, size, BundleImportOptions{Overwrite: true})
+	require.NoError(t, err)
+	assert.False(t, fs.FileExists(filepath.Join(dir, "stray.txt")), "overwriting replaces the whole directory")
+	assert.True(t, fs.FileExists(filepath.Join(dir, "NOTES.md")))
+}
+
+func TestImportBundleRejectsUnsafeEntries(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	zipOf := func(files map[string]string) *bytes.Reader {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for name, content := range files {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	for name, files := range map[string]map[string]string{
+		"path traversal": {"feature-x/../../README.md": "# Feature: x"},
+		"absolute path":  {"/feature-x/README.md": "# Feature: x"},
+		"no directory":   {"README.md": "# Feature: x"},
+		"two items":      {"feature-x/README.md": "# Feature: x", "feature-y/README.md": "# Feature: y"},
+		"no readme":      {"feature-x/NOTES.md": "notes"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			bundle := zipOf(files)
+			_, err := manager.ImportBundle(ctx, bundle, bundle.Size(), BundleImportOptions{})
+			var validationErr *ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+		})
+	}
+
+	_, err := manager.ImportBundle(ctx, bytes.NewReader([]byte("not a zip")), 9, BundleImportOptions{})
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Empty(t, fs.files, "rejected bundles write nothing")
+}
@@ -0,0 +1,116 @@
+package pm
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// changelogSections lists the changelog headings in order, one per work item type
+var changelogSections = []struct {
+	itemType ItemType
+	heading  string
+}{
+	{TypeFeature, "Features"},
+	{TypeBug, "Bug Fixes"},
+	{TypeExperiment, "Experiments"},
+}
+
+// changelogEntry is a completed work item and when it was completed
+type changelogEntry struct {
+	item        WorkItem
+	completedAt time.Time
+}
+
+// GenerateChangelog renders markdown release notes for the work items, in
+// the backlog or archived, completed after since. Items are grouped by type,
+// oldest completion first, with their title and overview summary. The
+// completion time comes from the item's history, or from its README's
+// modification time when it has none.
+//
+// Example:
+//
+//	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)
+//	changelog, err := service.GenerateChangelog(ctx, since)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(changelog)
+func (s *WorkItemService) GenerateChangelog(ctx context.Context, since time.Time) (string, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{Status: StatusCompleted, Scope: ScopeAll})
+	if err != nil {
+		return "", err
+	}
+
+	var entries []changelogEntry
+	for _, item := range items {
+		completedAt, err := s.completionTime(item)
+		if err != nil {
+			return "", &WorkItemError{Op: "changelog", Name: item.Name, Err: fmt.Errorf("failed to read history: %w", err)}
+		}
+		if completedAt.After(since) {
+			entries = append(entries, changelogEntry{item: item, completedAt: completedAt})
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b changelogEntry) int {
+		return cmp.Or(a.completedAt.Compare(b.completedAt), cmp.Compare(a.item.Name, b.item.Name))
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changelog since %s\n", since.Format("2006-01-02"))
+	if len(entries) == 0 {
+		fmt.Fprintf(&b, "\nNo work items were completed since %s.\n", since.Format("2006-01-02"))
+		return b.String(), nil
+	}
+
+	for _, section := range changelogSections {
+		var written bool
+		for _, entry := range entries {
+			if entry.item.Type != section.itemType {
+				continue
+			}
+			if !written {
+				fmt.Fprintf(&b, "\n## %s\n\n", section.heading)
+				written = true
+			}
+			title := entry.item.Title
+			if title == "" {
+				title = entry.item.Name
+			}
+			fmt.Fprintf(&b, "- **%s** (`%s`, completed %s)\n", title, entry.item.Name, entry.completedAt.Format("2006-01-02"))
+			if entry.item.Summary != "" {
+				fmt.Fprintf(&b, "  %s\n", entry.item.Summary)
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// completionTime returns when a completed item last moved to COMPLETED
+// according to its history, or its last update when the history doesn't say
+func (s *WorkItemService) completionTime(item WorkItem) (time.Time, error) {
+	entries, err := s.readHistory(filepath.Dir(item.Path))
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, entry := range slices.Backward(entries) {
+		if entry.Field == HistoryFieldStatus && ItemStatus(entry.New) == StatusCompleted {
+			return entry.Timestamp, nil
+		}
+	}
+	return item.UpdatedAt, nil
+}
+
+// ResolveRefDate returns the commit date of a git ref, such as a release tag,
+// so it can be used as the starting point of a changelog
+func (s *WorkItemService) ResolveRefDate(ctx context.Context, ref string) (time.Time, error) {
+	date, err := s.git.GetRefDate(ref)
+	if err != nil {
+		return time.Time{}, &ValidationError{Field: "ref", Value: ref, Message: err.Error()}
+	}
+	return date, nil
+}
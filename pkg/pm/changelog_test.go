@@ -0,0 +1,63 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateChangelog(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, req := range []CreateRequest{
+		{Type: TypeFeature, Name: "login", Title: "Single sign-on"},
+		{Type: TypeBug, Name: "crash"},
+		{Type: TypeBug, Name: "old"},
+		{Type: TypeFeature, Name: "wip"},
+	} {
+		_, err := manager.CreateWorkItem(ctx, req)
+		require.NoError(t, err)
+	}
+	for _, name := range []string{"feature-login", "bug-crash"} {
+		require.NoError(t, manager.UpdateStatus(ctx, name, StatusCompleted))
+	}
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "bug-crash"))
+
+	// bug-old was completed long before the release
+	oldDir := filepath.Join(config.BacklogDir, "bug-old")
+	require.NoError(t, manager.UpdateStatus(ctx, "bug-old", StatusCompleted))
+	require.NoError(t, fs.WriteFile(filepath.Join(oldDir, historyFileName), nil))
+	require.NoError(t, manager.service.appendHistory(oldDir, HistoryEntry{
+		Timestamp: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Field: HistoryFieldStatus, New: string(StatusCompleted),
+	}))
+
+	git.SetRefDate("v1.0.0", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	since, err := manager.ResolveRefDate(ctx, "v1.0.0")
+	require.NoError(t, err)
+
+	changelog, err := manager.GenerateChangelog(ctx, since)
+	require.NoError(t, err)
+	assert.Contains(t, changelog, "# Changelog since 2024-01-01\n")
+	assert.Contains(t, changelog, "## Features\n\n- **Single sign-on** (`feature-login`, completed ")
+	assert.Contains(t, changelog, "## Bug Fixes\n\n- **crash** (`bug-crash`, completed ", "archived items are included")
+	assert.NotContains(t, changelog, "bug-old", "completed before since")
+	assert.NotContains(t, changelog, "feature-wip", "not completed")
+	assert.NotContains(t, changelog, "## Experiments")
+
+	empty, err := manager.GenerateChangelog(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Contains(t, empty, "No work items were completed since")
+
+	var validationErr *ValidationError
+	_, err = manager.ResolveRefDate(ctx, "v9.9.9")
+	assert.ErrorAs(t, err, &validationErr)
+}
@@ -0,0 +1,110 @@
+package pm
+
+import (
+	"os"
+	"strings"
+)
+
+// ColorMode controls when terminal output is colored
+type ColorMode string
+
+const (
+	ColorAuto   ColorMode = "auto"   // Color only when writing to a terminal and NO_COLOR is unset
+	ColorAlways ColorMode = "always" // Always emit color codes
+	ColorNever  ColorMode = "never"  // Never emit color codes
+)
+
+// ANSI escape sequences used by Colorizer
+const (
+	ansiReset  = "\033[0m"
+	ansiBold   = "\033[1m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+)
+
+// ParseColorMode parses a --color flag value
+func ParseColorMode(value string) (ColorMode, error) {
+	switch mode := ColorMode(strings.ToLower(value)); mode {
+	case ColorAuto, ColorAlways, ColorNever:
+		return mode, nil
+	}
+	return "", &ValidationError{Field: "color", Value: value, Message: "must be one of auto, always, never"}
+}
+
+// Colorizer wraps text in ANSI color codes when color output is enabled.
+// When disabled every method returns its input unchanged.
+type Colorizer struct {
+	enabled bool
+}
+
+// NewColorizer creates a colorizer for output written to out.
+// In auto mode color is enabled only if out is a terminal and the
+// NO_COLOR environment variable (https://no-color.org) is not set.
+func NewColorizer(mode ColorMode, out *os.File) *Colorizer {
+	switch mode {
+	case ColorAlways:
+		return &Colorizer{enabled: true}
+	case ColorNever:
+		return &Colorizer{enabled: false}
+	}
+
+	if _, noColor := os.LookupEnv("NO_COLOR"); noColor {
+		return &Colorizer{enabled: false}
+	}
+	return &Colorizer{enabled: out != nil && IsTerminal(out)}
+}
+
+// Enabled reports whether the colorizer emits color codes
+func (c *Colorizer) Enabled() bool {
+	return c != nil && c.enabled
+}
+
+// Green colors text green (completed)
+func (c *Colorizer) Green(text string) string {
+	return c.wrap(ansiGreen, text)
+}
+
+// Yellow colors text yellow (in progress)
+func (c *Colorizer) Yellow(text string) string {
+	return c.wrap(ansiYellow, text)
+}
+
+// Red colors text red (blocked or overdue)
+func (c *Colorizer) Red(text string) string {
+	return c.wrap(ansiRed, text)
+}
+
+// Bold renders text in bold
+func (c *Colorizer) Bold(text string) string {
+	return c.wrap(ansiBold, text)
+}
+
+// Status colors a status by workflow state: green for completed,
+// yellow for in progress, uncolored otherwise
+func (c *Colorizer) Status(status ItemStatus) string {
+	switch {
+	case status == StatusCompleted:
+		return c.Green(string(status))
+	case strings.HasPrefix(string(status), "IN_PROGRESS_"):
+		return c.Yellow(string(status))
+	}
+	return string(status)
+}
+
+// wrap surrounds text with the given escape sequence when enabled
+func (c *Colorizer) wrap(code, text string) string {
+	if !c.Enabled() {
+		return text
+	}
+	return code + text + ansiReset
+}
+
+// IsTerminal reports whether f is attached to a character device such as a terminal
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,38 @@
+package pm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorizer(t *testing.T) {
+	always := NewColorizer(ColorAlways, nil)
+	assert.True(t, always.Enabled())
+	assert.Equal(t, "\033[32mCOMPLETED\033[0m", always.Status(StatusCompleted))
+	assert.Equal(t, "\033[33mIN_PROGRESS_PLANNING\033[0m", always.Status(StatusInProgressPlanning))
+	assert.Equal(t, "PROPOSED", always.Status(StatusProposed))
+
+	never := NewColorizer(ColorNever, nil)
+	assert.False(t, never.Enabled())
+	assert.Equal(t, "COMPLETED", never.Status(StatusCompleted))
+	assert.Equal(t, "late", never.Red("late"))
+
+	// Auto mode never colors a non-terminal
+	assert.False(t, NewColorizer(ColorAuto, nil).Enabled())
+
+	t.Setenv("NO_COLOR", "1")
+	assert.False(t, NewColorizer(ColorAuto, nil).Enabled())
+	assert.True(t, NewColorizer(ColorAlways, nil).Enabled())
+}
+
+func TestParseColorMode(t *testing.T) {
+	mode, err := ParseColorMode("Always")
+	require.NoError(t, err)
+	assert.Equal(t, ColorAlways, mode)
+
+	_, err = ParseColorMode("sometimes")
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
@@ -0,0 +1,86 @@
+package pm
+
+import "os"
+
+// Config value sources reported by EffectiveConfig
+const (
+	ConfigSourceDefault  = "default"
+	ConfigSourceFile     = "file"
+	ConfigSourceEnv      = "env"
+	ConfigSourceOverride = "override"
+)
+
+// redactedConfigValue replaces secrets such as api_token and github_token in EffectiveConfig
+const redactedConfigValue = "<redacted>"
+
+// ConfigValue is one resolved config setting and where its value came from
+type ConfigValue struct {
+	Key    string `json:"key" yaml:"key"`       // Config file key (e.g. "backlog_dir")
+	Env    string `json:"env" yaml:"env"`       // Environment variable that overrides it
+	Value  any    `json:"value" yaml:"value"`   // Value in effect, with computed paths resolved
+	Source string `json:"source" yaml:"source"` // ConfigSourceDefault, ConfigSourceFile, ConfigSourceEnv or ConfigSourceOverride
+}
+
+// EffectiveConfig lists every setting of config with the source of its value.
+// Secrets are redacted.
+// ConfigOverrides take precedence over environment variables, which take
+// precedence over the config file, which takes precedence over defaults. Values are read from config, so BacklogDir and
+// CompletedDir show the computed paths.
+func EffectiveConfig(config Config) []ConfigValue {
+	values := map[string]any{
+		"auto_detect_repo_root":       config.AutoDetectRepoRoot,
+		"backlog_dir":                 config.BacklogDir,
+		"completed_dir":               config.CompletedDir,
+		"phase_timeout_days":          config.PhaseTimeoutDays,
+		"enable_git":                  config.EnableGit,
+		"branch_per_phase":            config.BranchPerPhase,
+		"auto_commit":                 config.AutoCommit,
+		"branch_prefix":               config.BranchPrefix,
+		"branch_separator":            config.BranchSeparator,
+		"webhook_url":                 config.WebhookURL,
+		"work_item_file":              config.WorkItemFile,
+		"reviewer":                    config.Reviewer,
+		"api_token":                   config.APIToken,
+		"github_token":                config.GitHubToken,
+		"default_assignee_by_type":    config.DefaultAssigneeByType,
+		"phase_advance_strict":        config.PhaseAdvanceStrict,
+		"phase_requirements":          config.PhaseRequirements,
+		"postmortem_template":         config.PostmortemTemplate,
+		"templates_dir":               config.TemplatesDir,
+		"warn_phase_mismatch":         config.WarnPhaseMismatch,
+		"status_directories":          config.StatusDirectories,
+		"list_concurrency":            config.ListConcurrency,
+		"progress_mismatch_threshold": config.ProgressMismatchThreshold,
+	}
+
+	effective := make([]ConfigValue, 0, len(configSettings))
+	overridden := config.overrides.settings()
+	for _, setting := range configSettings {
+		source := ConfigSourceDefault
+		if _, ok := overridden[setting.Key]; ok {
+			source = ConfigSourceOverride
+		} else if os.Getenv(setting.Env) != "" { // Like viper, an empty environment variable counts as unset
+			source = ConfigSourceEnv
+		} else if configViper.InConfig(setting.Key) {
+			source = ConfigSourceFile
+		}
+
+		value := values[setting.Key]
+		if (setting.Key == "api_token" || setting.Key == "github_token") && value != "" {
+			value = redactedConfigValue
+		}
+
+		effective = append(effective, ConfigValue{
+			Key:    setting.Key,
+			Env:    setting.Env,
+			Value:  value,
+			Source: source,
+		})
+	}
+	return effective
+}
+
+// ConfigFileUsed returns the path of the config file that was loaded, or "" if none was found
+func ConfigFileUsed() string {
+	return configViper.ConfigFileUsed()
+}
@@ -73,6 +73,10 @@ auto_detect_repo_root: false
 backlog_dir: "custom-backlog"
 enable_git: true
 phase_timeout_days: 10
+phase_requirements:
+  planning:
+    assignee: true
+    min_tasks: 2
 `
 	err := os.WriteFile(configFile, []byte(configContent), 0644)
 	require.NoError(t, err)
@@ -93,6 +97,32 @@ phase_timeout_days: 10
 	assert.Contains(t, config.BacklogDir, "custom-backlog")
 	assert.True(t, config.EnableGit)
 	assert.Equal(t, 10, config.PhaseTimeoutDays)
+	assert.Equal(t, map[WorkPhase]PhaseRequirement{PhasePlanning: {Assignee: true, MinTasks: 2}}, config.PhaseRequirements)
+}
+
+func TestConfigFileAtRepoRoot(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, exec.Command("git", "init", tempDir).Run())
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("phase_timeout_days: 12\n"), 0644))
+
+	subDir := filepath.Join(tempDir, "nested", "dir")
+	require.NoError(t, os.MkdirAll(subDir, 0755))
+
+	origWd, _ := os.Getwd()
+	require.NoError(t, os.Chdir(subDir))
+	defer func() {
+		_ = os.Chdir(origWd)
+		reloadConfigForTesting()
+	}()
+
+	// Running from a subdirectory still finds the repo-root config
+	reloadConfigForTesting()
+	assert.Equal(t, 12, DefaultConfig().PhaseTimeoutDays)
+
+	// A config in the working directory takes precedence
+	require.NoError(t, os.WriteFile(filepath.Join(subDir, "config.yaml"), []byte("phase_timeout_days: 3\n"), 0644))
+	reloadConfigForTesting()
+	assert.Equal(t, 3, DefaultConfig().PhaseTimeoutDays)
 }
 
 func TestDetectRepoRoot(t *testing.T) {
@@ -264,3 +294,124 @@ func TestAutoDetectFromSubdirectory(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, items, 0) // Should be empty since item was archived
 }
+
+func TestEffectiveConfigSources(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("phase_timeout_days: 10\nreviewer: alice\n"), 0644))
+
+	origWd, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tempDir))
+	defer func() {
+		_ = os.Chdir(origWd)
+		reloadConfigForTesting()
+	}()
+	t.Setenv("PM_AUTO_DETECT_REPO_ROOT", "false")
+	t.Setenv("PM_REVIEWER", "bob")
+	t.Setenv("PM_API_TOKEN", "secret")
+	t.Setenv("PM_GITHUB_TOKEN", "ghp_secret")
+	t.Setenv("PM_DEFAULT_ASSIGNEE_BY_TYPE", `{"bug": "oncall"}`)
+	t.Setenv("PM_PHASE_REQUIREMENTS", `{"discovery": {"overview": true}}`)
+	reloadConfigForTesting()
+
+	settings := make(map[string]ConfigValue)
+	for _, setting := range EffectiveConfig(DefaultConfig()) {
+		settings[setting.Key] = setting
+	}
+
+	assert.Len(t, settings, len(configSettings))
+	assert.Equal(t, ConfigSourceDefault, settings["enable_git"].Source)
+	assert.Equal(t, ConfigSourceFile, settings["phase_timeout_days"].Source)
+	assert.Equal(t, 10, settings["phase_timeout_days"].Value)
+	assert.Equal(t, ConfigSourceEnv, settings["reviewer"].Source, "env overrides the file")
+	assert.Equal(t, "bob", settings["reviewer"].Value)
+	assert.Equal(t, filepath.Join(".", "work-items/backlog"), settings["backlog_dir"].Value)
+	assert.Equal(t, redactedConfigValue, settings["api_token"].Value)
+	assert.Equal(t, redactedConfigValue, settings["github_token"].Value)
+	assert.Equal(t, "PM_BACKLOG_DIR", settings["backlog_dir"].Env)
+	assert.Contains(t, ConfigFileUsed(), "config.yaml")
+	assert.Equal(t, map[string]string{"bug": "oncall"}, DefaultConfig().DefaultAssigneeByType)
+	assert.Equal(t, map[WorkPhase]PhaseRequirement{PhaseDiscovery: {Overview: true}}, DefaultConfig().PhaseRequirements)
+}
+
+func TestUseRepo(t *testing.T) {
+	tempDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, exec.Command("git", "init", tempDir).Run())
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("phase_timeout_days: 21\n"), 0644))
+	subDir := filepath.Join(tempDir, "services", "api")
+	require.NoError(t, os.MkdirAll(subDir, 0755))
+	defer func() {
+		repoDir = "."
+		reloadConfigForTesting()
+	}()
+
+	// Auto-detection finds the git root containing the given path
+	require.NoError(t, UseRepo(subDir))
+	config := DefaultConfig()
+	assert.Equal(t, filepath.Join(tempDir, "work-items", "backlog"), config.BacklogDir)
+	assert.Equal(t, 21, config.PhaseTimeoutDays, "the repository's config file is loaded")
+
+	// Without auto-detection the path itself is the base directory
+	t.Setenv("PM_AUTO_DETECT_REPO_ROOT", "false")
+	require.NoError(t, UseRepo(subDir))
+	assert.Equal(t, filepath.Join(subDir, "work-items", "backlog"), DefaultConfig().BacklogDir)
+
+	assert.Error(t, UseRepo(filepath.Join(tempDir, "missing")))
+	assert.Error(t, UseRepo(filepath.Join(tempDir, "config.yaml")))
+}
+
+func TestDefaultConfigWithOverrides(t *testing.T) {
+	tempDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, exec.Command("git", "init", tempDir).Run())
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("enable_git: true\nlist_concurrency: 2\nphase_timeout_days: 21\n"), 0644))
+	subDir := filepath.Join(tempDir, "services", "api")
+	require.NoError(t, os.MkdirAll(subDir, 0755))
+	defer func() {
+		repoDir = "."
+		reloadConfigForTesting()
+	}()
+	t.Setenv("PM_LIST_CONCURRENCY", "3")
+
+	// Without overrides: env beats the file, which beats the default
+	config, err := DefaultConfigWithOverrides(ConfigOverrides{BaseDir: subDir})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tempDir, "work-items", "backlog"), config.BacklogDir)
+	assert.True(t, config.EnableGit)
+	assert.Equal(t, 3, config.ListConcurrency)
+	assert.Equal(t, 21, config.PhaseTimeoutDays)
+
+	// Overrides beat both env and the file
+	enableGit, concurrency := false, 4
+	config, err = DefaultConfigWithOverrides(ConfigOverrides{BaseDir: subDir, EnableGit: &enableGit, ListConcurrency: &concurrency})
+	require.NoError(t, err)
+	assert.False(t, config.EnableGit)
+	assert.Equal(t, 4, config.ListConcurrency)
+	assert.Equal(t, 21, config.PhaseTimeoutDays)
+	assert.True(t, DefaultConfig().EnableGit, "overrides don't carry over to later loads")
+	assert.Equal(t, 3, DefaultConfig().ListConcurrency)
+
+	sources := make(map[string]string)
+	for _, setting := range EffectiveConfig(config) {
+		sources[setting.Key] = setting.Source
+	}
+	assert.Equal(t, ConfigSourceOverride, sources["enable_git"])
+	assert.Equal(t, ConfigSourceOverride, sources["list_concurrency"])
+	assert.Equal(t, ConfigSourceFile, sources["phase_timeout_days"])
+	for _, setting := range EffectiveConfig(DefaultConfig()) {
+		if setting.Key == "enable_git" {
+			assert.Equal(t, ConfigSourceFile, setting.Source)
+		}
+	}
+
+	// Disabling auto-detection makes the base directory itself the root, so
+	// the repository's config file is no longer found
+	autoDetect := false
+	config, err = DefaultConfigWithOverrides(ConfigOverrides{BaseDir: subDir, AutoDetectRepoRoot: &autoDetect})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(subDir, "work-items", "backlog"), config.BacklogDir)
+	assert.Equal(t, 7, config.PhaseTimeoutDays)
+
+	_, err = DefaultConfigWithOverrides(ConfigOverrides{BaseDir: filepath.Join(tempDir, "missing")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+)
+
+// DeleteWorkItem permanently removes a work item's directory from the backlog
+// or the completed directory and, when Config.EnableGit is set, deletes its
+// item and phase branches. Unless force is set, only COMPLETED work items can
+// be deleted, so in-progress work isn't lost by accident; use TrashWorkItem
+// for a delete that can be undone.
+//
+// Example:
+//
+//	err := service.DeleteWorkItem(ctx, "feature-user-auth", false)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) DeleteWorkItem(ctx context.Context, name string, force bool) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+	dir := s.itemDir(name)
+	if !s.fs.DirectoryExists(dir) {
+		dir = filepath.Join(s.config.CompletedDir, name)
+		if !s.fs.DirectoryExists(dir) {
+			return &WorkItemError{Op: "delete", Name: name, Err: ErrWorkItemNotFound}
+		}
+	}
+
+	// A directory without a readable README has no status to protect
+	item, err := s.parser.ParseWorkItem(name, filepath.Join(dir, s.config.WorkItemFile))
+	if err != nil {
+		item = WorkItem{Name: name, Type: itemTypeFromDirName(name)}
+	}
+	if !force && item.Status != "" && item.Status != StatusCompleted {
+		return &WorkItemError{Op: "delete", Name: name, Err: fmt.Errorf("work item is %s, not COMPLETED; force the delete to remove unfinished work", item.Status)}
+	}
+
+	if err := s.fs.RemoveDirectory(dir); err != nil {
+		return &WorkItemError{Op: "delete", Name: name, Err: fmt.Errorf("failed to remove work item: %w", err)}
+	}
+
+	if s.config.EnableGit && item.Type != "" {
+		if _, err := s.git.DeleteWorkItemBranches(item.Type, name); err != nil {
+			return &WorkItemError{Op: "delete", Name: name, Err: fmt.Errorf("work item removed, but not all of its branches: %w", err)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerDeleteWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	config.EnableGit = true
+	config.BranchPerPhase = true
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "gone"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-gone"))
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "other"})
+	require.NoError(t, err)
+	itemBranch := manager.service.BranchName(TypeFeature, "gone")
+	phaseBranch := manager.service.git.PhaseBranchName(TypeFeature, "feature-gone", PhaseDiscovery)
+	require.True(t, git.BranchExists(itemBranch))
+	require.True(t, git.BranchExists(phaseBranch))
+
+	// Unfinished work needs force
+	err = manager.DeleteWorkItem(ctx, "feature-gone", false)
+	var workItemErr *WorkItemError
+	require.ErrorAs(t, err, &workItemErr)
+	assert.Contains(t, err.Error(), "not COMPLETED")
+	assert.True(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-gone")))
+
+	require.NoError(t, manager.DeleteWorkItem(ctx, "feature-gone", true))
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-gone")))
+	assert.False(t, git.BranchExists(itemBranch))
+	assert.False(t, git.BranchExists(phaseBranch))
+	assert.True(t, git.BranchExists(manager.service.BranchName(TypeFeature, "other")), "other items' branches are kept")
+
+	// Archived items are deleted from the completed directory
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-other", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-other"))
+	require.NoError(t, manager.DeleteWorkItem(ctx, "feature-other", false))
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.CompletedDir, "feature-other")))
+
+	err = manager.DeleteWorkItem(ctx, "feature-gone", true)
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
@@ -0,0 +1,217 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Dependency is a work item another work item depends on, with its status
+type Dependency struct {
+	Name   string     `json:"name"`
+	Status ItemStatus `json:"status,omitempty"` // Empty when no such work item exists
+}
+
+// String describes the dependency and its status, e.g. "feature-auth (IN_PROGRESS_PLANNING)"
+func (d Dependency) String() string {
+	if d.Status == "" {
+		return d.Name + " (not found)"
+	}
+	return fmt.Sprintf("%s (%s)", d.Name, d.Status)
+}
+
+// BlockedItem is a work item with dependencies that aren't COMPLETED yet
+type BlockedItem struct {
+	Item      WorkItem     `json:"item"`
+	BlockedBy []Dependency `json:"blocked_by"`
+}
+
+// AddDependency records that a work item can't start until dependency, in the
+// backlog or archived, is COMPLETED, on its "## Depends On:" line. AdvancePhase
+// refuses to move a PROPOSED item into discovery while a dependency is
+// unfinished. Dependencies that would form a cycle are rejected, and adding
+// one that is already listed is a no-op.
+//
+// Example:
+//
+//	err := service.AddDependency(ctx, "feature-sso", "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) AddDependency(ctx context.Context, name, dependency string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if err := validateWorkItemName(dependency); err != nil {
+		return err
+	}
+	if dependency == name {
+		return &ValidationError{Field: "dependency", Value: dependency, Message: "a work item cannot depend on itself"}
+	}
+
+	item, readmePath, err := s.backlogItem(name, "add_dependency")
+	if err != nil {
+		return err
+	}
+	if _, ok := s.findDependency(dependency); !ok {
+		return &WorkItemError{Op: "add_dependency", Name: name, Err: fmt.Errorf("dependency '%s': %w", dependency, ErrWorkItemNotFound)}
+	}
+	if slices.Contains(item.DependsOn, dependency) {
+		return nil
+	}
+	if s.dependsOn(dependency, name, map[string]bool{}) {
+		return &ValidationError{Field: "dependency", Value: dependency, Message: fmt.Sprintf("'%s' already depends on '%s', so this would form a cycle", dependency, name)}
+	}
+
+	if err := s.updater.UpdateDependencies(readmePath, append(item.DependsOn, dependency)); err != nil {
+		return &WorkItemError{Op: "add_dependency", Name: name, Err: fmt.Errorf("failed to update dependencies: %w", err)}
+	}
+	return nil
+}
+
+// RemoveDependency removes dependency from a work item's "## Depends On:"
+// line. It returns a ValidationError when the item doesn't depend on it.
+//
+// Example:
+//
+//	err := service.RemoveDependency(ctx, "feature-sso", "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) RemoveDependency(ctx context.Context, name, dependency string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	item, readmePath, err := s.backlogItem(name, "remove_dependency")
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(item.DependsOn, dependency) {
+		return &ValidationError{Field: "dependency", Value: dependency, Message: fmt.Sprintf("work item '%s' does not depend on it", name)}
+	}
+
+	dependsOn := slices.DeleteFunc(item.DependsOn, func(d string) bool { return d == dependency })
+	if err := s.updater.UpdateDependencies(readmePath, dependsOn); err != nil {
+		return &WorkItemError{Op: "remove_dependency", Name: name, Err: fmt.Errorf("failed to update dependencies: %w", err)}
+	}
+	return nil
+}
+
+// ListBlocked returns the unfinished backlog work items that have dependencies
+// which aren't COMPLETED yet, each with those dependencies. A dependency that
+// doesn't exist counts as unfinished.
+//
+// Example:
+//
+//	blocked, err := service.ListBlocked(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, b := range blocked {
+//		fmt.Printf("%s is waiting on %v\n", b.Item.Name, b.BlockedBy)
+//	}
+func (s *WorkItemService) ListBlocked(ctx context.Context) ([]BlockedItem, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{Scope: ScopeAll})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]ItemStatus, len(items))
+	for _, item := range items {
+		// An item both archived and in the backlog counts as completed
+		if statuses[item.Name] != StatusCompleted {
+			statuses[item.Name] = item.Status
+		}
+	}
+
+	blocked := []BlockedItem{}
+	for _, item := range items {
+		if item.Status == StatusCompleted {
+			continue
+		}
+		unmet := unmetDependencies(item, func(name string) (ItemStatus, bool) {
+			status, ok := statuses[name]
+			return status, ok
+		})
+		if len(unmet) > 0 {
+			blocked = append(blocked, BlockedItem{Item: item, BlockedBy: unmet})
+		}
+	}
+	return blocked, nil
+}
+
+// unmetDependencies returns the item's dependencies that aren't COMPLETED,
+// looking up their status with statusOf
+func unmetDependencies(item WorkItem, statusOf func(name string) (ItemStatus, bool)) []Dependency {
+	var unmet []Dependency
+	for _, name := range item.DependsOn {
+		status, _ := statusOf(name)
+		if status != StatusCompleted {
+			unmet = append(unmet, Dependency{Name: name, Status: status})
+		}
+	}
+	return unmet
+}
+
+// blockedReason explains which unfinished dependencies block a work item
+func blockedReason(unmet []Dependency) string {
+	names := make([]string, len(unmet))
+	for i, dependency := range unmet {
+		names[i] = dependency.String()
+	}
+	return "blocked by unfinished dependencies: " + strings.Join(names, ", ")
+}
+
+// dependencyStatus returns the status of a work item in the backlog or the
+// completed directory, and whether it exists
+func (s *WorkItemService) dependencyStatus(name string) (ItemStatus, bool) {
+	item, ok := s.findDependency(name)
+	return item.Status, ok
+}
+
+// findDependency parses a work item from the backlog, or from the completed
+// directory once archived
+func (s *WorkItemService) findDependency(name string) (WorkItem, bool) {
+	for _, dir := range []string{s.itemDir(name), filepath.Join(s.config.CompletedDir, name)} {
+		readmePath := filepath.Join(dir, s.config.WorkItemFile)
+		if !s.fs.FileExists(readmePath) {
+			continue
+		}
+		item, err := s.parser.ParseWorkItem(name, readmePath)
+		if err != nil {
+			continue
+		}
+		return item, true
+	}
+	return WorkItem{}, false
+}
+
+// dependsOn reports whether name depends on target, directly or through its
+// own dependencies
+func (s *WorkItemService) dependsOn(name, target string, seen map[string]bool) bool {
+	if seen[name] {
+		return false
+	}
+	seen[name] = true
+
+	item, ok := s.findDependency(name)
+	if !ok {
+		return false
+	}
+	for _, dependency := range item.DependsOn {
+		if dependency == target || s.dependsOn(dependency, target, seen) {
+			return true
+		}
+	}
+	return false
+}
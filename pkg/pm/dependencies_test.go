@@ -0,0 +1,69 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencies(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, req := range []CreateRequest{
+		{Type: TypeFeature, Name: "auth"},
+		{Type: TypeFeature, Name: "sso"},
+		{Type: TypeBug, Name: "login"},
+	} {
+		_, err := manager.CreateWorkItem(ctx, req)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, manager.AddDependency(ctx, "feature-sso", "feature-auth"))
+	require.NoError(t, manager.AddDependency(ctx, "feature-sso", "bug-login"))
+	require.NoError(t, manager.AddDependency(ctx, "feature-sso", "bug-login"), "adding an existing dependency is a no-op")
+
+	item, err := manager.GetWorkItem(ctx, "feature-sso")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-auth", "bug-login"}, item.DependsOn)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.AddDependency(ctx, "feature-auth", "feature-sso"), &validationErr, "cycles are rejected")
+	assert.ErrorAs(t, manager.AddDependency(ctx, "feature-auth", "feature-auth"), &validationErr)
+	assert.ErrorIs(t, manager.AddDependency(ctx, "feature-auth", "feature-missing"), ErrWorkItemNotFound)
+
+	// Unfinished dependencies block starting the item, naming the blockers
+	err = manager.AdvancePhase(ctx, "feature-sso")
+	var phaseErr *PhaseError
+	require.ErrorAs(t, err, &phaseErr)
+	assert.Contains(t, phaseErr.Reason, "feature-auth (PROPOSED)")
+	assert.Contains(t, phaseErr.Reason, "bug-login (PROPOSED)")
+
+	preview, err := manager.PreviewAdvance(ctx, "feature-sso")
+	require.NoError(t, err)
+	assert.False(t, preview.CanAdvance())
+
+	blocked, err := manager.ListBlocked(ctx)
+	require.NoError(t, err)
+	require.Len(t, blocked, 1)
+	assert.Equal(t, "feature-sso", blocked[0].Item.Name)
+	assert.Equal(t, []Dependency{{Name: "feature-auth", Status: StatusProposed}, {Name: "bug-login", Status: StatusProposed}}, blocked[0].BlockedBy)
+
+	// An archived dependency counts as done
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-auth", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-auth"))
+	require.NoError(t, manager.RemoveDependency(ctx, "feature-sso", "bug-login"))
+	assert.ErrorAs(t, manager.RemoveDependency(ctx, "feature-sso", "bug-login"), &validationErr)
+
+	blocked, err = manager.ListBlocked(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, blocked)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-sso"))
+	assert.True(t, fs.DirectoryExists(filepath.Join(config.CompletedDir, "feature-auth")))
+}
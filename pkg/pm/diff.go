@@ -0,0 +1,127 @@
+package pm
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// TaskChange describes how a task differs between two work items
+type TaskChange string
+
+const (
+	TaskAdded             TaskChange = "added"      // Only in the second work item
+	TaskRemoved           TaskChange = "removed"    // Only in the first work item
+	TaskCompletionChanged TaskChange = "completion" // In both, but checked off in only one
+)
+
+// TaskDiff is a task that differs between two work items. Tasks are matched
+// by phase and description.
+type TaskDiff struct {
+	Change      TaskChange `json:"change"`
+	Phase       WorkPhase  `json:"phase"`
+	Description string     `json:"description"`
+	CompletedA  bool       `json:"completed_a"` // Whether the task is completed in the first work item (false if absent)
+	CompletedB  bool       `json:"completed_b"` // Whether the task is completed in the second work item (false if absent)
+}
+
+// FieldDiff is a README field, such as the status, that differs between two work items
+type FieldDiff struct {
+	Field string `json:"field"`
+	A     string `json:"a"`
+	B     string `json:"b"`
+}
+
+// WorkItemDiff describes how two work items' status, phase, progress and
+// task checklists differ
+type WorkItemDiff struct {
+	A      string      `json:"a"`
+	B      string      `json:"b"`
+	Fields []FieldDiff `json:"fields"`
+	Tasks  []TaskDiff  `json:"tasks"`
+}
+
+// Empty reports whether the two work items have no differences
+func (d *WorkItemDiff) Empty() bool {
+	return len(d.Fields) == 0 && len(d.Tasks) == 0
+}
+
+// DiffWorkItems compares two work items, such as an experiment and its clone,
+// reporting the status, phase and progress that differ and the tasks that were
+// added, removed, or completed in only one of them. Tasks are matched by phase
+// and description, so a reworded task shows up as removed and added.
+//
+// Example:
+//
+//	diff, err := service.DiffWorkItems(ctx, "experiment-cache", "experiment-cache-v2")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, task := range diff.Tasks {
+//		fmt.Printf("%s: %s\n", task.Change, task.Description)
+//	}
+func (s *WorkItemService) DiffWorkItems(ctx context.Context, a, b string) (*WorkItemDiff, error) {
+	itemA, err := s.GetWorkItem(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	itemB, err := s.GetWorkItem(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &WorkItemDiff{A: a, B: b, Fields: []FieldDiff{}, Tasks: diffTasks(itemA.Tasks, itemB.Tasks)}
+	for _, field := range []FieldDiff{
+		{Field: "status", A: string(itemA.Status), B: string(itemB.Status)},
+		{Field: "phase", A: string(itemA.Phase), B: string(itemB.Phase)},
+		{Field: "progress", A: fmt.Sprintf("%d%%", itemA.Progress), B: fmt.Sprintf("%d%%", itemB.Progress)},
+	} {
+		if field.A != field.B {
+			diff.Fields = append(diff.Fields, field)
+		}
+	}
+	return diff, nil
+}
+
+// diffTasks matches tasks by phase and description, pairing duplicates in
+// order, and returns the differences sorted by phase
+func diffTasks(tasksA, tasksB []Task) []TaskDiff {
+	type taskKey struct {
+		phase       WorkPhase
+		description string
+	}
+	unmatched := make(map[taskKey][]int)
+	for i, task := range tasksB {
+		key := taskKey{task.Phase, task.Description}
+		unmatched[key] = append(unmatched[key], i)
+	}
+
+	diffs := []TaskDiff{}
+	matched := make([]bool, len(tasksB))
+	for _, task := range tasksA {
+		key := taskKey{task.Phase, task.Description}
+		candidates := unmatched[key]
+		if len(candidates) == 0 {
+			diffs = append(diffs, TaskDiff{Change: TaskRemoved, Phase: task.Phase, Description: task.Description, CompletedA: task.Completed})
+			continue
+		}
+		other := tasksB[candidates[0]]
+		matched[candidates[0]] = true
+		unmatched[key] = candidates[1:]
+		if task.Completed != other.Completed {
+			diffs = append(diffs, TaskDiff{Change: TaskCompletionChanged, Phase: task.Phase, Description: task.Description, CompletedA: task.Completed, CompletedB: other.Completed})
+		}
+	}
+	for i, task := range tasksB {
+		if !matched[i] {
+			diffs = append(diffs, TaskDiff{Change: TaskAdded, Phase: task.Phase, Description: task.Description, CompletedB: task.Completed})
+		}
+	}
+
+	phases := []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup}
+	slices.SortStableFunc(diffs, func(x, y TaskDiff) int {
+		return cmp.Compare(slices.Index(phases, x.Phase), slices.Index(phases, y.Phase))
+	})
+	return diffs
+}
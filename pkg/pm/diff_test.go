@@ -0,0 +1,79 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorkItems(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	write := func(name, readme string) {
+		dir := filepath.Join(config.BacklogDir, name)
+		require.NoError(t, fs.CreateDirectory(dir))
+		require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+	}
+	write("experiment-cache", `# Experiment: cache
+
+## Status: IN_PROGRESS_EXECUTION
+## Phase: execution
+## Progress: 50%
+
+## Planning Phase
+
+### Tasks
+- [x] Pick a cache
+
+## Execution Phase
+
+### Tasks
+- [x] Add cache
+- [ ] Measure hit rate
+- [ ] Tune TTL
+`)
+	write("experiment-cache-v2", `# Experiment: cache v2
+
+## Status: IN_PROGRESS_PLANNING
+## Phase: planning
+## Progress: 50%
+
+## Planning Phase
+
+### Tasks
+- [x] Pick a cache
+- [ ] Pick an eviction policy
+
+## Execution Phase
+
+### Tasks
+- [ ] Add cache
+- [ ] Measure hit rate
+`)
+
+	diff, err := manager.DiffWorkItems(ctx, "experiment-cache", "experiment-cache-v2")
+	require.NoError(t, err)
+	assert.False(t, diff.Empty())
+	assert.Equal(t, []FieldDiff{
+		{Field: "status", A: "IN_PROGRESS_EXECUTION", B: "IN_PROGRESS_PLANNING"},
+		{Field: "phase", A: "execution", B: "planning"},
+	}, diff.Fields, "progress is the same")
+	assert.Equal(t, []TaskDiff{
+		{Change: TaskAdded, Phase: PhasePlanning, Description: "Pick an eviction policy"},
+		{Change: TaskCompletionChanged, Phase: PhaseExecution, Description: "Add cache", CompletedA: true},
+		{Change: TaskRemoved, Phase: PhaseExecution, Description: "Tune TTL"},
+	}, diff.Tasks)
+
+	same, err := manager.DiffWorkItems(ctx, "experiment-cache", "experiment-cache")
+	require.NoError(t, err)
+	assert.True(t, same.Empty())
+
+	_, err = manager.DiffWorkItems(ctx, "experiment-cache", "experiment-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
@@ -0,0 +1,155 @@
+package pm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DigestSection identifies a section of the digest report
+type DigestSection string
+
+const (
+	DigestSectionStale   DigestSection = "stale"
+	DigestSectionOverdue DigestSection = "overdue"
+	DigestSectionBlocked DigestSection = "blocked"
+)
+
+// AllDigestSections lists every digest section in report order
+var AllDigestSections = []DigestSection{DigestSectionStale, DigestSectionOverdue, DigestSectionBlocked}
+
+// Digest summarizes work items that need follow-up.
+// It is built from the backlog independently of how it is delivered.
+type Digest struct {
+	GeneratedAt time.Time     // When the digest was built
+	Stale       []WorkItem    // In-progress items not updated within PhaseTimeoutDays
+	Overdue     []WorkItem    // Unfinished items past their due date
+	Blocked     []BlockedItem // Unfinished items waiting on dependencies that aren't COMPLETED
+}
+
+// Markdown renders the digest as a markdown report containing the given sections.
+// An empty section list renders every section.
+func (d Digest) Markdown(sections []DigestSection) string {
+	if len(sections) == 0 {
+		sections = AllDigestSections
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Work Item Digest (%s)\n", d.GeneratedAt.Format("2006-01-02"))
+
+	for _, section := range sections {
+		switch section {
+		case DigestSectionStale:
+			b.WriteString("\n## Stale\n\n")
+			if len(d.Stale) == 0 {
+				b.WriteString("No stale work items.\n")
+			}
+			for _, item := range d.Stale {
+				fmt.Fprintf(&b, "- **%s** (%s) last updated %s\n", item.Name, item.Status, item.UpdatedAt.Format("2006-01-02"))
+			}
+		case DigestSectionOverdue:
+			b.WriteString("\n## Overdue\n\n")
+			if len(d.Overdue) == 0 {
+				b.WriteString("No overdue work items.\n")
+			}
+			for _, item := range d.Overdue {
+				fmt.Fprintf(&b, "- **%s** (%s) was due %s\n", item.Name, item.Status, item.DueDate.Format("2006-01-02"))
+			}
+		case DigestSectionBlocked:
+			b.WriteString("\n## Blocked\n\n")
+			if len(d.Blocked) == 0 {
+				b.WriteString("No blocked work items.\n")
+			}
+			for _, blocked := range d.Blocked {
+				waiting := make([]string, 0, len(blocked.BlockedBy))
+				for _, dependency := range blocked.BlockedBy {
+					waiting = append(waiting, dependency.String())
+				}
+				fmt.Fprintf(&b, "- **%s** (%s) is waiting on %s\n", blocked.Item.Name, blocked.Item.Status, strings.Join(waiting, ", "))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// isStale reports whether an in-progress work item hasn't been updated within timeoutDays.
+// A non-positive timeout disables stale detection.
+func isStale(item WorkItem, timeoutDays int, now time.Time) bool {
+	if timeoutDays <= 0 || item.UpdatedAt.IsZero() {
+		return false
+	}
+	if !strings.HasPrefix(string(item.Status), "IN_PROGRESS_") {
+		return false
+	}
+	return now.Sub(item.UpdatedAt) > time.Duration(timeoutDays)*24*time.Hour
+}
+
+// IsOverdue reports whether the work item is unfinished and past its due date
+func (w WorkItem) IsOverdue(now time.Time) bool {
+	return isOverdue(w, now)
+}
+
+// IsOverdue reports whether the task is unresolved and past its due date
+func (t Task) IsOverdue(now time.Time) bool {
+	if t.DueDate.IsZero() || t.Resolved() {
+		return false
+	}
+	return now.After(t.DueDate.AddDate(0, 0, 1))
+}
+
+// isOverdue reports whether an unfinished work item is past its due date
+func isOverdue(item WorkItem, now time.Time) bool {
+	if item.DueDate.IsZero() || item.Status == StatusCompleted {
+		return false
+	}
+	return now.After(item.DueDate.AddDate(0, 0, 1))
+}
+
+// WebhookNotifier posts notifications to a chat webhook (Slack-compatible).
+// The HTTP client is injectable so delivery can be tested without the network.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a new webhook notifier.
+// If client is nil, http.DefaultClient is used.
+func NewWebhookNotifier(url string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &WebhookNotifier{url: url, client: client}
+}
+
+// Send posts text to the webhook as a {"text": "..."} JSON payload
+func (wn *WebhookNotifier) Send(ctx context.Context, text string) error {
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wn.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := wn.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package pm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDigest(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	err := fs.CreateDirectory(config.BacklogDir)
+	require.NoError(t, err)
+
+	// One overdue item, one with a future due date and one completed past its due date
+	for name, body := range map[string]string{
+		"feature-late":   "# Feature: late\n\n## Status: IN_PROGRESS_EXECUTION\n## Due Date: 2000-01-01\n",
+		"feature-future": "# Feature: future\n\n## Status: IN_PROGRESS_EXECUTION\n## Due Date: 2999-01-01\n",
+		"feature-done":   "# Feature: done\n\n## Status: COMPLETED\n## Due Date: 2000-01-01\n",
+	} {
+		dir := filepath.Join(config.BacklogDir, name)
+		require.NoError(t, fs.CreateDirectory(dir))
+		require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(body)))
+	}
+
+	digest, err := manager.BuildDigest(context.Background())
+	require.NoError(t, err)
+	require.Len(t, digest.Overdue, 1)
+	assert.Equal(t, "feature-late", digest.Overdue[0].Name)
+
+	report := digest.Markdown(nil)
+	assert.Contains(t, report, "## Stale")
+	assert.Contains(t, report, "## Overdue")
+	assert.Contains(t, report, "**feature-late**")
+
+	report = digest.Markdown([]DigestSection{DigestSectionOverdue})
+	assert.NotContains(t, report, "## Stale")
+	assert.Contains(t, report, "## Overdue")
+	assert.NotContains(t, report, "## Blocked")
+}
+
+func TestBuildDigestBlocked(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"auth", "sso"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+	}
+	require.NoError(t, manager.AddDependency(ctx, "feature-sso", "feature-auth"))
+
+	digest, err := manager.BuildDigest(ctx)
+	require.NoError(t, err)
+	require.Len(t, digest.Blocked, 1)
+	assert.Equal(t, "feature-sso", digest.Blocked[0].Item.Name)
+
+	report := digest.Markdown([]DigestSection{DigestSectionBlocked})
+	assert.Contains(t, report, "## Blocked")
+	assert.Contains(t, report, "**feature-sso** (PROPOSED) is waiting on feature-auth (PROPOSED)")
+	assert.NotContains(t, report, "## Stale")
+
+	// Completing the dependency unblocks the item
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-auth", StatusCompleted))
+	digest, err = manager.BuildDigest(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, digest.Blocked)
+	assert.Contains(t, digest.Markdown(nil), "No blocked work items.")
+}
+
+func TestIsStale(t *testing.T) {
+	now := time.Now()
+	item := WorkItem{Status: StatusInProgressExecution, UpdatedAt: now.Add(-10 * 24 * time.Hour)}
+
+	assert.True(t, isStale(item, 7, now))
+	assert.False(t, isStale(item, 14, now))
+	assert.False(t, isStale(item, 0, now))
+
+	item.Status = StatusProposed
+	assert.False(t, isStale(item, 7, now))
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, server.Client())
+	err := notifier.Send(context.Background(), "# Digest")
+	require.NoError(t, err)
+	assert.Equal(t, "# Digest", received["text"])
+}
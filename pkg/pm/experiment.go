@@ -0,0 +1,112 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Outcomes returns the outcomes an experiment can be concluded with
+func Outcomes() []Outcome {
+	return []Outcome{OutcomeSucceeded, OutcomeFailed, OutcomeInconclusive}
+}
+
+// validateOutcome validates an experiment outcome
+func (s *WorkItemService) validateOutcome(outcome Outcome) error {
+	if slices.Contains(Outcomes(), outcome) {
+		return nil
+	}
+	return &ValidationError{Field: "outcome", Value: string(outcome), Message: "invalid outcome (valid: succeeded, failed, inconclusive)"}
+}
+
+// ConcludeExperiment records an experiment's outcome as a "## Outcome:" heading.
+// A non-empty note is appended to the experiment's notes explaining the conclusion.
+// Concluding again replaces the previous outcome. Only experiments can be concluded.
+//
+// Example:
+//
+//	err := service.ConcludeExperiment(ctx, "experiment-cache", OutcomeSucceeded, "p95 latency down 40%")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) ConcludeExperiment(ctx context.Context, name string, outcome Outcome, note string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if err := s.validateOutcome(outcome); err != nil {
+		return err
+	}
+
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return err
+	}
+	if item.Type != TypeExperiment {
+		return &ValidationError{Field: "type", Value: string(item.Type), Message: "only experiments can be concluded"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if err := s.updater.UpdateOutcome(readmePath, outcome); err != nil {
+		return &WorkItemError{Op: "conclude", Name: name, Err: fmt.Errorf("failed to update outcome: %w", err)}
+	}
+
+	if note != "" {
+		return s.AppendNote(ctx, name, fmt.Sprintf("Concluded as %s: %s", outcome, note))
+	}
+	return nil
+}
+
+// GraduateExperiment creates a feature work item named featureName from an
+// experiment concluded as succeeded. The feature inherits the experiment's
+// title and priority and the two are linked with graduated-from and
+// graduated-to related items.
+//
+// Example:
+//
+//	feature, err := service.GraduateExperiment(ctx, "experiment-cache", "response-cache")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Created %s\n", feature.Name)
+func (s *WorkItemService) GraduateExperiment(ctx context.Context, name, featureName string) (*WorkItem, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if experiment.Type != TypeExperiment {
+		return nil, &ValidationError{Field: "type", Value: string(experiment.Type), Message: "only experiments can graduate"}
+	}
+	if experiment.Outcome != OutcomeSucceeded {
+		return nil, &ValidationError{Field: "outcome", Value: string(experiment.Outcome), Message: "only experiments concluded as succeeded can graduate"}
+	}
+
+	req := CreateRequest{Type: TypeFeature, Name: featureName, Priority: experiment.Priority}
+	if slug := strings.TrimPrefix(experiment.Name, string(TypeExperiment)+"-"); experiment.Title != slug {
+		req.Title = experiment.Title
+	}
+	feature, err := s.CreateWorkItem(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	featureReadme := filepath.Join(s.itemDir(feature.Name), s.config.WorkItemFile)
+	if err := s.updater.AddRelatedItem(featureReadme, RelatedItem{Relation: RelationGraduatedFrom, Name: name}); err != nil {
+		return nil, &WorkItemError{Op: "graduate", Name: feature.Name, Err: fmt.Errorf("failed to link experiment: %w", err)}
+	}
+	experimentReadme := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if err := s.updater.AddRelatedItem(experimentReadme, RelatedItem{Relation: RelationGraduatedTo, Name: feature.Name}); err != nil {
+		return nil, &WorkItemError{Op: "graduate", Name: name, Err: fmt.Errorf("failed to link feature: %w", err)}
+	}
+
+	return s.GetWorkItem(ctx, feature.Name)
+}
@@ -0,0 +1,71 @@
+package pm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcludeExperiment(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeExperiment, Name: "cache"})
+	require.NoError(t, err)
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+
+	require.NoError(t, manager.ConcludeExperiment(ctx, "experiment-cache", OutcomeInconclusive, ""))
+	require.NoError(t, manager.ConcludeExperiment(ctx, "experiment-cache", OutcomeSucceeded, "p95 latency down 40%"))
+
+	// Concluding again replaces the outcome
+	item, err := manager.GetWorkItem(ctx, "experiment-cache")
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeSucceeded, item.Outcome)
+
+	notes, err := manager.GetNotes(ctx, "experiment-cache")
+	require.NoError(t, err)
+	assert.Contains(t, notes, "Concluded as succeeded: p95 latency down 40%")
+
+	var validationErr *ValidationError
+	err = manager.ConcludeExperiment(ctx, "experiment-cache", "maybe", "")
+	assert.ErrorAs(t, err, &validationErr)
+	err = manager.ConcludeExperiment(ctx, "feature-login", OutcomeFailed, "")
+	assert.ErrorAs(t, err, &validationErr)
+	err = manager.ConcludeExperiment(ctx, "experiment-missing", OutcomeFailed, "")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestGraduateExperiment(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeExperiment, Name: "cache", Title: "Response caching", Priority: PriorityHigh})
+	require.NoError(t, err)
+
+	// Only succeeded experiments graduate
+	var validationErr *ValidationError
+	_, err = manager.GraduateExperiment(ctx, "experiment-cache", "response-cache")
+	assert.ErrorAs(t, err, &validationErr)
+	require.NoError(t, manager.ConcludeExperiment(ctx, "experiment-cache", OutcomeFailed, ""))
+	_, err = manager.GraduateExperiment(ctx, "experiment-cache", "response-cache")
+	assert.ErrorAs(t, err, &validationErr)
+
+	require.NoError(t, manager.ConcludeExperiment(ctx, "experiment-cache", OutcomeSucceeded, ""))
+	feature, err := manager.GraduateExperiment(ctx, "experiment-cache", "response-cache")
+	require.NoError(t, err)
+	assert.Equal(t, "feature-response-cache", feature.Name)
+	assert.Equal(t, "Response caching", feature.Title)
+	assert.Equal(t, PriorityHigh, feature.Priority)
+	assert.Equal(t, []RelatedItem{{Relation: RelationGraduatedFrom, Name: "experiment-cache"}}, feature.RelatedItems)
+
+	experiment, err := manager.GetWorkItem(ctx, "experiment-cache")
+	require.NoError(t, err)
+	assert.Equal(t, []RelatedItem{{Relation: RelationGraduatedTo, Name: "feature-response-cache"}}, experiment.RelatedItems)
+}
@@ -2,12 +2,16 @@ package pm
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
@@ -44,6 +48,13 @@ type FileSystem interface {
 	// MoveDirectory moves a directory from src to dst.
 	// This is equivalent to renaming the directory.
 	MoveDirectory(src, dst string) error
+
+	// RemoveDirectory deletes a directory and everything in it.
+	RemoveDirectory(path string) error
+
+	// CheckWritable reports why a directory at path can't be created or
+	// written to, or nil when it can.
+	CheckWritable(path string) error
 }
 
 // OSFileSystem implements FileSystem using the OS file system
@@ -70,20 +81,54 @@ func (fs *OSFileSystem) CreateDirectory(path string) error {
 }
 
 // CopyFile copies a file from src to dst.
-// If dst already exists, it will be overwritten. File permissions are set to 0644.
+// If dst already exists, it will be overwritten. The copy is written like WriteFile.
 func (fs *OSFileSystem) CopyFile(src, dst string) error {
 	data, err := os.ReadFile(src)
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(dst, data, 0o644)
+	return fs.WriteFile(dst, data)
 }
 
 // WriteFile writes data to a file.
-// The file is created if it doesn't exist, and truncated if it does.
-// File permissions are set to 0644 (rw-r--r--).
+// The file is created if it doesn't exist, and replaced if it does. New files
+// get permissions 0644 (rw-r--r--); replaced files keep theirs. The data is
+// written to a temporary file in the same directory and renamed into place,
+// so a crash mid-write leaves either the old or the new content, never a
+// truncated file.
 func (fs *OSFileSystem) WriteFile(path string, data []byte) error {
-	return os.WriteFile(path, data, 0o644)
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	cleanup := func(err error) error {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		return cleanup(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return cleanup(err)
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		return cleanup(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return cleanup(err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return cleanup(err)
+	}
+	return nil
 }
 
 // ReadFile reads the contents of a file.
@@ -146,6 +191,309 @@ func (fs *OSFileSystem) MoveDirectory(src, dst string) error {
 	return os.Rename(src, dst)
 }
 
+// RemoveDirectory deletes a directory and everything in it.
+// A path that doesn't exist is not an error.
+func (fs *OSFileSystem) RemoveDirectory(path string) error {
+	return os.RemoveAll(path)
+}
+
+// CheckWritable reports why a directory at path can't be created or written to.
+// It finds the nearest existing ancestor of path (path itself when it exists)
+// and creates and removes a probe file there.
+func (fs *OSFileSystem) CheckWritable(path string) error {
+	dir := path
+	for {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", dir)
+			}
+			break
+		}
+		parent := filepath.Dir(dir)
+		if !(errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR)) || parent == dir {
+			return err
+		}
+		dir = parent
+	}
+
+	probe, err := os.CreateTemp(dir, ".go-pm-write-check-*")
+	if err != nil {
+		return err
+	}
+	_ = probe.Close()
+	return os.Remove(probe.Name())
+}
+
+// MemFileSystem implements FileSystem in memory, for running go-pm without
+// touching disk, e.g. in a web service or a sandbox. Directories behave like
+// on the OS file system: writing a file requires its parent directory,
+// listings return only direct children, and moving a directory moves
+// everything in it. Paths are cleaned, so "a/b/../c" and "a/c" are the same
+// file. A MemFileSystem is safe for concurrent use.
+type MemFileSystem struct {
+	mu    sync.RWMutex
+	files map[string][]byte
+	dirs  map[string]bool
+}
+
+// NewMemFileSystem creates an empty in-memory file system. The current
+// directory (".") and the root ("/") always exist.
+//
+// Example:
+//
+//	fs := NewMemFileSystem()
+//	manager := NewDefaultManagerWithDeps(DefaultConfig(), fs, NewNoOpGitClient())
+//	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "user-auth"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func NewMemFileSystem() *MemFileSystem {
+	return &MemFileSystem{
+		files: make(map[string][]byte),
+		dirs:  map[string]bool{".": true, "/": true},
+	}
+}
+
+// CreateDirectory creates a directory and all necessary parents.
+// It fails if path or one of its parents is a file.
+func (fs *MemFileSystem) CreateDirectory(path string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	path = filepath.Clean(path)
+	var missing []string
+	for dir := path; !fs.dirs[dir]; dir = filepath.Dir(dir) {
+		if _, isFile := fs.files[dir]; isFile {
+			return &os.PathError{Op: "mkdir", Path: path, Err: syscall.ENOTDIR}
+		}
+		missing = append(missing, dir)
+	}
+	for _, dir := range missing {
+		fs.dirs[dir] = true
+	}
+	return nil
+}
+
+// CopyFile copies a file from src to dst.
+// If dst already exists, it will be overwritten.
+func (fs *MemFileSystem) CopyFile(src, dst string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	data, ok := fs.files[filepath.Clean(src)]
+	if !ok {
+		return &os.PathError{Op: "open", Path: src, Err: os.ErrNotExist}
+	}
+	return fs.writeFile(dst, data)
+}
+
+// WriteFile writes data to a file.
+// The file is created if it doesn't exist, and truncated if it does. Its
+// parent directory must exist.
+func (fs *MemFileSystem) WriteFile(path string, data []byte) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.writeFile(path, data)
+}
+
+// writeFile stores a copy of data at path; fs.mu must be held for writing
+func (fs *MemFileSystem) writeFile(path string, data []byte) error {
+	path = filepath.Clean(path)
+	if fs.dirs[path] {
+		return &os.PathError{Op: "open", Path: path, Err: syscall.EISDIR}
+	}
+	if !fs.dirs[filepath.Dir(path)] {
+		return &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+	fs.files[path] = slices.Clone(data)
+	return nil
+}
+
+// ReadFile reads the contents of a file.
+// Returns a copy of the file data, so callers may modify it.
+func (fs *MemFileSystem) ReadFile(path string) ([]byte, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	data, ok := fs.files[filepath.Clean(path)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+	return slices.Clone(data), nil
+}
+
+// FileExists checks if a file exists.
+// Returns false if the path is a directory or doesn't exist.
+func (fs *MemFileSystem) FileExists(path string) bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	_, ok := fs.files[filepath.Clean(path)]
+	return ok
+}
+
+// DirectoryExists checks if a directory exists.
+// Returns false if the path is a file or doesn't exist.
+func (fs *MemFileSystem) DirectoryExists(path string) bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	return fs.dirs[filepath.Clean(path)]
+}
+
+// ListDirectories lists the directories directly in a path, sorted by name.
+// The path must exist.
+func (fs *MemFileSystem) ListDirectories(path string) ([]string, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	path = filepath.Clean(path)
+	if !fs.dirs[path] {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+	var dirs []string
+	for dir := range fs.dirs {
+		if dir != path && filepath.Dir(dir) == path {
+			dirs = append(dirs, filepath.Base(dir))
+		}
+	}
+	slices.Sort(dirs)
+	return dirs, nil
+}
+
+// ListFiles lists the files directly in a path, sorted by name.
+// The path must exist.
+func (fs *MemFileSystem) ListFiles(path string) ([]string, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	path = filepath.Clean(path)
+	if !fs.dirs[path] {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+	var files []string
+	for file := range fs.files {
+		if filepath.Dir(file) == path {
+			files = append(files, filepath.Base(file))
+		}
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// MoveDirectory moves a directory, with all the files and directories in it,
+// from src to dst. Like os.Rename, dst's parent must exist, and dst must not
+// exist or be an empty directory.
+func (fs *MemFileSystem) MoveDirectory(src, dst string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	src, dst = filepath.Clean(src), filepath.Clean(dst)
+	if !fs.dirs[src] {
+		return &os.LinkError{Op: "rename", Old: src, New: dst, Err: os.ErrNotExist}
+	}
+	if src == dst {
+		return nil
+	}
+	if isWithin(dst, src) {
+		return &os.LinkError{Op: "rename", Old: src, New: dst, Err: syscall.EINVAL}
+	}
+	if !fs.dirs[filepath.Dir(dst)] {
+		return &os.LinkError{Op: "rename", Old: src, New: dst, Err: os.ErrNotExist}
+	}
+	if _, isFile := fs.files[dst]; isFile {
+		return &os.LinkError{Op: "rename", Old: src, New: dst, Err: syscall.ENOTDIR}
+	}
+	if fs.dirs[dst] && fs.hasChildren(dst) {
+		return &os.LinkError{Op: "rename", Old: src, New: dst, Err: syscall.ENOTEMPTY}
+	}
+
+	for dir := range fs.dirs {
+		if rel, ok := relativeWithin(dir, src); ok {
+			delete(fs.dirs, dir)
+			fs.dirs[filepath.Join(dst, rel)] = true
+		}
+	}
+	for file, data := range fs.files {
+		if rel, ok := relativeWithin(file, src); ok {
+			delete(fs.files, file)
+			fs.files[filepath.Join(dst, rel)] = data
+		}
+	}
+	return nil
+}
+
+// RemoveDirectory deletes a directory and everything in it.
+// A path that doesn't exist is not an error.
+func (fs *MemFileSystem) RemoveDirectory(path string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	path = filepath.Clean(path)
+	for dir := range fs.dirs {
+		if isWithin(dir, path) && dir != "." && dir != "/" {
+			delete(fs.dirs, dir)
+		}
+	}
+	for file := range fs.files {
+		if isWithin(file, path) {
+			delete(fs.files, file)
+		}
+	}
+	return nil
+}
+
+// CheckWritable reports why a directory at path can't be created: when path
+// or its nearest existing ancestor is a file. Memory is always writable.
+func (fs *MemFileSystem) CheckWritable(path string) error {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	for dir := filepath.Clean(path); !fs.dirs[dir]; dir = filepath.Dir(dir) {
+		if _, isFile := fs.files[dir]; isFile {
+			return fmt.Errorf("%s is not a directory", dir)
+		}
+	}
+	return nil
+}
+
+// hasChildren reports whether any file or directory is inside dir; fs.mu must be held
+func (fs *MemFileSystem) hasChildren(dir string) bool {
+	for d := range fs.dirs {
+		if d != dir && isWithin(d, dir) {
+			return true
+		}
+	}
+	for file := range fs.files {
+		if isWithin(file, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithin reports whether the cleaned path is dir or inside it
+func isWithin(path, dir string) bool {
+	_, ok := relativeWithin(path, dir)
+	return ok
+}
+
+// relativeWithin returns the cleaned path relative to dir, and whether path
+// is dir or inside it
+func relativeWithin(path, dir string) (string, bool) {
+	if path == dir {
+		return ".", true
+	}
+	prefix := dir + string(filepath.Separator)
+	if dir == string(filepath.Separator) {
+		prefix = dir
+	} else if dir == "." {
+		return path, !filepath.IsAbs(path)
+	}
+	rel, ok := strings.CutPrefix(path, prefix)
+	return rel, ok
+}
+
 // TemplateProcessor handles template processing for work items.
 // It copies template files and replaces placeholders with work item data.
 type TemplateProcessor struct {
@@ -159,28 +507,98 @@ func NewTemplateProcessor(fs FileSystem, config Config) *TemplateProcessor {
 	return &TemplateProcessor{fs: fs, config: config}
 }
 
-// ProcessTemplate processes an embedded template for a work item.
-// It replaces {{name}} placeholders with the work item name.
-// Templates are always sourced from embedded resources.
-func (tp *TemplateProcessor) ProcessTemplate(targetPath, name string, itemType ItemType) error {
-	// Get embedded template content
-	var embeddedContent string
+// ProcessTemplate processes the template for a work item, as resolved by
+// ResolveTemplate, and writes it to targetPath.
+// It replaces {{name}} placeholders with the work item name, then each
+// {{key}} placeholder with vars[key]. vars may be nil.
+func (tp *TemplateProcessor) ProcessTemplate(targetPath, name string, itemType ItemType, vars map[string]string) error {
+	processed, err := tp.RenderTemplate(name, itemType, vars)
+	if err != nil {
+		return err
+	}
+
+	// Write the processed content directly to target
+	return tp.fs.WriteFile(targetPath, []byte(processed))
+}
+
+// RenderTemplate returns the template for a work item type with
+// placeholders replaced as in ProcessTemplate, without writing it anywhere.
+func (tp *TemplateProcessor) RenderTemplate(name string, itemType ItemType, vars map[string]string) (string, error) {
+	source, err := tp.ResolveTemplate(itemType)
+	if err != nil {
+		return "", err
+	}
+
+	// Process built-in placeholders first so vars cannot override them
+	content := strings.ReplaceAll(source.Content, "{{name}}", name)
+	for key, value := range vars {
+		content = strings.ReplaceAll(content, "{{"+key+"}}", value)
+	}
+	return content, nil
+}
+
+// templatePlaceholderRegex matches "{{key}}" template placeholders
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{([\w.-]+)\}\}`)
+
+// UnreplacedPlaceholders returns the keys of "{{key}}" placeholders left in
+// rendered template content, in order of first appearance.
+func UnreplacedPlaceholders(content string) []string {
+	var keys []string
+	for _, match := range templatePlaceholderRegex.FindAllStringSubmatch(content, -1) {
+		if !slices.Contains(keys, match[1]) {
+			keys = append(keys, match[1])
+		}
+	}
+	return keys
+}
+
+// TemplateSource describes the template a work item type resolves to
+type TemplateSource struct {
+	Type     ItemType // Work item type the template is for
+	Embedded bool     // Whether the template is compiled into the binary
+	Path     string   // Template path, relative to the pm package when embedded
+	Content  string   // Raw template content with placeholders
+}
+
+// ResolveTemplate reports which template would be used for a work item type:
+// Config.TemplatesDir/workitem-<type>.md when that file exists, otherwise the
+// embedded template.
+func (tp *TemplateProcessor) ResolveTemplate(itemType ItemType) (TemplateSource, error) {
+	fileName := fmt.Sprintf("workitem-%s.md", itemType)
+	var content string
 	switch itemType {
 	case TypeFeature:
-		embeddedContent = embeddedTemplateWorkItemFeature
+		content = embeddedTemplateWorkItemFeature
 	case TypeBug:
-		embeddedContent = embeddedTemplateWorkItemBug
+		content = embeddedTemplateWorkItemBug
 	case TypeExperiment:
-		embeddedContent = embeddedTemplateWorkItemExperiment
+		content = embeddedTemplateWorkItemExperiment
 	default:
-		return fmt.Errorf("unsupported item type: %s", itemType)
+		return TemplateSource{}, fmt.Errorf("unsupported item type: %s", itemType)
 	}
 
-	// Process template placeholders
-	processed := strings.ReplaceAll(embeddedContent, "{{name}}", name)
+	if tp.config.TemplatesDir != "" {
+		path := filepath.Join(tp.config.TemplatesDir, fileName)
+		if tp.fs.FileExists(path) {
+			data, err := tp.fs.ReadFile(path)
+			if err != nil {
+				return TemplateSource{}, fmt.Errorf("failed to read template %s: %w", path, err)
+			}
+			return TemplateSource{Type: itemType, Path: path, Content: string(data)}, nil
+		}
+	}
 
-	// Write the processed content directly to target
-	return tp.fs.WriteFile(targetPath, []byte(processed))
+	return TemplateSource{
+		Type:     itemType,
+		Embedded: true,
+		Path:     "templates/" + fileName,
+		Content:  content,
+	}, nil
+}
+
+// TemplateTypes returns the work item types that have a template
+func TemplateTypes() []ItemType {
+	return []ItemType{TypeFeature, TypeBug, TypeExperiment}
 }
 
 // WorkItemParser parses work item metadata from README files.
@@ -200,10 +618,17 @@ func NewWorkItemParser(fs FileSystem) *WorkItemParser {
 // Returns a WorkItem struct with all parsed information.
 func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 	item := WorkItem{
-		Name:   name,
-		Path:   path,
-		Status: "UNKNOWN",
-		Phase:  PhaseDiscovery, // Default phase
+		Name:          name,
+		Path:          path,
+		Status:        "UNKNOWN",
+		Phase:         PhaseDiscovery, // Default phase
+		SchemaVersion: 1,              // READMEs predating the version marker
+		Labels:        []string{},
+		DependsOn:     []string{},
+		RelatedItems:  []RelatedItem{},
+		References:    []Reference{},
+		PhaseHistory:  []PhaseTransition{},
+		Tasks:         []Task{},
 	}
 
 	content, err := p.fs.ReadFile(path)
@@ -217,14 +642,57 @@ func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 	var phaseRegex = regexp.MustCompile(`##\s*Phase:\s*(\w+)`)
 	var progressRegex = regexp.MustCompile(`##\s*Progress:\s*(\d+)%`)
 	var assigneeRegex = regexp.MustCompile(`##\s*Assigned\s+To:\s*(.+)`)
+	var createdByRegex = regexp.MustCompile(`##\s*Created\s+By:\s*(.+)`)
+	var dueDateRegex = regexp.MustCompile(`##\s*Due\s+Date:\s*(\d{4}-\d{2}-\d{2})`)
+	var issueRegex = regexp.MustCompile(`##\s*Issue:\s*(\S+)`)
+	var priorityRegex = regexp.MustCompile(`##\s*Priority:\s*(\w+)`)
+	var estimateRegex = regexp.MustCompile(`##\s*Estimate:\s*(\d+(?:\.\d+)?)\s*([mhdw]?)\b`)
+	var labelsRegex = regexp.MustCompile(`##\s*Labels:(.*)`)
+	var dependsOnRegex = regexp.MustCompile(`##\s*Depends\s+On:(.*)`)
+	var outcomeRegex = regexp.MustCompile(`##\s*Outcome:\s*(\w+)`)
+	var schemaVersionRegex = regexp.MustCompile(`##\s*Schema\s+Version:\s*(\d+)`)
 	var phaseSectionRegex = regexp.MustCompile(`##\s+(\w+)\s+Phase`)
-	var taskRegex = regexp.MustCompile(`^\s*-\s*\[([ x])\]\s*(.+)$`)
+	var taskRegex = regexp.MustCompile(`^\s*-\s*\[([ x~])\]\s*(.+)$`)
+	var sectionRegex = regexp.MustCompile(`^##\s`)
+	var groupRegex = regexp.MustCompile(`^###\s+(.+?)\s*$`)
+	var relatedSectionRegex = regexp.MustCompile(`(?i)^##\s+Related\s+Items\s*$`)
+	var relatedItemRegex = regexp.MustCompile(`^\s*-\s*([A-Za-z][\w-]*):\s*(\S+)\s*$`)
+	var overviewSectionRegex = regexp.MustCompile(`(?i)^##\s+Overview\s*$`)
+	var referencesSectionRegex = regexp.MustCompile(`(?i)^##\s+References\s*$`)
+	var referenceRegex = regexp.MustCompile(`^\s*[-*]\s*\[([^\]]+)\]\((\S+)\)\s*$`)
+	var metadataSectionRegex = regexp.MustCompile(`(?i)^##\s+Metadata\s*$`)
+	var phaseHistorySectionRegex = regexp.MustCompile(`(?i)^##\s+Phase\s+History\s*$`)
+	var phaseHistoryEntryRegex = regexp.MustCompile(`^\s*-\s*(\w+):\s*(\S+)\s*$`)
 
 	currentPhase := PhaseDiscovery // Default to discovery
+	currentGroup := ""
+	inRelated := false
+	inReferences := false
+	inMetadata := false
+	inPhaseHistory := false
+	inOverview := false
+	var summary []string
 
 	for scanner.Scan() {
 		line := scanner.Text()
 
+		// Collect the first paragraph under "## Overview" as the summary; it
+		// ends at a blank line, the next heading or a "---" separator
+		if inOverview {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(trimmed, "#") || trimmed == "---":
+				inOverview = false
+			case trimmed == "":
+				inOverview = len(summary) == 0
+			default:
+				summary = append(summary, trimmed)
+			}
+		}
+		if overviewSectionRegex.MatchString(line) && len(summary) == 0 {
+			inOverview = true
+		}
+
 		// Extract title from first heading
 		if matches := titleRegex.FindStringSubmatch(line); len(matches) > 1 {
 			item.Title = strings.TrimSpace(matches[1])
@@ -252,6 +720,101 @@ func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 			item.AssignedTo = strings.TrimSpace(matches[1])
 		}
 
+		// Extract author
+		if matches := createdByRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.CreatedBy = strings.TrimSpace(matches[1])
+		}
+
+		// Extract priority
+		if matches := priorityRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.Priority = Priority(strings.ToUpper(matches[1]))
+		}
+
+		// Extract effort estimate; plain numbers are hours
+		if matches := estimateRegex.FindStringSubmatch(line); len(matches) > 2 {
+			if amount, err := strconv.ParseFloat(matches[1], 64); err == nil {
+				unit := matches[2]
+				if unit == "" {
+					unit = "h"
+				}
+				item.EstimatedHours = amount * estimateUnits[unit].Hours()
+			}
+		}
+
+		// Extract labels
+		if matches := labelsRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.Labels = parseLabels(matches[1])
+		}
+
+		// Extract dependencies
+		if matches := dependsOnRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.DependsOn = parseLabels(matches[1])
+		}
+
+		// Extract experiment outcome
+		if matches := outcomeRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.Outcome = Outcome(strings.ToLower(matches[1]))
+		}
+
+		// Extract schema version
+		if matches := schemaVersionRegex.FindStringSubmatch(line); len(matches) > 1 {
+			if version, err := strconv.Atoi(matches[1]); err == nil {
+				item.SchemaVersion = version
+			}
+		}
+
+		// Extract due date
+		if matches := dueDateRegex.FindStringSubmatch(line); len(matches) > 1 {
+			if dueDate, err := time.ParseInLocation("2006-01-02", matches[1], time.Local); err == nil {
+				item.DueDate = dueDate
+			}
+		}
+
+		// Extract the linked GitHub issue
+		if matches := issueRegex.FindStringSubmatch(line); len(matches) > 1 {
+			item.IssueURL = matches[1]
+		}
+
+		// Track task groups: a "###" subheading starts a group, the next "##" section ends it.
+		// The template's default "### Tasks" list is left ungrouped.
+		if sectionRegex.MatchString(line) {
+			currentGroup = ""
+			inRelated = relatedSectionRegex.MatchString(line)
+			inReferences = referencesSectionRegex.MatchString(line)
+			inMetadata = metadataSectionRegex.MatchString(line)
+			inPhaseHistory = phaseHistorySectionRegex.MatchString(line)
+		} else if inRelated {
+			if matches := relatedItemRegex.FindStringSubmatch(line); len(matches) > 2 {
+				item.RelatedItems = append(item.RelatedItems, RelatedItem{Relation: Relation(strings.ToLower(matches[1])), Name: matches[2]})
+			}
+		} else if inReferences {
+			// Only markdown link bullets are references; other bullets are ignored
+			if matches := referenceRegex.FindStringSubmatch(line); len(matches) > 2 {
+				item.References = append(item.References, Reference{Label: strings.TrimSpace(matches[1]), URL: matches[2]})
+			}
+			continue
+		} else if inMetadata {
+			if matches := metadataEntryRegex.FindStringSubmatch(line); len(matches) > 2 {
+				if item.Metadata == nil {
+					item.Metadata = make(map[string]string)
+				}
+				item.Metadata[matches[1]] = matches[2]
+			}
+			continue
+		} else if inPhaseHistory {
+			if matches := phaseHistoryEntryRegex.FindStringSubmatch(line); len(matches) > 2 {
+				if enteredAt, err := time.Parse(time.RFC3339, matches[2]); err == nil {
+					item.PhaseHistory = append(item.PhaseHistory, PhaseTransition{Phase: WorkPhase(strings.ToLower(matches[1])), EnteredAt: enteredAt})
+				}
+			}
+			continue
+		} else if matches := groupRegex.FindStringSubmatch(line); len(matches) > 1 {
+			currentGroup = matches[1]
+			if strings.EqualFold(currentGroup, "Tasks") {
+				currentGroup = ""
+			}
+		}
+
 		// Check for phase section headers
 		if matches := phaseSectionRegex.FindStringSubmatch(line); len(matches) > 1 {
 			phaseName := strings.ToLower(matches[1])
@@ -272,11 +835,14 @@ func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 			completed := matches[1] == "x"
 			description := strings.TrimSpace(matches[2])
 			task := Task{
-				Description: description,
-				Completed:   completed,
-				Phase:       currentPhase,
-				AssignedTo:  item.AssignedTo, // Default to work item assignee
+				Description:   description,
+				Completed:     completed,
+				NotApplicable: matches[1] == "~",
+				Phase:         currentPhase,
+				AssignedTo:    item.AssignedTo, // Default to work item assignee, unless annotated with "(@name)"
+				Group:         currentGroup,
 			}
+			parseTaskAnnotations(&task)
 			item.Tasks = append(item.Tasks, task)
 		}
 	}
@@ -285,14 +851,10 @@ func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 		return item, err
 	}
 
+	item.Summary = strings.Join(summary, " ")
+
 	// Infer type from directory name
-	if strings.HasPrefix(name, "feature-") {
-		item.Type = TypeFeature
-	} else if strings.HasPrefix(name, "bug-") {
-		item.Type = TypeBug
-	} else if strings.HasPrefix(name, "experiment-") {
-		item.Type = TypeExperiment
-	}
+	item.Type = itemTypeFromDirName(name)
 
 	// Set timestamps based on file information
 	if fileInfo, err := os.Stat(path); err == nil {
@@ -300,9 +862,89 @@ func (p *WorkItemParser) ParseWorkItem(name, path string) (WorkItem, error) {
 		item.UpdatedAt = fileInfo.ModTime() // Use file modification time as last update
 	}
 
+	// A completed item's last phase ended when it was last updated
+	end := time.Now()
+	if item.Status == StatusCompleted && !item.UpdatedAt.IsZero() {
+		end = item.UpdatedAt
+	}
+	setPhaseDurations(item.PhaseHistory, end)
+
 	return item, nil
 }
 
+// taskDueRegex matches an inline "(due: YYYY-MM-DD)" task annotation
+var taskDueRegex = regexp.MustCompile(`\s*\(due:\s*(\d{4}-\d{2}-\d{2})\)`)
+
+// taskDoneRegex matches an inline "(done: YYYY-MM-DD)" task annotation
+var taskDoneRegex = regexp.MustCompile(`\s*\(done:\s*(\d{4}-\d{2}-\d{2})\)`)
+
+// taskEstimateRegex matches an inline "(estimate: 2h)" task annotation; the
+// amount may be fractional and the unit is m, h, d (8h working day) or w (5d)
+var taskEstimateRegex = regexp.MustCompile(`\s*\(estimate:\s*(\d+(?:\.\d+)?)\s*([mhdw])\)`)
+
+// taskAssigneeRegex matches an inline "(@name)" task assignee annotation
+var taskAssigneeRegex = regexp.MustCompile(`\s*\(@([^()\s]+)\)`)
+
+// estimateUnits maps the units accepted by taskEstimateRegex to durations
+var estimateUnits = map[string]time.Duration{
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": workDay,
+	"w": 5 * workDay,
+}
+
+// parseTaskAnnotations moves recognized inline annotations from a task's
+// description into its fields. Unrecognized parenthesized text is left as is.
+func parseTaskAnnotations(task *Task) {
+	if matches := taskDueRegex.FindStringSubmatch(task.Description); len(matches) > 1 {
+		if dueDate, err := time.ParseInLocation("2006-01-02", matches[1], time.Local); err == nil {
+			task.DueDate = dueDate
+			task.Description = strings.TrimSpace(taskDueRegex.ReplaceAllString(task.Description, ""))
+		}
+	}
+	if matches := taskDoneRegex.FindStringSubmatch(task.Description); len(matches) > 1 {
+		if completedAt, err := time.ParseInLocation("2006-01-02", matches[1], time.Local); err == nil {
+			task.CompletedAt = completedAt
+			task.Description = strings.TrimSpace(taskDoneRegex.ReplaceAllString(task.Description, ""))
+		}
+	}
+	if matches := taskEstimateRegex.FindStringSubmatch(task.Description); len(matches) > 2 {
+		if amount, err := strconv.ParseFloat(matches[1], 64); err == nil {
+			task.Estimate = time.Duration(amount * float64(estimateUnits[matches[2]]))
+			task.Description = strings.TrimSpace(taskEstimateRegex.ReplaceAllString(task.Description, ""))
+		}
+	}
+	if matches := taskAssigneeRegex.FindStringSubmatch(task.Description); len(matches) > 1 {
+		task.AssignedTo = matches[1]
+		task.Description = strings.TrimSpace(taskAssigneeRegex.ReplaceAllString(task.Description, ""))
+	}
+}
+
+// itemTypeFromDirName infers the work item type from a "<type>-<name>" directory name.
+// Returns an empty type if the prefix is not recognized.
+func itemTypeFromDirName(name string) ItemType {
+	switch {
+	case strings.HasPrefix(name, "feature-"):
+		return TypeFeature
+	case strings.HasPrefix(name, "bug-"):
+		return TypeBug
+	case strings.HasPrefix(name, "experiment-"):
+		return TypeExperiment
+	}
+	return ""
+}
+
+// parseLabels splits a comma-separated label list, dropping empty entries
+func parseLabels(value string) []string {
+	labels := []string{}
+	for _, label := range strings.Split(value, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
 // StatusUpdater updates work item status in README files.
 // It modifies the status, phase, progress, and assignee fields in markdown.
 type StatusUpdater struct {
@@ -422,6 +1064,14 @@ func (su *StatusUpdater) UpdatePhaseAndStatus(filePath string, phase WorkPhase,
 	return su.fs.WriteFile(filePath, []byte(content))
 }
 
+// AppendPhaseHistory records that a work item entered phase at the given
+// time, appending a "- <phase>: <RFC 3339 timestamp>" entry under the
+// "## Phase History" heading of a README file, creating the section after the
+// metadata block if needed
+func (su *StatusUpdater) AppendPhaseHistory(filePath string, phase WorkPhase, enteredAt time.Time) error {
+	return su.appendSectionEntry(filePath, "Phase History", fmt.Sprintf("- %s: %s", phase, enteredAt.UTC().Format(time.RFC3339)))
+}
+
 // UpdatePhase updates the phase in a README file
 func (su *StatusUpdater) UpdatePhase(filePath string, phase WorkPhase) error {
 	data, err := su.fs.ReadFile(filePath)
@@ -445,6 +1095,331 @@ func (su *StatusUpdater) UpdatePhase(filePath string, phase WorkPhase) error {
 	return su.fs.WriteFile(filePath, []byte(content))
 }
 
+// UpdateTitle updates the title in the "# Feature:", "# Bug:" or "# Experiment:" heading
+func (su *StatusUpdater) UpdateTitle(filePath string, title string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	content := string(data)
+	titleRegex := regexp.MustCompile(`(?m)^(#\s+(?:Feature|Bug|Experiment):\s*)(.+)$`)
+
+	if loc := titleRegex.FindStringSubmatchIndex(content); loc != nil {
+		content = content[:loc[4]] + title + content[loc[5]:]
+	}
+
+	return su.fs.WriteFile(filePath, []byte(content))
+}
+
+// UpdateCreatedBy sets the author in a README file
+func (su *StatusUpdater) UpdateCreatedBy(filePath string, author string) error {
+	return su.updateMetadataField(filePath, "Created By", author)
+}
+
+// UpdatePriority updates the priority in a README file
+func (su *StatusUpdater) UpdatePriority(filePath string, priority Priority) error {
+	return su.updateMetadataField(filePath, "Priority", string(priority))
+}
+
+// UpdateEstimate updates the "## Estimate:" effort estimate in a README file, in hours
+func (su *StatusUpdater) UpdateEstimate(filePath string, hours float64) error {
+	return su.updateMetadataField(filePath, "Estimate", strconv.FormatFloat(hours, 'f', -1, 64)+"h")
+}
+
+// UpdateOutcome updates the experiment outcome in a README file
+func (su *StatusUpdater) UpdateOutcome(filePath string, outcome Outcome) error {
+	return su.updateMetadataField(filePath, "Outcome", string(outcome))
+}
+
+// UpdateLabels updates the comma-separated labels in a README file
+func (su *StatusUpdater) UpdateLabels(filePath string, labels []string) error {
+	return su.updateMetadataField(filePath, "Labels", strings.Join(labels, ", "))
+}
+
+// UpdateDependencies updates the comma-separated "## Depends On:" work item names in a README file
+func (su *StatusUpdater) UpdateDependencies(filePath string, dependsOn []string) error {
+	return su.updateMetadataField(filePath, "Depends On", strings.Join(dependsOn, ", "))
+}
+
+// updateMetadataField sets a "## Key: value" metadata line in a README file
+func (su *StatusUpdater) updateMetadataField(filePath, key, value string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	content := setMetadataField(string(data), key, value)
+	return su.fs.WriteFile(filePath, []byte(content))
+}
+
+// setMetadataField replaces the first "## Key: value" line in content, or inserts
+// one at the end of the metadata block that follows the title heading if none exists.
+func setMetadataField(content, key, value string) string {
+	keyPattern := strings.Join(strings.Fields(regexp.QuoteMeta(key)), `\s+`)
+	fieldRegex := regexp.MustCompile(`(?im)^(##\s*` + keyPattern + `:[ \t]*)(.*)$`)
+
+	if loc := fieldRegex.FindStringSubmatchIndex(content); loc != nil {
+		return content[:loc[4]] + value + content[loc[5]:]
+	}
+
+	line := fmt.Sprintf("## %s: %s", key, value)
+	lines := strings.Split(content, "\n")
+	metadataRegex := regexp.MustCompile(`^##\s*[A-Za-z][A-Za-z ]*:`)
+
+	// Insert after the last line of the first block of metadata headings
+	insertAt := -1
+	for i, l := range lines {
+		if metadataRegex.MatchString(l) {
+			insertAt = i + 1
+		} else if insertAt != -1 {
+			break
+		}
+	}
+
+	if insertAt == -1 {
+		// No metadata yet: insert after the title heading
+		if len(lines) > 0 && strings.HasPrefix(lines[0], "#") {
+			lines = append(lines[:1], append([]string{"", line}, lines[1:]...)...)
+			return strings.Join(lines, "\n")
+		}
+		return line + "\n" + content
+	}
+
+	lines = append(lines[:insertAt], append([]string{line}, lines[insertAt:]...)...)
+	return strings.Join(lines, "\n")
+}
+
+// AddRelatedItem appends a "- <relation>: <name>" link under the "## Related Items"
+// heading of a README file, creating the section after the metadata block if needed.
+// Links that are already present are left as is.
+func (su *StatusUpdater) AddRelatedItem(filePath string, related RelatedItem) error {
+	return su.appendSectionEntry(filePath, "Related Items", fmt.Sprintf("- %s: %s", related.Relation, related.Name))
+}
+
+// RenameRelatedItem points the "- <relation>: <name>" links to oldName under
+// the "## Related Items" heading of a README file at newName instead. Other
+// links and the rest of the file are left as they are.
+func (su *StatusUpdater) RenameRelatedItem(filePath, oldName, newName string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	sectionRegex := regexp.MustCompile(`^##\s`)
+	relatedSectionRegex := regexp.MustCompile(`(?i)^##\s+Related\s+Items\s*$`)
+	linkRegex := regexp.MustCompile(`^(\s*-\s*[A-Za-z][\w-]*:\s*)(\S+)(\s*)$`)
+	inRelated := false
+	for i, line := range lines {
+		if sectionRegex.MatchString(line) {
+			inRelated = relatedSectionRegex.MatchString(line)
+			continue
+		}
+		if matches := linkRegex.FindStringSubmatch(line); inRelated && len(matches) > 3 && matches[2] == oldName {
+			lines[i] = matches[1] + newName + matches[3]
+		}
+	}
+
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
+// AddReference appends a "- [label](url)" link under the "## References" heading
+// of a README file, creating the section after the metadata block if needed.
+// Links that are already present are left as is.
+func (su *StatusUpdater) AddReference(filePath string, ref Reference) error {
+	return su.appendSectionEntry(filePath, "References", fmt.Sprintf("- [%s](%s)", ref.Label, ref.URL))
+}
+
+// SetMetadata sets key to value under the "## Metadata" heading of a README
+// file, replacing the key's existing line or appending a "- key: value" line,
+// creating the section after the metadata block if needed. Other keys are left
+// as they are.
+func (su *StatusUpdater) SetMetadata(filePath, key, value string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	entry := fmt.Sprintf("- %s: %s", key, value)
+	lines := strings.Split(string(data), "\n")
+	sectionRegex := regexp.MustCompile(`^##\s`)
+	metadataSectionRegex := regexp.MustCompile(`(?i)^##\s+Metadata\s*$`)
+	inMetadata := false
+	for i, line := range lines {
+		if sectionRegex.MatchString(line) {
+			inMetadata = metadataSectionRegex.MatchString(line)
+			continue
+		}
+		if !inMetadata {
+			continue
+		}
+		if matches := metadataEntryRegex.FindStringSubmatch(line); len(matches) > 2 && matches[1] == key {
+			lines[i] = entry
+			return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+		}
+	}
+
+	return su.appendSectionEntry(filePath, "Metadata", entry)
+}
+
+// metadataEntryRegex matches a "key: value" line, optionally bulleted, in the
+// "## Metadata" section
+var metadataEntryRegex = regexp.MustCompile(`^\s*(?:[-*]\s*)?([A-Za-z][\w.-]*):\s*(.*?)\s*$`)
+
+// appendSectionEntry appends a bullet entry after the last bullet of the
+// "## <heading>" section, creating the section after the first block of
+// metadata headings if needed. An entry that is already present is not repeated.
+func (su *StatusUpdater) appendSectionEntry(filePath, heading, entry string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	sectionRegex := regexp.MustCompile(`^##\s`)
+	headingRegex := regexp.MustCompile(`(?i)^##\s+` + strings.Join(strings.Fields(regexp.QuoteMeta(heading)), `\s+`) + `\s*$`)
+
+	start := -1
+	for i, line := range lines {
+		if headingRegex.MatchString(line) {
+			start = i
+			break
+		}
+	}
+
+	if start == -1 {
+		// Add the section after the first block of metadata headings
+		metadataRegex := regexp.MustCompile(`^##\s*[A-Za-z][A-Za-z ]*:`)
+		insertAt := -1
+		for i, l := range lines {
+			if metadataRegex.MatchString(l) {
+				insertAt = i + 1
+			} else if insertAt != -1 {
+				break
+			}
+		}
+		if insertAt == -1 {
+			insertAt = len(lines)
+		}
+		section := []string{"", "## " + heading, entry}
+		lines = append(lines[:insertAt], append(section, lines[insertAt:]...)...)
+		return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+	}
+
+	// Append after the last entry in the existing section
+	insertAt := start + 1
+	for i := start + 1; i < len(lines) && !sectionRegex.MatchString(lines[i]); i++ {
+		if strings.TrimSpace(lines[i]) == entry {
+			return nil
+		}
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "-") {
+			insertAt = i + 1
+		}
+	}
+
+	lines = append(lines[:insertAt], append([]string{entry}, lines[insertAt:]...)...)
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
+// AppendPhaseTasks adds unchecked tasks to a phase section in a README file.
+// Tasks are inserted after the last task of the section's default task list
+// (or after the section's last task), or at the end of the section if it has none.
+func (su *StatusUpdater) AppendPhaseTasks(filePath string, phase WorkPhase, descriptions []string) error {
+	if len(descriptions) == 0 {
+		return nil
+	}
+
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	start, end, ok := phaseSectionBounds(lines, phase)
+	if !ok {
+		return fmt.Errorf("no %s phase section found", phase)
+	}
+
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
+	groupRegex := regexp.MustCompile(`^###\s+(.+?)\s*$`)
+
+	insertAt, lastUngrouped := -1, -1
+	grouped := false
+	for i := start + 1; i < end; i++ {
+		if matches := groupRegex.FindStringSubmatch(lines[i]); len(matches) > 1 {
+			grouped = !strings.EqualFold(matches[1], "Tasks")
+		}
+		if taskRegex.MatchString(lines[i]) {
+			insertAt = i + 1
+			if !grouped {
+				lastUngrouped = i + 1
+			}
+		}
+	}
+	if lastUngrouped != -1 {
+		insertAt = lastUngrouped
+	}
+	if insertAt == -1 {
+		// No tasks yet: insert before the blank lines and separators closing the section
+		insertAt = end
+		for insertAt > start+1 && (strings.TrimSpace(lines[insertAt-1]) == "" || strings.TrimSpace(lines[insertAt-1]) == "---") {
+			insertAt--
+		}
+	}
+
+	newLines := make([]string, 0, len(descriptions))
+	for _, description := range descriptions {
+		newLines = append(newLines, "- [ ] "+description)
+	}
+
+	lines = append(lines[:insertAt], append(newLines, lines[insertAt:]...)...)
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
+// phaseSectionBounds finds the "## <Phase> Phase" section in lines. It returns the
+// index of the heading and the index of the next "##" heading (or len(lines)).
+func phaseSectionBounds(lines []string, phase WorkPhase) (start, end int, ok bool) {
+	headingRegex := regexp.MustCompile(`(?i)^##\s+` + regexp.QuoteMeta(string(phase)) + `\s+Phase\b`)
+	sectionRegex := regexp.MustCompile(`^##\s`)
+
+	start = -1
+	for i, line := range lines {
+		if start == -1 {
+			if headingRegex.MatchString(line) {
+				start = i
+			}
+			continue
+		}
+		if sectionRegex.MatchString(line) {
+			return start, i, true
+		}
+	}
+
+	if start == -1 {
+		return 0, 0, false
+	}
+	return start, len(lines), true
+}
+
+// phaseTaskDescriptions returns the task descriptions listed in a phase section of markdown content
+func phaseTaskDescriptions(content string, phase WorkPhase) []string {
+	lines := strings.Split(content, "\n")
+	start, end, ok := phaseSectionBounds(lines, phase)
+	if !ok {
+		return nil
+	}
+
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]\s*(.+)$`)
+	var descriptions []string
+	for _, line := range lines[start+1 : end] {
+		if matches := taskRegex.FindStringSubmatch(line); len(matches) > 2 {
+			descriptions = append(descriptions, strings.TrimSpace(matches[2]))
+		}
+	}
+	return descriptions
+}
+
 // CompleteTask marks a task as completed in a README file
 func (su *StatusUpdater) CompleteTask(filePath string, taskId int) error {
 	data, err := su.fs.ReadFile(filePath)
@@ -455,15 +1430,20 @@ func (su *StatusUpdater) CompleteTask(filePath string, taskId int) error {
 	content := string(data)
 	lines := strings.Split(content, "\n")
 
-	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x])\]`)
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
 	completeRegex := regexp.MustCompile(`^\s*-\s*\[\s*\]`)
 
 	taskCount := 0
 	for i, line := range lines {
 		if taskRegex.MatchString(line) {
 			if taskCount == taskId {
-				// Mark this task as completed
-				lines[i] = completeRegex.ReplaceAllString(line, "- [x]")
+				// Mark this task as completed, stamping the date it was first completed
+				if completeRegex.MatchString(line) {
+					lines[i] = completeRegex.ReplaceAllString(line, "- [x]")
+					if !taskDoneRegex.MatchString(line) {
+						lines[i] = strings.TrimRight(lines[i], " ") + fmt.Sprintf(" (done: %s)", time.Now().Format("2006-01-02"))
+					}
+				}
 				break
 			}
 			taskCount++
@@ -474,6 +1454,98 @@ func (su *StatusUpdater) CompleteTask(filePath string, taskId int) error {
 	return su.fs.WriteFile(filePath, []byte(content))
 }
 
+// UncompleteTask marks a completed task as open again in a README file,
+// removing its "(done: YYYY-MM-DD)" annotation. Open and N/A tasks are left
+// as they are.
+func (su *StatusUpdater) UncompleteTask(filePath string, taskId int) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
+	doneRegex := regexp.MustCompile(`^(\s*-\s*)\[x\]`)
+
+	taskCount := 0
+	for i, line := range lines {
+		if taskRegex.MatchString(line) {
+			if taskCount == taskId {
+				if doneRegex.MatchString(line) {
+					lines[i] = taskDoneRegex.ReplaceAllString(doneRegex.ReplaceAllString(line, "${1}[ ]"), "")
+				}
+				break
+			}
+			taskCount++
+		}
+	}
+
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
+// MarkTaskNotApplicable marks an open task as not applicable ("- [~]") in a
+// README file. Completed and already N/A tasks are left as they are.
+func (su *StatusUpdater) MarkTaskNotApplicable(filePath string, taskId int) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
+	openRegex := regexp.MustCompile(`^(\s*-\s*)\[\s*\]`)
+
+	taskCount := 0
+	for i, line := range lines {
+		if taskRegex.MatchString(line) {
+			if taskCount == taskId {
+				lines[i] = openRegex.ReplaceAllString(line, "${1}[~]")
+				break
+			}
+			taskCount++
+		}
+	}
+
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
+// AssignTask sets the "(@assignee)" annotation of a task in a README file,
+// replacing an existing one in place or appending it to the task line. An
+// empty assignee removes the annotation, so the task falls back to the work
+// item assignee.
+func (su *StatusUpdater) AssignTask(filePath string, taskId int, assignee string) error {
+	data, err := su.fs.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
+
+	taskCount := 0
+	for i, line := range lines {
+		if taskRegex.MatchString(line) {
+			if taskCount == taskId {
+				switch {
+				case assignee == "":
+					lines[i] = taskAssigneeRegex.ReplaceAllString(line, "")
+				case taskAssigneeRegex.MatchString(line):
+					lines[i] = taskAssigneeRegex.ReplaceAllLiteralString(line, " (@"+assignee+")")
+				default:
+					lines[i] = strings.TrimRight(line, " ") + " (@" + assignee + ")"
+				}
+				break
+			}
+			taskCount++
+		}
+	}
+
+	return su.fs.WriteFile(filePath, []byte(strings.Join(lines, "\n")))
+}
+
 // TaskParser parses task completion status from README files.
 // It counts completed and total tasks in markdown checklists.
 type TaskParser struct {
@@ -488,6 +1560,7 @@ func NewTaskParser(fs FileSystem) *TaskParser {
 
 // ParseTaskList counts total and completed tasks in a README.
 // Returns the total number of tasks and the number that are completed.
+// Not applicable ("- [~]") tasks are counted as neither.
 func (tp *TaskParser) ParseTaskList(filePath string) (total, completed int, err error) {
 	content, err := tp.fs.ReadFile(filePath)
 	if err != nil {
@@ -495,11 +1568,11 @@ func (tp *TaskParser) ParseTaskList(filePath string) (total, completed int, err
 	}
 
 	scanner := bufio.NewScanner(strings.NewReader(string(content)))
-	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x])\]`)
+	taskRegex := regexp.MustCompile(`^\s*-\s*\[([ x~])\]`)
 
 	for scanner.Scan() {
 		line := scanner.Text()
-		if matches := taskRegex.FindStringSubmatch(line); len(matches) > 1 {
+		if matches := taskRegex.FindStringSubmatch(line); len(matches) > 1 && matches[1] != "~" {
 			total++
 			if matches[1] == "x" {
 				completed++
@@ -510,48 +1583,76 @@ func (tp *TaskParser) ParseTaskList(filePath string) (total, completed int, err
 	return total, completed, scanner.Err()
 }
 
+// postmortemFile is the name of the postmortem written into archived work items
+const postmortemFile = "POSTMORTEM.md"
+
 // PostmortemGenerator generates postmortem templates for completed work items.
-// It creates structured templates for retrospective analysis.
+// It creates structured templates for retrospective analysis, from the file
+// configured as postmortem_template or the embedded default.
 type PostmortemGenerator struct {
-	fs FileSystem
+	fs     FileSystem
+	config Config
 }
 
 // NewPostmortemGenerator creates a new postmortem generator.
 // Requires a FileSystem implementation for file operations.
-func NewPostmortemGenerator(fs FileSystem) *PostmortemGenerator {
-	return &PostmortemGenerator{fs: fs}
+func NewPostmortemGenerator(fs FileSystem, config Config) *PostmortemGenerator {
+	return &PostmortemGenerator{fs: fs, config: config}
 }
 
 // GeneratePostmortem creates a postmortem template for a completed work item.
-// It generates a structured markdown template for retrospective analysis.
-func (pg *PostmortemGenerator) GeneratePostmortem(path, name string) error {
-	template := fmt.Sprintf(`# Postmortem: %s
-
-## Completion Date
-%s
-
-## Summary
-- [ ] What was accomplished?
-- [ ] Key challenges faced?
-- [ ] Lessons learned?
-
-## Metrics
-- Development time:
-- Lines of code added/modified:
-- Tests added:
+// It writes POSTMORTEM.md to path, replacing these placeholders:
+//
+//	{{name}}             work item name
+//	{{title}}            work item title
+//	{{type}}             work item type
+//	{{date}}             completion date (today)
+//	{{created}}          creation date
+//	{{total_tasks}}      number of tasks
+//	{{completed_tasks}}  number of completed tasks
+//	{{time_spent}}       time from creation to completion, e.g. "3d 4h"
+func (pg *PostmortemGenerator) GeneratePostmortem(path string, item WorkItem) error {
+	template, err := pg.loadTemplate()
+	if err != nil {
+		return err
+	}
 
-## What Went Well
--
+	metrics := NewProgressTracker(pg.fs).CalculateWorkItemMetrics(&item)
+	created, timeSpent := "unknown", "unknown"
+	if !item.CreatedAt.IsZero() {
+		created = item.CreatedAt.Format("2006-01-02")
+		timeSpent = formatElapsed(time.Since(item.CreatedAt))
+	}
 
-## What Could Be Improved
--
+	content := strings.NewReplacer(
+		"{{name}}", item.Name,
+		"{{title}}", item.Title,
+		"{{type}}", string(item.Type),
+		"{{date}}", time.Now().Format("2006-01-02"),
+		"{{created}}", created,
+		"{{total_tasks}}", strconv.Itoa(metrics.TotalTasks),
+		"{{completed_tasks}}", strconv.Itoa(metrics.CompletedTasks),
+		"{{time_spent}}", timeSpent,
+	).Replace(template)
+
+	postmortemPath := filepath.Join(path, postmortemFile)
+	return pg.fs.WriteFile(postmortemPath, []byte(content))
+}
 
-## Follow-up Items
-- [ ] Documentation updates needed
-- [ ] Technical debt created
-- [ ] Future enhancements identified
-`, name, time.Now().Format("2006-01-02"))
+// loadTemplate returns the configured postmortem template, or the embedded default
+func (pg *PostmortemGenerator) loadTemplate() (string, error) {
+	if pg.config.PostmortemTemplate == "" {
+		return embeddedTemplatePostmortem, nil
+	}
+	data, err := pg.fs.ReadFile(pg.config.PostmortemTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to read postmortem template %s: %w", pg.config.PostmortemTemplate, err)
+	}
+	return string(data), nil
+}
 
-	postmortemPath := filepath.Join(path, "POSTMORTEM.md")
-	return pg.fs.WriteFile(postmortemPath, []byte(template))
+// formatElapsed formats a duration as whole days and hours, e.g. "3d 4h"
+func formatElapsed(d time.Duration) string {
+	hours := int(d.Hours())
+	return fmt.Sprintf("%dd %dh", hours/24, hours%24)
 }
@@ -0,0 +1,166 @@
+package pm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemFileSystemNestedListing(t *testing.T) {
+	fs := NewMemFileSystem()
+
+	require.NoError(t, fs.CreateDirectory("work/backlog/feature-a/assets"))
+	require.NoError(t, fs.CreateDirectory("work/completed"))
+	require.NoError(t, fs.WriteFile("work/backlog/feature-a/README.md", []byte("# A")))
+	require.NoError(t, fs.WriteFile("work/backlog/feature-a/assets/diagram.png", []byte("png")))
+	require.NoError(t, fs.WriteFile("work/backlog/NOTES.md", []byte("notes")))
+
+	assert.True(t, fs.DirectoryExists("work"), "parents are created")
+	assert.True(t, fs.DirectoryExists("work/backlog/feature-a/"), "paths are cleaned")
+	assert.True(t, fs.FileExists("work/backlog/x/../feature-a/README.md"))
+	assert.False(t, fs.FileExists("work/backlog/feature-a"))
+	assert.False(t, fs.DirectoryExists("work/backlog/NOTES.md"))
+
+	dirs, err := fs.ListDirectories("work")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backlog", "completed"}, dirs)
+	dirs, err = fs.ListDirectories("work/backlog")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-a"}, dirs, "only direct children are listed")
+
+	files, err := fs.ListFiles("work/backlog")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"NOTES.md"}, files)
+	files, err = fs.ListFiles("work/backlog/feature-a/assets")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"diagram.png"}, files)
+
+	_, err = fs.ListFiles("work/missing")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.ErrorIs(t, fs.WriteFile("work/missing/README.md", nil), os.ErrNotExist, "the parent directory must exist")
+	assert.Error(t, fs.CreateDirectory("work/backlog/NOTES.md/sub"), "a file can't hold directories")
+	assert.Error(t, fs.CheckWritable("work/backlog/NOTES.md/sub"))
+	assert.NoError(t, fs.CheckWritable("work/new/dir"))
+}
+
+func TestMemFileSystemMoveDirectory(t *testing.T) {
+	fs := NewMemFileSystem()
+
+	require.NoError(t, fs.CreateDirectory("backlog/feature-a/docs/drafts"))
+	require.NoError(t, fs.CreateDirectory("backlog/feature-ab"))
+	require.NoError(t, fs.CreateDirectory("completed"))
+	require.NoError(t, fs.WriteFile("backlog/feature-a/README.md", []byte("# A")))
+	require.NoError(t, fs.WriteFile("backlog/feature-a/docs/drafts/v1.md", []byte("v1")))
+	require.NoError(t, fs.WriteFile("backlog/feature-ab/README.md", []byte("# AB")))
+
+	require.NoError(t, fs.MoveDirectory("backlog/feature-a", "completed/feature-a"))
+
+	assert.False(t, fs.DirectoryExists("backlog/feature-a"))
+	assert.False(t, fs.DirectoryExists("backlog/feature-a/docs"))
+	assert.False(t, fs.FileExists("backlog/feature-a/README.md"))
+	assert.True(t, fs.FileExists("backlog/feature-ab/README.md"), "siblings sharing a name prefix stay put")
+
+	data, err := fs.ReadFile("completed/feature-a/docs/drafts/v1.md")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(data))
+	dirs, err := fs.ListDirectories("completed/feature-a/docs")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"drafts"}, dirs)
+	dirs, err = fs.ListDirectories("backlog")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-ab"}, dirs)
+
+	assert.ErrorIs(t, fs.MoveDirectory("backlog/feature-a", "completed/again"), os.ErrNotExist)
+	assert.ErrorIs(t, fs.MoveDirectory("backlog/feature-ab", "missing/feature-ab"), os.ErrNotExist, "the destination parent must exist")
+	assert.Error(t, fs.MoveDirectory("completed", "completed/feature-a/docs/nested"), "can't move a directory into itself")
+	require.NoError(t, fs.CreateDirectory("completed/feature-ab"))
+	require.NoError(t, fs.MoveDirectory("backlog/feature-ab", "completed/feature-ab"), "an empty destination is replaced")
+	assert.Error(t, fs.MoveDirectory("completed/feature-ab", "completed/feature-a"), "a non-empty destination is not")
+
+	require.NoError(t, fs.RemoveDirectory("completed/feature-a"))
+	assert.False(t, fs.FileExists("completed/feature-a/docs/drafts/v1.md"))
+	assert.True(t, fs.FileExists("completed/feature-ab/README.md"))
+}
+
+func TestMemFileSystemManager(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMemFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "in-memory"})
+	require.NoError(t, err)
+	assert.True(t, fs.FileExists(item.Path))
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-in-memory", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-in-memory"))
+	assert.True(t, fs.FileExists(filepath.Join(config.CompletedDir, "feature-in-memory", "README.md")))
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-in-memory")))
+}
+
+func TestMemFileSystemConcurrentWrites(t *testing.T) {
+	fs := NewMemFileSystem()
+	require.NoError(t, fs.CreateDirectory("items"))
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dir := filepath.Join("items", string(rune('a'+i)))
+			assert.NoError(t, fs.CreateDirectory(dir))
+			assert.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte("# item")))
+			_, _ = fs.ListDirectories("items")
+		}()
+	}
+	wg.Wait()
+
+	dirs, err := fs.ListDirectories("items")
+	require.NoError(t, err)
+	assert.Len(t, dirs, 20)
+}
+
+func TestOSFileSystemWriteFileAtomic(t *testing.T) {
+	fs := NewOSFileSystem()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "README.md")
+
+	require.NoError(t, fs.WriteFile(path, []byte("## Status: PROPOSED\n")))
+	require.NoError(t, fs.WriteFile(path, []byte("## Status: COMPLETED\n")))
+	data, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "## Status: COMPLETED\n", string(data))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+
+	// A replaced file keeps its permissions
+	require.NoError(t, os.Chmod(path, 0o600))
+	require.NoError(t, fs.WriteFile(path, []byte("updated")))
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// A failed rename, here onto a non-empty directory, cleans up its temp file
+	require.NoError(t, fs.CreateDirectory(filepath.Join(dir, "docs", "sub")))
+	assert.Error(t, fs.WriteFile(filepath.Join(dir, "docs"), []byte("x")))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"README.md", "docs"}, names, "no .tmp files are left behind")
+}
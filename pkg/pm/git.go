@@ -1,9 +1,13 @@
 package pm
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 // GitClient provides git operations for the PM system.
@@ -15,11 +19,36 @@ type GitClient interface {
 	// BranchExists checks if a branch already exists.
 	BranchExists(branchName string) bool
 
+	// DeleteBranch deletes a local branch, even if it isn't merged.
+	DeleteBranch(branchName string) error
+
+	// RenameBranch renames a local branch.
+	RenameBranch(oldName, newName string) error
+
 	// GetCurrentBranch returns the current branch name.
 	GetCurrentBranch() (string, error)
 
 	// GetGitUserName returns the git user name from config.
 	GetGitUserName() (string, error)
+
+	// ReadFileAtRef returns the contents of path as of ref without checking it
+	// out. For a directory it returns git's tree listing instead.
+	ReadFileAtRef(path, ref string) ([]byte, error)
+
+	// GetRefDate returns the commit date of ref, such as a release tag.
+	GetRefDate(ref string) (time.Time, error)
+
+	// CommitChanges stages paths, including deletions, and commits only them.
+	CommitChanges(paths []string, message string) error
+}
+
+// gitCommand returns a git command run from the directory UseRepo selected
+// (the working directory by default), so --repo applies to git operations as
+// well as to config and paths
+func gitCommand(args ...string) *exec.Cmd {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoDir
+	return cmd
 }
 
 // OSGitClient implements GitClient using OS exec commands.
@@ -35,7 +64,7 @@ func NewOSGitClient() *OSGitClient {
 // CreateBranch creates a new git branch.
 // It switches to the new branch after creation.
 func (gc *OSGitClient) CreateBranch(branchName string) error {
-	cmd := exec.Command("git", "checkout", "-b", branchName)
+	cmd := gitCommand("checkout", "-b", branchName)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("failed to create branch %s: %s", branchName, string(output))
@@ -46,7 +75,7 @@ func (gc *OSGitClient) CreateBranch(branchName string) error {
 // BranchExists checks if a branch exists.
 // Returns true if the branch exists locally.
 func (gc *OSGitClient) BranchExists(branchName string) bool {
-	cmd := exec.Command("git", "branch", "--list", branchName)
+	cmd := gitCommand("branch", "--list", branchName)
 	output, err := cmd.Output()
 	if err != nil {
 		return false
@@ -54,13 +83,35 @@ func (gc *OSGitClient) BranchExists(branchName string) bool {
 	return strings.TrimSpace(string(output)) != ""
 }
 
+// DeleteBranch deletes a local branch with "git branch -D", whether or not
+// it is merged. git refuses to delete the checked-out branch.
+func (gc *OSGitClient) DeleteBranch(branchName string) error {
+	cmd := gitCommand("branch", "-D", branchName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to delete branch %s: %s", branchName, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// RenameBranch renames a local branch with "git branch -m". git refuses to
+// overwrite an existing branch.
+func (gc *OSGitClient) RenameBranch(oldName, newName string) error {
+	cmd := gitCommand("branch", "-m", oldName, newName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to rename branch %s to %s: %s", oldName, newName, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
 // GetCurrentBranch returns the current branch name.
 // Returns an error if not in a git repository or command fails.
 func (gc *OSGitClient) GetCurrentBranch() (string, error) {
-	cmd := exec.Command("git", "branch", "--show-current")
-	output, err := cmd.Output()
+	cmd := gitCommand("branch", "--show-current")
+	output, err := cmd.CombinedOutput()
 	if err != nil {
-		return "", fmt.Errorf("failed to get current branch: %v", err)
+		return "", fmt.Errorf("failed to get current branch: %s", strings.TrimSpace(string(output)))
 	}
 	return strings.TrimSpace(string(output)), nil
 }
@@ -68,28 +119,316 @@ func (gc *OSGitClient) GetCurrentBranch() (string, error) {
 // GetGitUserName returns the git user name from config.
 // Returns an error if git config is not set or command fails.
 func (gc *OSGitClient) GetGitUserName() (string, error) {
-	cmd := exec.Command("git", "config", "user.name")
-	output, err := cmd.Output()
+	cmd := gitCommand("config", "user.name")
+	output, err := cmd.CombinedOutput()
 	if err != nil {
+		// git config prints nothing when the key is unset
+		if message := strings.TrimSpace(string(output)); message != "" {
+			return "", fmt.Errorf("failed to get git user name: %s", message)
+		}
 		return "", fmt.Errorf("failed to get git user name: %v", err)
 	}
 	return strings.TrimSpace(string(output)), nil
 }
 
+// ReadFileAtRef returns the contents of path as of ref using "git show
+// ref:path", without touching the working tree. path is absolute or relative
+// to the current directory and must be inside the repository. For a directory,
+// git prints "tree ref:path", a blank line and one entry per line, with
+// subdirectories suffixed by "/".
+func (gc *OSGitClient) ReadFileAtRef(path, ref string) ([]byte, error) {
+	root := detectRepoRoot()
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%s is outside the git repository %s", path, root)
+	}
+	spec := filepath.ToSlash(rel)
+	if rel == "." {
+		spec = ""
+	}
+
+	cmd := gitCommand("-C", root, "show", ref+":"+spec)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("failed to read %s at %s: %s", rel, ref, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to read %s at %s: %v", rel, ref, err)
+	}
+	return output, nil
+}
+
+// GetRefDate returns the committer date of the commit ref points to, using
+// "git log -1 --format=%cI ref". For a tag this is the date of the tagged
+// commit.
+func (gc *OSGitClient) GetRefDate(ref string) (time.Time, error) {
+	cmd := gitCommand("log", "-1", "--format=%cI", ref, "--")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to resolve %s: %s", ref, strings.TrimSpace(string(output)))
+	}
+	// The date is the last line; warnings such as an ambiguous ref come first
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	date, err := time.Parse(time.RFC3339, strings.TrimSpace(lines[len(lines)-1]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse the date of %s: %w", ref, err)
+	}
+	return date, nil
+}
+
+// CommitChanges stages paths with "git add -A", so moved and deleted files
+// are staged too, and commits them with "git commit -- paths", leaving
+// anything else already staged out of the commit. Paths that no longer exist
+// and were never tracked, such as where a new work item was created before it
+// moved to a status directory, are skipped, since git rejects them.
+func (gc *OSGitClient) CommitChanges(paths []string, message string) error {
+	var committed []string
+	for _, path := range paths {
+		// git resolves relative paths against the directory it runs in
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", path, err)
+		}
+		if _, err := os.Stat(abs); err != nil && !gc.isTracked(abs) {
+			continue
+		}
+		committed = append(committed, abs)
+	}
+	if len(committed) == 0 {
+		return nil
+	}
+	paths = committed
+
+	add := gitCommand(append([]string{"add", "-A", "--"}, paths...)...)
+	if output, err := add.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to stage %s: %s", strings.Join(paths, ", "), strings.TrimSpace(string(output)))
+	}
+
+	commit := gitCommand(append([]string{"commit", "-m", message, "--"}, paths...)...)
+	if output, err := commit.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to commit %s: %s", strings.Join(paths, ", "), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// isTracked reports whether git tracks any file under path
+func (gc *OSGitClient) isTracked(path string) bool {
+	output, err := gitCommand("ls-files", "--", path).Output()
+	return err == nil && strings.TrimSpace(string(output)) != ""
+}
+
+// RetryPolicy controls how RetryingGitClient retries failed git commands.
+// Backoff doubles after each failed attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first (values below 1 mean 1)
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff time.Duration
+	// Retryable reports whether an error is transient; nil uses IsRetryableGitError
+	Retryable func(err error) bool
+	// Sleep waits between attempts; nil uses time.Sleep (injectable for tests)
+	Sleep func(d time.Duration)
+}
+
+// DefaultRetryPolicy returns the retry policy used for git commands:
+// 3 attempts with 100ms, then 200ms backoff
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+	}
+}
+
+// retryableGitErrors are messages from transient git failures, such as
+// another git process holding the index lock
+var retryableGitErrors = []string{
+	"index.lock",
+	"another git process",
+	"unable to create",
+	"cannot lock ref",
+}
+
+// IsRetryableGitError reports whether a git error looks transient (lock contention).
+// Errors like "not a git repository" are not retryable.
+func IsRetryableGitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, pattern := range retryableGitErrors {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryingGitClient wraps a GitClient and retries transient failures with
+// exponential backoff. Non-retryable errors are returned immediately.
+type RetryingGitClient struct {
+	client GitClient
+	policy RetryPolicy
+}
+
+// NewRetryingGitClient wraps client with the given retry policy.
+//
+// Example:
+//
+//	git := NewRetryingGitClient(NewOSGitClient(), DefaultRetryPolicy())
+func NewRetryingGitClient(client GitClient, policy RetryPolicy) *RetryingGitClient {
+	if policy.Retryable == nil {
+		policy.Retryable = IsRetryableGitError
+	}
+	if policy.Sleep == nil {
+		policy.Sleep = time.Sleep
+	}
+	return &RetryingGitClient{client: client, policy: policy}
+}
+
+// do runs op until it succeeds, fails with a non-retryable error, or runs out of attempts
+func (rc *RetryingGitClient) do(op func() error) error {
+	backoff := rc.policy.InitialBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = op()
+		if err == nil || attempt >= rc.policy.MaxAttempts || !rc.policy.Retryable(err) {
+			return err
+		}
+		rc.policy.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// CreateBranch creates a new git branch, retrying on lock contention
+func (rc *RetryingGitClient) CreateBranch(branchName string) error {
+	return rc.do(func() error {
+		return rc.client.CreateBranch(branchName)
+	})
+}
+
+// DeleteBranch deletes a branch, retrying on lock contention
+func (rc *RetryingGitClient) DeleteBranch(branchName string) error {
+	return rc.do(func() error {
+		return rc.client.DeleteBranch(branchName)
+	})
+}
+
+// RenameBranch renames a branch, retrying on lock contention
+func (rc *RetryingGitClient) RenameBranch(oldName, newName string) error {
+	return rc.do(func() error {
+		return rc.client.RenameBranch(oldName, newName)
+	})
+}
+
+// BranchExists checks if a branch exists. It reports no error, so it is not retried.
+func (rc *RetryingGitClient) BranchExists(branchName string) bool {
+	return rc.client.BranchExists(branchName)
+}
+
+// GetCurrentBranch returns the current branch name, retrying on lock contention
+func (rc *RetryingGitClient) GetCurrentBranch() (string, error) {
+	var branch string
+	err := rc.do(func() error {
+		var err error
+		branch, err = rc.client.GetCurrentBranch()
+		return err
+	})
+	return branch, err
+}
+
+// GetGitUserName returns the git user name, retrying on lock contention
+func (rc *RetryingGitClient) GetGitUserName() (string, error) {
+	var name string
+	err := rc.do(func() error {
+		var err error
+		name, err = rc.client.GetGitUserName()
+		return err
+	})
+	return name, err
+}
+
+// ReadFileAtRef returns the contents of path as of ref, retrying on lock contention
+func (rc *RetryingGitClient) ReadFileAtRef(path, ref string) ([]byte, error) {
+	var data []byte
+	err := rc.do(func() error {
+		var err error
+		data, err = rc.client.ReadFileAtRef(path, ref)
+		return err
+	})
+	return data, err
+}
+
+// GetRefDate returns the commit date of ref, retrying on lock contention
+func (rc *RetryingGitClient) GetRefDate(ref string) (time.Time, error) {
+	var date time.Time
+	err := rc.do(func() error {
+		var err error
+		date, err = rc.client.GetRefDate(ref)
+		return err
+	})
+	return date, err
+}
+
+// CommitChanges commits paths, retrying on lock contention
+func (rc *RetryingGitClient) CommitChanges(paths []string, message string) error {
+	return rc.do(func() error {
+		return rc.client.CommitChanges(paths, message)
+	})
+}
+
+// DefaultBranchSeparator joins branch name segments when Config.BranchSeparator is empty
+const DefaultBranchSeparator = "/"
+
 // BranchNamer generates branch names for work items.
-// It creates standardized branch names based on item type and name.
-type BranchNamer struct{}
+// It creates standardized branch names based on item type and name, with an
+// optional prefix segment and a configurable segment separator.
+type BranchNamer struct {
+	prefix    string
+	separator string
+}
 
 // NewBranchNamer creates a new branch namer.
 // No configuration needed for standard branch naming.
 func NewBranchNamer() *BranchNamer {
-	return &BranchNamer{}
+	return &BranchNamer{separator: DefaultBranchSeparator}
+}
+
+// NewBranchNamerWithConfig creates a branch namer using the configured
+// BranchPrefix and BranchSeparator.
+func NewBranchNamerWithConfig(config Config) *BranchNamer {
+	separator := config.BranchSeparator
+	if separator == "" {
+		separator = DefaultBranchSeparator
+	}
+	return &BranchNamer{
+		prefix:    strings.TrimSuffix(config.BranchPrefix, separator),
+		separator: separator,
+	}
 }
 
 // GenerateBranchName creates a branch name for a work item.
-// Format: "{itemType}/{name}" (e.g., "feature/user-auth").
+// Format: "{prefix}/{itemType}/{name}" (e.g., "feature/user-auth" without a prefix,
+// "wi/feature/user-auth" with prefix "wi").
 func (bn *BranchNamer) GenerateBranchName(itemType ItemType, name string) string {
-	return fmt.Sprintf("%s/%s", itemType, name)
+	return bn.join(string(itemType), name)
+}
+
+// GeneratePhaseBranchName creates a branch name for a work item phase.
+// Format: "{prefix}/{itemType}/{name}/{phase}".
+func (bn *BranchNamer) GeneratePhaseBranchName(itemType ItemType, name string, phase WorkPhase) string {
+	return bn.join(string(itemType), name, string(phase))
+}
+
+// join joins segments with the separator, after the prefix if one is set
+func (bn *BranchNamer) join(segments ...string) string {
+	if bn.prefix != "" {
+		segments = append([]string{bn.prefix}, segments...)
+	}
+	return strings.Join(segments, bn.separator)
 }
 
 // GitIntegration handles git operations for work items.
@@ -102,14 +441,35 @@ type GitIntegration struct {
 // NewGitIntegration creates a new git integration instance.
 // Requires a GitClient implementation for git operations.
 func NewGitIntegration(client GitClient) *GitIntegration {
+	return NewGitIntegrationWithNamer(client, NewBranchNamer())
+}
+
+// NewGitIntegrationWithNamer creates a git integration instance that names
+// branches with namer, e.g. one built by NewBranchNamerWithConfig.
+func NewGitIntegrationWithNamer(client GitClient, namer *BranchNamer) *GitIntegration {
 	return &GitIntegration{
 		client: client,
-		namer:  NewBranchNamer(),
+		namer:  namer,
 	}
 }
 
+// BranchName returns the branch CreateWorkItemBranch creates for a work item
+func (gi *GitIntegration) BranchName(itemType ItemType, name string) string {
+	return gi.namer.GenerateBranchName(itemType, name)
+}
+
+// PhaseBranchName returns the branch CreateWorkItemBranchForPhase creates for a work item phase
+func (gi *GitIntegration) PhaseBranchName(itemType ItemType, name string, phase WorkPhase) string {
+	return gi.namer.GeneratePhaseBranchName(itemType, name, phase)
+}
+
+// BranchExists reports whether the branch exists
+func (gi *GitIntegration) BranchExists(branchName string) bool {
+	return gi.client.BranchExists(branchName)
+}
+
 // CreateWorkItemBranch creates a git branch for a new work item.
-// Branch name format: "{itemType}/{name}". Does not fail if branch already exists.
+// Branch name format: "{prefix}/{itemType}/{name}". Does not fail if branch already exists.
 func (gi *GitIntegration) CreateWorkItemBranch(itemType ItemType, name string) error {
 	branchName := gi.namer.GenerateBranchName(itemType, name)
 
@@ -127,10 +487,82 @@ func (gi *GitIntegration) CreateWorkItemBranch(itemType ItemType, name string) e
 	return nil
 }
 
+// DeleteWorkItemBranches deletes the branches CreateWorkItem and AdvancePhase
+// create for a work item: its item branch and any per-phase branches. dirName
+// is the "<type>-<name>" directory name. It returns the deleted branches and
+// the failures, if any.
+func (gi *GitIntegration) DeleteWorkItemBranches(itemType ItemType, dirName string) ([]string, error) {
+	candidates := []string{gi.BranchName(itemType, strings.TrimPrefix(dirName, string(itemType)+"-"))}
+	for _, phase := range []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup} {
+		candidates = append(candidates, gi.PhaseBranchName(itemType, dirName, phase))
+	}
+
+	var deleted []string
+	var errs []error
+	for _, branch := range candidates {
+		if !gi.client.BranchExists(branch) {
+			continue
+		}
+		if err := gi.client.DeleteBranch(branch); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted = append(deleted, branch)
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// RenameWorkItemBranches renames the branches CreateWorkItem and AdvancePhase
+// created for a work item, its item branch and any per-phase branches, after
+// the work item is renamed from oldDirName to newDirName ("<type>-<name>"
+// directory names). It returns the new names of the renamed branches and the
+// failures, if any.
+func (gi *GitIntegration) RenameWorkItemBranches(itemType ItemType, oldDirName, newDirName string) ([]string, error) {
+	prefix := string(itemType) + "-"
+	type rename struct{ from, to string }
+	candidates := []rename{{
+		gi.BranchName(itemType, strings.TrimPrefix(oldDirName, prefix)),
+		gi.BranchName(itemType, strings.TrimPrefix(newDirName, prefix)),
+	}}
+	for _, phase := range []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup} {
+		candidates = append(candidates, rename{gi.PhaseBranchName(itemType, oldDirName, phase), gi.PhaseBranchName(itemType, newDirName, phase)})
+	}
+
+	var renamed []string
+	var errs []error
+	for _, branch := range candidates {
+		if !gi.client.BranchExists(branch.from) {
+			continue
+		}
+		if err := gi.client.RenameBranch(branch.from, branch.to); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		renamed = append(renamed, branch.to)
+	}
+	return renamed, errors.Join(errs...)
+}
+
+// GetRefDate returns the commit date of a git ref
+func (gi *GitIntegration) GetRefDate(ref string) (time.Time, error) {
+	return gi.client.GetRefDate(ref)
+}
+
+// CommitWorkItem commits the given work item paths with message, as
+// config.AutoCommit does after creating, updating or advancing a work item
+func (gi *GitIntegration) CommitWorkItem(paths []string, message string) error {
+	return gi.client.CommitChanges(paths, message)
+}
+
+// GetUserName returns the configured git user name, used to record work item authors
+func (gi *GitIntegration) GetUserName() (string, error) {
+	return gi.client.GetGitUserName()
+}
+
 // CreateWorkItemBranchForPhase creates a git branch for a work item phase.
-// Branch name format: "{itemType}/{name}/{phase}". Does not fail if branch already exists.
+// Branch name format: "{prefix}/{itemType}/{name}/{phase}". Does not fail if branch already exists.
 func (gi *GitIntegration) CreateWorkItemBranchForPhase(itemType ItemType, name string, phase WorkPhase) error {
-	branchName := fmt.Sprintf("%s/%s/%s", itemType, name, phase)
+	branchName := gi.namer.GeneratePhaseBranchName(itemType, name, phase)
 
 	if gi.client.BranchExists(branchName) {
 		// Branch already exists, don't error
@@ -164,6 +596,14 @@ func (gc *NoOpGitClient) BranchExists(branchName string) bool {
 	return false
 }
 
+func (gc *NoOpGitClient) DeleteBranch(branchName string) error {
+	return nil
+}
+
+func (gc *NoOpGitClient) RenameBranch(oldName, newName string) error {
+	return nil
+}
+
 func (gc *NoOpGitClient) GetCurrentBranch() (string, error) {
 	return "main", nil
 }
@@ -171,3 +611,15 @@ func (gc *NoOpGitClient) GetCurrentBranch() (string, error) {
 func (gc *NoOpGitClient) GetGitUserName() (string, error) {
 	return "test-user", nil
 }
+
+func (gc *NoOpGitClient) ReadFileAtRef(path, ref string) ([]byte, error) {
+	return nil, fmt.Errorf("reading %s at %s: %w", path, ref, ErrNotSupported)
+}
+
+func (gc *NoOpGitClient) GetRefDate(ref string) (time.Time, error) {
+	return time.Time{}, fmt.Errorf("resolving %s: %w", ref, ErrNotSupported)
+}
+
+func (gc *NoOpGitClient) CommitChanges(paths []string, message string) error {
+	return nil
+}
@@ -1,9 +1,17 @@
 package pm
 
 import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGitIntegration(t *testing.T) {
@@ -26,4 +34,178 @@ func TestBranchNamer(t *testing.T) {
 
 	branchName = bn.GenerateBranchName(TypeBug, "fix-crash")
 	assert.Equal(t, "bug/fix-crash", branchName)
+
+	branchName = bn.GeneratePhaseBranchName(TypeFeature, "user-auth", PhaseExecution)
+	assert.Equal(t, "feature/user-auth/execution", branchName)
+}
+
+func TestBranchNamerWithConfig(t *testing.T) {
+	config := DefaultConfig()
+	config.BranchPrefix = "wi"
+	bn := NewBranchNamerWithConfig(config)
+	assert.Equal(t, "wi/feature/user-auth", bn.GenerateBranchName(TypeFeature, "user-auth"))
+	assert.Equal(t, "wi/feature/user-auth/planning", bn.GeneratePhaseBranchName(TypeFeature, "user-auth", PhasePlanning))
+
+	// A trailing separator on the prefix is not doubled
+	config.BranchPrefix = "wi/"
+	assert.Equal(t, "wi/bug/crash", NewBranchNamerWithConfig(config).GenerateBranchName(TypeBug, "crash"))
+
+	config.BranchPrefix = ""
+	config.BranchSeparator = "-"
+	assert.Equal(t, "bug-crash", NewBranchNamerWithConfig(config).GenerateBranchName(TypeBug, "crash"))
+
+	// An empty separator falls back to the default
+	config.BranchSeparator = ""
+	assert.Equal(t, "bug/crash", NewBranchNamerWithConfig(config).GenerateBranchName(TypeBug, "crash"))
+}
+
+// flakyGitClient fails CreateBranch with err for the first failures calls
+type flakyGitClient struct {
+	NoOpGitClient
+	failures int
+	err      error
+	calls    int
+}
+
+func (gc *flakyGitClient) CreateBranch(branchName string) error {
+	gc.calls++
+	if gc.calls <= gc.failures {
+		return gc.err
+	}
+	return nil
+}
+
+func TestRetryingGitClient(t *testing.T) {
+	lockErr := errors.New("fatal: Unable to create '/repo/.git/index.lock': File exists")
+
+	var sleeps []time.Duration
+	policy := DefaultRetryPolicy()
+	policy.Sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+
+	// Succeeds after transient failures, with exponential backoff
+	flaky := &flakyGitClient{failures: 2, err: lockErr}
+	err := NewRetryingGitClient(flaky, policy).CreateBranch("feature/x")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, flaky.calls)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, sleeps)
+
+	// Gives up after MaxAttempts
+	flaky = &flakyGitClient{failures: 5, err: lockErr}
+	err = NewRetryingGitClient(flaky, policy).CreateBranch("feature/x")
+	assert.ErrorIs(t, err, lockErr)
+	assert.Equal(t, 3, flaky.calls)
+
+	// Fails fast on real errors
+	flaky = &flakyGitClient{failures: 1, err: errors.New("fatal: not a git repository")}
+	err = NewRetryingGitClient(flaky, policy).CreateBranch("feature/x")
+	assert.Error(t, err)
+	assert.Equal(t, 1, flaky.calls)
+}
+
+func TestOSGitClientLockFailureIsRetried(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as git")
+	}
+
+	// A git that hits index.lock contention on its first run only
+	bin := t.TempDir()
+	calls := filepath.Join(bin, "calls")
+	script := `#!/bin/sh
+echo run >> "` + calls + `"
+if [ "$(wc -l < "` + calls + `")" -eq 1 ]; then
+	echo "fatal: Unable to create '/repo/.git/index.lock': File exists." >&2
+	exit 128
+fi
+echo main
+`
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "git"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	// The error carries git's output, so the lock failure is recognised
+	_, err := NewOSGitClient().GetCurrentBranch()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index.lock")
+	assert.True(t, IsRetryableGitError(err))
+
+	require.NoError(t, os.Remove(calls))
+	policy := DefaultRetryPolicy()
+	policy.Sleep = func(time.Duration) {}
+	branch, err := NewRetryingGitClient(NewOSGitClient(), policy).GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+	runs, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(runs), "run"))
+}
+
+// initTestRepo creates a git repository with one commit in a temporary directory
+func initTestRepo(t *testing.T) string {
+	t.Helper()
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.name", "test"},
+		{"config", "user.email", "test@example.com"},
+		{"commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		output, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+	return dir
+}
+
+func TestOSGitClientUsesRepo(t *testing.T) {
+	repoA := initTestRepo(t)
+	repoB := initTestRepo(t)
+
+	origWd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoA))
+	defer func() {
+		_ = os.Chdir(origWd)
+		repoDir = "."
+		reloadConfigForTesting()
+	}()
+
+	// Run from repo A with --repo pointing at repo B
+	require.NoError(t, UseRepo(repoB))
+	client := NewOSGitClient()
+	require.NoError(t, client.CreateBranch("feature/x"))
+	assert.True(t, client.BranchExists("feature/x"))
+
+	branch, err := client.GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x", branch)
+
+	output, err := exec.Command("git", "-C", repoA, "branch", "--list", "feature/x").Output()
+	require.NoError(t, err)
+	assert.Empty(t, string(output), "the working directory's repository is untouched")
+}
+
+func TestOSGitClientCommitChanges(t *testing.T) {
+	repo := initTestRepo(t)
+	defer func() {
+		repoDir = "."
+		reloadConfigForTesting()
+	}()
+	require.NoError(t, UseRepo(repo))
+
+	item := filepath.Join(repo, "backlog", "proposed", "feature-x")
+	require.NoError(t, os.MkdirAll(item, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(item, "README.md"), []byte("# Feature: x\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "unrelated.txt"), []byte("staged\n"), 0644))
+	require.NoError(t, exec.Command("git", "-C", repo, "add", "unrelated.txt").Run())
+
+	// The item was created in the flat backlog, then moved before it was ever tracked
+	never := filepath.Join(repo, "backlog", "feature-x")
+	require.NoError(t, NewOSGitClient().CommitChanges([]string{never, item}, "pm: create feature-x"))
+
+	output, err := exec.Command("git", "-C", repo, "show", "--name-only", "--format=%s", "HEAD").Output()
+	require.NoError(t, err)
+	assert.Equal(t, "pm: create feature-x\n\nbacklog/proposed/feature-x/README.md\n", string(output))
+
+	output, err = exec.Command("git", "-C", repo, "status", "--porcelain").Output()
+	require.NoError(t, err)
+	assert.Equal(t, "A  unrelated.txt\n", string(output), "other staged changes stay out of the commit")
 }
@@ -0,0 +1,190 @@
+package pm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultGitHubAPIURL is the GitHub REST API GitHubSync talks to when no other is given
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// githubIssueURLRegex matches an issue URL such as
+// "https://github.com/org/repo/issues/42", capturing the host, owner, repo and
+// number
+var githubIssueURLRegex = regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/issues/(\d+)/?$`)
+
+// GitHubSync mirrors work item status onto the GitHub issues they link with
+// "## Issue:". The HTTP client is injectable so syncing can be tested without
+// the network.
+type GitHubSync struct {
+	token  string
+	apiURL string
+	client *http.Client
+}
+
+// NewGitHubSync creates a GitHub sync authenticating with token.
+// If apiURL is empty, DefaultGitHubAPIURL is used (set it for GitHub
+// Enterprise); if client is nil, http.DefaultClient is used.
+//
+// Example:
+//
+//	github := NewGitHubSync(config.GitHubToken, "", nil)
+//	err := github.PushStatus(ctx, *item)
+func NewGitHubSync(token, apiURL string, client *http.Client) *GitHubSync {
+	if apiURL == "" {
+		apiURL = DefaultGitHubAPIURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &GitHubSync{token: token, apiURL: strings.TrimSuffix(apiURL, "/"), client: client}
+}
+
+// GitHubPhaseLabel returns the issue label PushStatus applies for phase, e.g. "phase: planning"
+func GitHubPhaseLabel(phase WorkPhase) string {
+	return "phase: " + string(phase)
+}
+
+// PushStatus reflects the work item's current phase on its linked issue: it
+// removes the labels of other phases, adds the current phase's label and
+// comments with the phase, status and progress. An issue that already has the
+// current phase's label is left as is, so repeated syncs don't comment again.
+// It returns a ValidationError when the item has no issue URL or the URL isn't
+// an issue on github.com or the configured API's host.
+func (gs *GitHubSync) PushStatus(ctx context.Context, item WorkItem) error {
+	issuePath, err := gs.issuePath(item.IssueURL)
+	if err != nil {
+		return err
+	}
+
+	var current []struct {
+		Name string `json:"name"`
+	}
+	if err := gs.do(ctx, http.MethodGet, issuePath+"/labels", nil, &current); err != nil {
+		return fmt.Errorf("failed to list labels: %w", err)
+	}
+	onIssue := make(map[string]bool, len(current))
+	for _, label := range current {
+		onIssue[label.Name] = true
+	}
+
+	for _, phase := range []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup} {
+		if phase == item.Phase || !onIssue[GitHubPhaseLabel(phase)] {
+			continue
+		}
+		// Someone may have removed the label since; GitHub reports that as 404
+		path := issuePath + "/labels/" + url.PathEscape(GitHubPhaseLabel(phase))
+		if err := gs.do(ctx, http.MethodDelete, path, nil, nil, http.StatusNotFound); err != nil {
+			return fmt.Errorf("failed to remove label: %w", err)
+		}
+	}
+
+	if onIssue[GitHubPhaseLabel(item.Phase)] {
+		return nil
+	}
+
+	labels := map[string][]string{"labels": {GitHubPhaseLabel(item.Phase)}}
+	if err := gs.do(ctx, http.MethodPost, issuePath+"/labels", labels, nil); err != nil {
+		return fmt.Errorf("failed to add label: %w", err)
+	}
+
+	comment := map[string]string{"body": githubStatusComment(item)}
+	if err := gs.do(ctx, http.MethodPost, issuePath+"/comments", comment, nil); err != nil {
+		return fmt.Errorf("failed to comment: %w", err)
+	}
+	return nil
+}
+
+// githubStatusComment is the comment PushStatus posts for item
+func githubStatusComment(item WorkItem) string {
+	return fmt.Sprintf("go-pm: `%s` is in the **%s** phase (status `%s`, %d%% complete).",
+		item.Name, item.Phase, item.Status, item.Progress)
+}
+
+// issuePath returns the REST API path of the issue at issueURL, e.g.
+// "/repos/org/repo/issues/42". The issue must be on github.com or, for GitHub
+// Enterprise, on the host of the configured API URL, so the owner and repo
+// really name a repository the API serves.
+func (gs *GitHubSync) issuePath(issueURL string) (string, error) {
+	if issueURL == "" {
+		return "", &ValidationError{Field: "issue_url", Message: `work item has no "## Issue:" URL`}
+	}
+	matches := githubIssueURLRegex.FindStringSubmatch(issueURL)
+	if matches == nil {
+		return "", &ValidationError{Field: "issue_url", Value: issueURL, Message: "not a GitHub issue URL (expected https://github.com/<owner>/<repo>/issues/<number>)"}
+	}
+	host := strings.ToLower(matches[1])
+	if host != "github.com" && host != gs.enterpriseHost() {
+		return "", &ValidationError{Field: "issue_url", Value: issueURL, Message: fmt.Sprintf("issue is on %s, not on github.com or the configured GitHub API's host", matches[1])}
+	}
+	number, _ := strconv.Atoi(matches[4])
+	return fmt.Sprintf("/repos/%s/%s/issues/%d", url.PathEscape(matches[2]), url.PathEscape(matches[3]), number), nil
+}
+
+// enterpriseHost returns the host of a GitHub Enterprise API URL, such as
+// "ghe.example.com" for "https://ghe.example.com/api/v3", or "" when the
+// public API is used
+func (gs *GitHubSync) enterpriseHost() string {
+	if gs.apiURL == DefaultGitHubAPIURL {
+		return ""
+	}
+	api, err := url.Parse(gs.apiURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(api.Host)
+}
+
+// do sends an authenticated API request with body encoded as JSON (none if
+// nil) and decodes the JSON response into out (unless nil). Responses other
+// than 2xx and the allowed status codes are errors.
+func (gs *GitHubSync) do(ctx context.Context, method, path string, body, out any, allowed ...int) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, gs.apiURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+gs.token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := gs.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call GitHub: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if out != nil {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				return fmt.Errorf("failed to decode GitHub response for %s %s: %w", method, path, err)
+			}
+		}
+		return nil
+	}
+	for _, status := range allowed {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+	return fmt.Errorf("GitHub returned %s for %s %s", resp.Status, method, path)
+}
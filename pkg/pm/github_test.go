@@ -0,0 +1,113 @@
+package pm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubSyncPushStatus(t *testing.T) {
+	var requests []string
+	var labels map[string][]string
+	var comment map[string]string
+	issueLabels := `[{"name": "bug"}, {"name": "phase: planning"}, {"name": "phase: cleanup"}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(issueLabels))
+		case r.Method == http.MethodDelete:
+			// Removed by someone else in the meantime
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/labels"):
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&labels))
+		case strings.HasSuffix(r.URL.Path, "/comments"):
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	created, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "mirrored"})
+	require.NoError(t, err)
+	content, err := fs.ReadFile(created.Path)
+	require.NoError(t, err)
+	content = append(content, []byte("\n## Issue: https://github.com/org/repo/issues/42\n")...)
+	require.NoError(t, fs.WriteFile(created.Path, content))
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-mirrored"))
+
+	item, err := manager.GetWorkItem(ctx, "feature-mirrored")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/org/repo/issues/42", item.IssueURL)
+
+	github := NewGitHubSync("secret", server.URL, server.Client())
+	require.NoError(t, github.PushStatus(ctx, *item), "labels already gone from the issue aren't errors")
+
+	// Only the phase labels on the issue are removed
+	assert.Contains(t, requests, "DELETE /repos/org/repo/issues/42/labels/phase:%20planning")
+	assert.Contains(t, requests, "DELETE /repos/org/repo/issues/42/labels/phase:%20cleanup")
+	assert.NotContains(t, requests, "DELETE /repos/org/repo/issues/42/labels/phase:%20execution")
+	assert.NotContains(t, requests, "DELETE /repos/org/repo/issues/42/labels/bug")
+	assert.Equal(t, []string{"phase: discovery"}, labels["labels"])
+	assert.Contains(t, comment["body"], "**discovery** phase")
+
+	// An issue already labelled with the phase gets no new label or comment
+	issueLabels = `[{"name": "phase: discovery"}]`
+	requests = nil
+	require.NoError(t, github.PushStatus(ctx, *item))
+	assert.Equal(t, []string{"GET /repos/org/repo/issues/42/labels"}, requests)
+
+	var validationErr *ValidationError
+	item.IssueURL = ""
+	assert.ErrorAs(t, github.PushStatus(ctx, *item), &validationErr)
+	item.IssueURL = "https://example.com/not-an-issue"
+	assert.ErrorAs(t, github.PushStatus(ctx, *item), &validationErr)
+}
+
+func TestGitHubSyncIssueHost(t *testing.T) {
+	public := NewGitHubSync("secret", "", nil)
+	path, err := public.issuePath("https://github.com/org/repo/issues/42")
+	require.NoError(t, err)
+	assert.Equal(t, "/repos/org/repo/issues/42", path)
+
+	// Issues elsewhere would send owner/repo (and the token) to the wrong place
+	var validationErr *ValidationError
+	_, err = public.issuePath("https://gitlab.com/org/repo/issues/42")
+	assert.ErrorAs(t, err, &validationErr)
+	_, err = public.issuePath("https://ghe.example.com/org/repo/issues/42")
+	assert.ErrorAs(t, err, &validationErr)
+
+	// GitHub Enterprise issues live on the API's host
+	enterprise := NewGitHubSync("secret", "https://ghe.example.com/api/v3", nil)
+	path, err = enterprise.issuePath("https://ghe.example.com/org/repo/issues/7")
+	require.NoError(t, err)
+	assert.Equal(t, "/repos/org/repo/issues/7", path)
+	_, err = enterprise.issuePath("https://github.com/org/repo/issues/7")
+	assert.NoError(t, err)
+	_, err = enterprise.issuePath("https://evil.example.com/org/repo/issues/7")
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestGitHubSyncPushStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	item := WorkItem{Name: "feature-x", Phase: PhaseDiscovery, IssueURL: "https://github.com/org/repo/issues/1"}
+	err := NewGitHubSync("bad", server.URL, server.Client()).PushStatus(context.Background(), item)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
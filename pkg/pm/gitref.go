@@ -0,0 +1,133 @@
+package pm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// GitRefFileSystem is a read-only FileSystem that reads files as of a git ref,
+// such as a branch or release tag, instead of from the working tree. Every
+// write fails, so a manager built on it can list and show work items only.
+type GitRefFileSystem struct {
+	client GitClient
+	ref    string
+}
+
+// NewGitRefFileSystem creates a read-only file system over the files at ref.
+//
+// Example:
+//
+//	fs := NewGitRefFileSystem(NewOSGitClient(), "v1.2.0")
+//	data, err := fs.ReadFile("/repo/backlog/feature-login/README.md")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func NewGitRefFileSystem(client GitClient, ref string) *GitRefFileSystem {
+	return &GitRefFileSystem{client: client, ref: ref}
+}
+
+// readOnlyError reports a write attempted against the git ref
+func (fs *GitRefFileSystem) readOnlyError(path string) error {
+	return fmt.Errorf("can't write %s: work items at git ref %s are read-only", path, fs.ref)
+}
+
+// tree returns the entries of the directory at path, with subdirectories
+// suffixed by "/", and whether path is a directory at the ref
+func (fs *GitRefFileSystem) tree(path string) ([]string, bool) {
+	data, err := fs.client.ReadFileAtRef(path, fs.ref)
+	if err != nil || !bytes.HasPrefix(data, []byte("tree "+fs.ref+":")) {
+		return nil, false
+	}
+	_, listing, _ := strings.Cut(string(data), "\n\n")
+	var entries []string
+	for _, entry := range strings.Split(listing, "\n") {
+		if entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, true
+}
+
+// CreateDirectory fails; the git ref is read-only
+func (fs *GitRefFileSystem) CreateDirectory(path string) error {
+	return fs.readOnlyError(path)
+}
+
+// CopyFile fails; the git ref is read-only
+func (fs *GitRefFileSystem) CopyFile(src, dst string) error {
+	return fs.readOnlyError(dst)
+}
+
+// WriteFile fails; the git ref is read-only
+func (fs *GitRefFileSystem) WriteFile(path string, data []byte) error {
+	return fs.readOnlyError(path)
+}
+
+// ReadFile reads the contents of the file at path as of the ref
+func (fs *GitRefFileSystem) ReadFile(path string) ([]byte, error) {
+	if _, isDir := fs.tree(path); isDir {
+		return nil, fmt.Errorf("%s is a directory at %s", path, fs.ref)
+	}
+	return fs.client.ReadFileAtRef(path, fs.ref)
+}
+
+// FileExists checks if a file exists at the ref
+func (fs *GitRefFileSystem) FileExists(path string) bool {
+	if _, isDir := fs.tree(path); isDir {
+		return false
+	}
+	_, err := fs.client.ReadFileAtRef(path, fs.ref)
+	return err == nil
+}
+
+// DirectoryExists checks if a directory exists at the ref
+func (fs *GitRefFileSystem) DirectoryExists(path string) bool {
+	_, isDir := fs.tree(path)
+	return isDir
+}
+
+// ListDirectories lists the directories in path at the ref
+func (fs *GitRefFileSystem) ListDirectories(path string) ([]string, error) {
+	entries, isDir := fs.tree(path)
+	if !isDir {
+		return nil, fmt.Errorf("%s is not a directory at %s", path, fs.ref)
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if name, ok := strings.CutSuffix(entry, "/"); ok {
+			dirs = append(dirs, name)
+		}
+	}
+	return dirs, nil
+}
+
+// ListFiles lists the files in path at the ref
+func (fs *GitRefFileSystem) ListFiles(path string) ([]string, error) {
+	entries, isDir := fs.tree(path)
+	if !isDir {
+		return nil, fmt.Errorf("%s is not a directory at %s", path, fs.ref)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry, "/") {
+			files = append(files, entry)
+		}
+	}
+	return files, nil
+}
+
+// MoveDirectory fails; the git ref is read-only
+func (fs *GitRefFileSystem) MoveDirectory(src, dst string) error {
+	return fs.readOnlyError(src)
+}
+
+// RemoveDirectory fails; the git ref is read-only
+func (fs *GitRefFileSystem) RemoveDirectory(path string) error {
+	return fs.readOnlyError(path)
+}
+
+// CheckWritable always reports that the git ref is read-only
+func (fs *GitRefFileSystem) CheckWritable(path string) error {
+	return fs.readOnlyError(path)
+}
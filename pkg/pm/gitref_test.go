@@ -0,0 +1,55 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRefFileSystem(t *testing.T) {
+	config := DefaultConfig()
+	git := NewMockGitClient()
+	readme := "# Feature: Login\n\n## Status: PROPOSED\n## Phase: discovery\n\n## Tasks\n\n- [x] Document requirements\n"
+	git.AddFileAtRef("v1.0", filepath.Join(config.BacklogDir, "feature-login", config.WorkItemFile), []byte(readme))
+	git.AddFileAtRef("v1.0", filepath.Join(config.BacklogDir, "feature-login", "notes.md"), []byte("notes"))
+
+	fs := NewGitRefFileSystem(git, "v1.0")
+	assert.True(t, fs.DirectoryExists(config.BacklogDir))
+	assert.False(t, fs.FileExists(config.BacklogDir))
+	dirs, err := fs.ListDirectories(config.BacklogDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-login"}, dirs)
+	files, err := fs.ListFiles(filepath.Join(config.BacklogDir, "feature-login"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{config.WorkItemFile, "notes.md"}, files)
+
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+	items, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "feature-login", items[0].Name)
+	assert.Equal(t, StatusProposed, items[0].Status)
+
+	item, err := manager.GetWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 1)
+	assert.True(t, item.Tasks[0].Completed)
+
+	// Work items at a ref can't be changed
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "crash"})
+	assert.ErrorContains(t, err, "read-only")
+	assert.Error(t, manager.UpdateStatus(ctx, "feature-login", StatusInProgressPlanning))
+
+	// Paths missing at the ref don't exist
+	_, err = NewGitRefFileSystem(git, "v0.9").ReadFile(item.Path)
+	assert.Error(t, err)
+}
+
+func TestNoOpGitClientReadFileAtRef(t *testing.T) {
+	_, err := NewNoOpGitClient().ReadFileAtRef("backlog/feature-login/README.md", "main")
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
@@ -0,0 +1,249 @@
+package pm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// historyFileName is the append-only change log kept in each work item directory
+const historyFileName = "history.jsonl"
+
+// History fields recorded in the change log
+const (
+	HistoryFieldStatus = "status"
+	HistoryFieldPhase  = "phase"
+)
+
+// HistoryEntry is one recorded change to a work item, stored as a JSON line in history.jsonl
+type HistoryEntry struct {
+	Timestamp time.Time `json:"timestamp"`     // When the change happened
+	Actor     string    `json:"actor"`         // Who made the change
+	Field     string    `json:"field"`         // Which field changed (status, phase)
+	Old       string    `json:"old,omitempty"` // Previous value, empty when the item was created
+	New       string    `json:"new"`           // New value
+}
+
+// HistoryRecord is a history entry together with the work item it belongs to,
+// as produced by ExportHistory. Unlike HistoryEntry, every field is always
+// present in its JSON form, so exports have a fixed schema.
+type HistoryRecord struct {
+	WorkItem  string    `json:"work_item"` // Directory name of the work item
+	Timestamp time.Time `json:"timestamp"` // When the change happened
+	Actor     string    `json:"actor"`     // Who made the change
+	Field     string    `json:"field"`     // Which field changed (status, phase)
+	Old       string    `json:"old"`       // Previous value, empty when the item was created
+	New       string    `json:"new"`       // New value
+}
+
+// PhaseTransition describes one visit to a phase in a work item's history
+type PhaseTransition struct {
+	Phase     WorkPhase     `json:"phase"`      // The phase that was entered
+	EnteredAt time.Time     `json:"entered_at"` // When the phase was entered
+	Duration  time.Duration `json:"duration"`   // Time spent in the phase; for the current phase, up to now
+}
+
+// setPhaseDurations sets how long each transition lasted: until the next
+// transition, or until end for the last one
+func setPhaseDurations(transitions []PhaseTransition, end time.Time) {
+	for i := range transitions {
+		until := end
+		if i+1 < len(transitions) {
+			until = transitions[i+1].EnteredAt
+		}
+		transitions[i].Duration = max(until.Sub(transitions[i].EnteredAt), 0)
+	}
+}
+
+// historyActor identifies who is making a change, from the USER environment variable
+func historyActor() string {
+	if user := os.Getenv("USER"); user != "" {
+		return user
+	}
+	return "unknown"
+}
+
+// appendHistory appends entries to the history log in a work item directory
+func (s *WorkItemService) appendHistory(dir string, entries ...HistoryEntry) error {
+	historyPath := filepath.Join(dir, historyFileName)
+
+	var b strings.Builder
+	if s.fs.FileExists(historyPath) {
+		existing, err := s.fs.ReadFile(historyPath)
+		if err != nil {
+			return err
+		}
+		b.Write(existing)
+	}
+
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		b.Write(line)
+		b.WriteString("\n")
+	}
+
+	return s.fs.WriteFile(historyPath, []byte(b.String()))
+}
+
+// fieldChange is a single field update to record in the history log
+type fieldChange struct {
+	field string
+	old   string
+	new   string
+}
+
+// recordChanges logs field changes for a work item, skipping fields whose value didn't change.
+// Failures are reported as warnings so history logging never blocks the change itself.
+func (s *WorkItemService) recordChanges(dir string, changes ...fieldChange) {
+	now := time.Now().UTC()
+	actor := historyActor()
+
+	var entries []HistoryEntry
+	for _, change := range changes {
+		if change.old == change.new {
+			continue
+		}
+		entries = append(entries, HistoryEntry{Timestamp: now, Actor: actor, Field: change.field, Old: change.old, New: change.new})
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := s.appendHistory(dir, entries...); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record history: %v\n", err)
+	}
+}
+
+// readHistory reads the history log in a work item directory.
+// A missing log yields no entries.
+func (s *WorkItemService) readHistory(dir string) ([]HistoryEntry, error) {
+	historyPath := filepath.Join(dir, historyFileName)
+	if !s.fs.FileExists(historyPath) {
+		return nil, nil
+	}
+
+	data, err := s.fs.ReadFile(historyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry HistoryEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("invalid history entry on line %d: %w", lineNum, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+// buildPhaseTimeline turns phase history entries into transitions. Each phase
+// lasts until the next transition; the last one lasts until the item was
+// completed, or until now if it is still open.
+func buildPhaseTimeline(entries []HistoryEntry, now time.Time) []PhaseTransition {
+	var timeline []PhaseTransition
+	end := now
+
+	for _, entry := range entries {
+		switch entry.Field {
+		case HistoryFieldPhase:
+			timeline = append(timeline, PhaseTransition{Phase: WorkPhase(entry.New), EnteredAt: entry.Timestamp})
+			end = now
+		case HistoryFieldStatus:
+			if ItemStatus(entry.New) == StatusCompleted {
+				end = entry.Timestamp
+			} else {
+				end = now
+			}
+		}
+	}
+
+	setPhaseDurations(timeline, end)
+	return timeline
+}
+
+// buildStatusDurations sums how long the item spent in each status from its
+// status history entries. Each status lasts until the next status change; the
+// current one lasts until now. COMPLETED is terminal and accrues no time.
+func buildStatusDurations(entries []HistoryEntry, now time.Time) map[ItemStatus]time.Duration {
+	durations := make(map[ItemStatus]time.Duration)
+	var current ItemStatus
+	var since time.Time
+
+	for _, entry := range entries {
+		if entry.Field != HistoryFieldStatus {
+			continue
+		}
+		if current != "" {
+			durations[current] += entry.Timestamp.Sub(since)
+		}
+		current, since = ItemStatus(entry.New), entry.Timestamp
+	}
+
+	if current != "" && current != StatusCompleted {
+		durations[current] += now.Sub(since)
+	}
+	return durations
+}
+
+// ExportHistory calls fn with every recorded history entry of the named work
+// item, in the order they were logged. With an empty name it covers every
+// work item in the backlog and the completed directory, one item after the
+// other. Items without a history log contribute nothing. It stops and returns
+// the error as soon as fn returns one or ctx is cancelled.
+//
+// Example:
+//
+//	encoder := json.NewEncoder(os.Stdout)
+//	err := service.ExportHistory(ctx, "", func(record HistoryRecord) error {
+//		return encoder.Encode(record)
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) ExportHistory(ctx context.Context, name string, fn func(HistoryRecord) error) error {
+	if name != "" {
+		if err := validateWorkItemName(name); err != nil {
+			return err
+		}
+		dir := s.itemDir(name)
+		if !s.fs.FileExists(filepath.Join(dir, s.config.WorkItemFile)) {
+			return &WorkItemError{Op: "export_history", Name: name, Err: s.missingReadmeError(dir)}
+		}
+		return s.exportHistoryDir(name, dir, fn)
+	}
+
+	return s.StreamWorkItems(ctx, ListFilter{Scope: ScopeAll}, func(item WorkItem) error {
+		return s.exportHistoryDir(item.Name, filepath.Dir(item.Path), fn)
+	})
+}
+
+// exportHistoryDir calls fn with each history entry logged in a work item directory
+func (s *WorkItemService) exportHistoryDir(name, dir string, fn func(HistoryRecord) error) error {
+	entries, err := s.readHistory(dir)
+	if err != nil {
+		return &WorkItemError{Op: "export_history", Name: name, Err: fmt.Errorf("failed to read history: %w", err)}
+	}
+	for _, entry := range entries {
+		record := HistoryRecord{WorkItem: name, Timestamp: entry.Timestamp, Actor: entry.Actor, Field: entry.Field, Old: entry.Old, New: entry.New}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package pm
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerHistoryRecording(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	service := manager.service
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "history"})
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-history"))
+	require.NoError(t, manager.SetPhase(ctx, "feature-history", PhasePlanning))
+	// Unchanged values are not logged
+	require.NoError(t, manager.SetPhase(ctx, "feature-history", PhasePlanning))
+
+	entries, err := service.readHistory(filepath.Join(config.BacklogDir, "feature-history"))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	assert.Equal(t, HistoryFieldStatus, entries[0].Field)
+	assert.Equal(t, string(StatusProposed), entries[0].New)
+	assert.Equal(t, HistoryFieldStatus, entries[2].Field)
+	assert.Equal(t, string(StatusProposed), entries[2].Old)
+	assert.Equal(t, string(StatusInProgressDiscovery), entries[2].New)
+	assert.Equal(t, string(PhasePlanning), entries[3].New)
+
+	timeline, err := manager.GetPhaseTimeline(ctx, "feature-history")
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, PhaseDiscovery, timeline[0].Phase)
+	assert.Equal(t, PhasePlanning, timeline[1].Phase)
+
+	_, err = manager.GetPhaseTimeline(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestBuildPhaseTimeline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	entries := []HistoryEntry{
+		{Timestamp: start, Field: HistoryFieldPhase, New: string(PhaseDiscovery)},
+		{Timestamp: start.Add(48 * time.Hour), Field: HistoryFieldPhase, Old: string(PhaseDiscovery), New: string(PhasePlanning)},
+		{Timestamp: start.Add(50 * time.Hour), Field: HistoryFieldStatus, New: string(StatusInProgressPlanning)},
+	}
+	now := start.Add(72 * time.Hour)
+
+	timeline := buildPhaseTimeline(entries, now)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, 48*time.Hour, timeline[0].Duration)
+	assert.Equal(t, 24*time.Hour, timeline[1].Duration) // current phase runs up to now
+
+	// A completed item's last phase ends at completion
+	entries = append(entries, HistoryEntry{Timestamp: start.Add(60 * time.Hour), Field: HistoryFieldStatus, New: string(StatusCompleted)})
+	timeline = buildPhaseTimeline(entries, now)
+	assert.Equal(t, 12*time.Hour, timeline[1].Duration)
+
+	// Out-of-order timestamps (clock skew, hand edits) never give negative durations
+	skewed := []HistoryEntry{
+		{Timestamp: start, Field: HistoryFieldPhase, New: string(PhaseDiscovery)},
+		{Timestamp: start.Add(-time.Hour), Field: HistoryFieldPhase, Old: string(PhaseDiscovery), New: string(PhasePlanning)},
+	}
+	timeline = buildPhaseTimeline(skewed, start.Add(-2*time.Hour))
+	require.Len(t, timeline, 2)
+	assert.Zero(t, timeline[0].Duration)
+	assert.Zero(t, timeline[1].Duration)
+
+	assert.Empty(t, buildPhaseTimeline(nil, now))
+}
+
+func TestPhaseTimelineUsesReadmePhaseHistory(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	created, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "timeline"})
+	require.NoError(t, err)
+
+	// Without a "## Phase History" the history log is used
+	timeline, err := manager.GetPhaseTimeline(ctx, "feature-timeline")
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+	assert.Equal(t, PhaseDiscovery, timeline[0].Phase)
+
+	// Once the README records phases, it is the timeline, matching WorkItem.PhaseHistory
+	discovery := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, manager.service.updater.AppendPhaseHistory(created.Path, PhaseDiscovery, discovery))
+	require.NoError(t, manager.service.updater.AppendPhaseHistory(created.Path, PhasePlanning, discovery.Add(24*time.Hour)))
+
+	timeline, err = manager.GetPhaseTimeline(ctx, "feature-timeline")
+	require.NoError(t, err)
+	item, err := manager.GetWorkItem(ctx, "feature-timeline")
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, discovery, timeline[0].EnteredAt)
+	assert.Equal(t, 24*time.Hour, timeline[0].Duration)
+	assert.Equal(t, PhasePlanning, timeline[1].Phase)
+	assert.Equal(t, item.PhaseHistory[0], timeline[0])
+}
+
+func TestManagerExportHistory(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "export"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-export"))
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "done"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "bug-done", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "bug-done"))
+
+	collect := func(name string) []HistoryRecord {
+		var records []HistoryRecord
+		require.NoError(t, manager.ExportHistory(ctx, name, func(record HistoryRecord) error {
+			records = append(records, record)
+			return nil
+		}))
+		return records
+	}
+
+	records := collect("feature-export")
+	require.Len(t, records, 3)
+	for _, record := range records {
+		assert.Equal(t, "feature-export", record.WorkItem)
+		assert.False(t, record.Timestamp.IsZero())
+	}
+	assert.Equal(t, HistoryRecord{
+		WorkItem: "feature-export", Timestamp: records[2].Timestamp, Actor: records[2].Actor,
+		Field: HistoryFieldStatus, Old: string(StatusProposed), New: string(StatusInProgressDiscovery),
+	}, records[2])
+
+	// Without a name, archived items are exported too
+	items := map[string]int{}
+	for _, record := range collect("") {
+		items[record.WorkItem]++
+	}
+	assert.Equal(t, map[string]int{"feature-export": 3, "bug-done": 3}, items)
+
+	// Records always carry every field, even an empty old value
+	data, err := json.Marshal(records[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"old":""`)
+
+	err = manager.ExportHistory(ctx, "feature-missing", func(HistoryRecord) error { return nil })
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestBuildStatusDurations(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	entries := []HistoryEntry{
+		{Timestamp: start, Field: HistoryFieldStatus, New: string(StatusProposed)},
+		{Timestamp: start, Field: HistoryFieldPhase, New: string(PhaseDiscovery)},
+		{Timestamp: start.Add(2 * time.Hour), Field: HistoryFieldStatus, Old: string(StatusProposed), New: string(StatusInProgressDiscovery)},
+		{Timestamp: start.Add(26 * time.Hour), Field: HistoryFieldStatus, Old: string(StatusInProgressDiscovery), New: string(StatusProposed)},
+		{Timestamp: start.Add(27 * time.Hour), Field: HistoryFieldStatus, Old: string(StatusProposed), New: string(StatusInProgressDiscovery)},
+	}
+	now := start.Add(30 * time.Hour)
+
+	// Repeat visits add up, and the current status runs until now
+	assert.Equal(t, map[ItemStatus]time.Duration{
+		StatusProposed:            3 * time.Hour,
+		StatusInProgressDiscovery: 27 * time.Hour,
+	}, buildStatusDurations(entries, now))
+
+	// Completion stops the clock
+	completed := append(entries, HistoryEntry{Timestamp: start.Add(28 * time.Hour), Field: HistoryFieldStatus, New: string(StatusCompleted)})
+	durations := buildStatusDurations(completed, now)
+	assert.Equal(t, 25*time.Hour, durations[StatusInProgressDiscovery])
+	assert.NotContains(t, durations, StatusCompleted)
+
+	assert.Empty(t, buildStatusDurations(nil, now))
+}
+
+func TestManagerGetStatusDurations(t *testing.T) {
+	config := DefaultConfig()
+	manager := NewDefaultManagerWithDeps(config, NewMockFileSystem(), NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "durations"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-durations"))
+
+	durations, err := manager.GetStatusDurations(ctx, "feature-durations")
+	require.NoError(t, err)
+	assert.Contains(t, durations, StatusProposed)
+	assert.Contains(t, durations, StatusInProgressDiscovery)
+
+	_, err = manager.GetStatusDurations(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
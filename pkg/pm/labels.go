@@ -0,0 +1,94 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// AddLabel adds a free-form label, such as "backend" or "q3-goal", to the
+// work item's "## Labels:" line. Adding a label the item already has is a
+// no-op.
+//
+// Example:
+//
+//	err := service.AddLabel(ctx, "feature-user-auth", "backend")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) AddLabel(ctx context.Context, name, label string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	label = strings.TrimSpace(label)
+	if label == "" || strings.ContainsAny(label, ",\r\n") {
+		return &ValidationError{Field: "label", Value: label, Message: "label must be non-empty and cannot contain commas or newlines"}
+	}
+
+	item, readmePath, err := s.backlogItem(name, "add_label")
+	if err != nil {
+		return err
+	}
+	if slices.Contains(item.Labels, label) {
+		return nil
+	}
+
+	if err := s.updater.UpdateLabels(readmePath, append(item.Labels, label)); err != nil {
+		return &WorkItemError{Op: "add_label", Name: name, Err: fmt.Errorf("failed to update labels: %w", err)}
+	}
+	return nil
+}
+
+// RemoveLabel removes a label from the work item's "## Labels:" line. It
+// returns a ValidationError when the item doesn't have the label.
+//
+// Example:
+//
+//	err := service.RemoveLabel(ctx, "feature-user-auth", "backend")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) RemoveLabel(ctx context.Context, name, label string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	label = strings.TrimSpace(label)
+	item, readmePath, err := s.backlogItem(name, "remove_label")
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(item.Labels, label) {
+		return &ValidationError{Field: "label", Value: label, Message: fmt.Sprintf("work item '%s' has no such label", name)}
+	}
+
+	labels := slices.DeleteFunc(item.Labels, func(l string) bool { return l == label })
+	if err := s.updater.UpdateLabels(readmePath, labels); err != nil {
+		return &WorkItemError{Op: "remove_label", Name: name, Err: fmt.Errorf("failed to update labels: %w", err)}
+	}
+	return nil
+}
+
+// backlogItem parses a backlog work item for a change to its metadata,
+// returning it with its README path
+func (s *WorkItemService) backlogItem(name, op string) (WorkItem, string, error) {
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return WorkItem{}, "", &WorkItemError{Op: op, Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return WorkItem{}, "", &WorkItemError{Op: op, Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	return item, readmePath, nil
+}
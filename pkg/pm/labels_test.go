@@ -0,0 +1,53 @@
+package pm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRemoveLabel(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "search", Labels: []string{"backend"}})
+	require.NoError(t, err)
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "slow-query"})
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AddLabel(ctx, "feature-search", "q3-goal"))
+	require.NoError(t, manager.AddLabel(ctx, "feature-search", "q3-goal"), "adding an existing label is a no-op")
+	require.NoError(t, manager.AddLabel(ctx, "bug-slow-query", "backend"))
+
+	item, err := manager.GetWorkItem(ctx, "feature-search")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backend", "q3-goal"}, item.Labels)
+
+	names := func(labels ...string) []string {
+		items, err := manager.ListWorkItems(ctx, ListFilter{Labels: labels})
+		require.NoError(t, err)
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+	assert.ElementsMatch(t, []string{"feature-search", "bug-slow-query"}, names("backend"))
+	assert.Equal(t, []string{"feature-search"}, names("backend", "q3-goal"), "multiple labels must all match")
+	assert.Empty(t, names("frontend"))
+
+	require.NoError(t, manager.RemoveLabel(ctx, "feature-search", "backend"))
+	item, err = manager.GetWorkItem(ctx, "feature-search")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"q3-goal"}, item.Labels)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.RemoveLabel(ctx, "feature-search", "backend"), &validationErr)
+	assert.ErrorAs(t, manager.AddLabel(ctx, "feature-search", "a,b"), &validationErr)
+	assert.ErrorIs(t, manager.AddLabel(ctx, "feature-missing", "backend"), ErrWorkItemNotFound)
+}
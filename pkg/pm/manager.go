@@ -3,6 +3,10 @@ package pm
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
 )
 
 // DefaultManager is the default implementation of the Manager interface.
@@ -21,258 +25,1272 @@ type DefaultManager struct {
 //	manager := NewDefaultManager(config)
 //	helper := NewCLIHelper(manager, config)
 //	err := helper.CreateAndReport(ctx, TypeFeature, "user-auth")
+//
+// Output is colored automatically when stdout is a terminal; use SetColorMode to override.
 func NewCLIHelper(manager Manager, config Config) *CLIHelper {
 	return &CLIHelper{
 		manager: manager,
 		config:  config,
 		fs:      NewOSFileSystem(),
+		color:   NewColorizer(ColorAuto, os.Stdout),
+	}
+}
+
+// NewDefaultManager creates a new default manager with standard dependencies.
+// It uses the OS filesystem and git client for all operations; git commands
+// are retried with DefaultRetryPolicy when they hit transient lock contention.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+func NewDefaultManager(config Config) *DefaultManager {
+	fs := NewOSFileSystem()
+	gitClient := NewRetryingGitClient(NewOSGitClient(), DefaultRetryPolicy())
+
+	return &DefaultManager{
+		service: NewWorkItemService(config, fs, gitClient),
+	}
+}
+
+// NewDefaultManagerAtRef creates a read-only manager that reads work items as
+// of a git ref, such as a branch or release tag, without checking it out.
+// Listing and showing work items work as usual; every change fails. It returns
+// an error if ref can't be read from the repository.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager, err := NewDefaultManagerAtRef(config, "v1.2.0")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	items, err := manager.ListWorkItems(ctx, ListFilter{})
+func NewDefaultManagerAtRef(config Config, ref string) (*DefaultManager, error) {
+	gitClient := NewRetryingGitClient(NewOSGitClient(), DefaultRetryPolicy())
+	if _, err := gitClient.ReadFileAtRef(detectRepoRoot(), ref); err != nil {
+		return nil, fmt.Errorf("can't read git ref %s: %w", ref, err)
+	}
+
+	return &DefaultManager{
+		service: NewWorkItemService(config, NewGitRefFileSystem(gitClient, ref), gitClient),
+	}, nil
+}
+
+// NewDefaultManagerWithDeps creates a new default manager with custom dependencies.
+// This is primarily useful for testing or when custom filesystem/git implementations
+// are needed.
+//
+// Example:
+//
+//	fs := NewMockFileSystem()
+//	git := NewMockGitClient()
+//	manager := NewDefaultManagerWithDeps(config, fs, git)
+func NewDefaultManagerWithDeps(config Config, fs FileSystem, gitClient GitClient) *DefaultManager {
+	return &DefaultManager{
+		service: NewWorkItemService(config, fs, gitClient),
 	}
 }
 
-// NewDefaultManager creates a new default manager with standard dependencies.
-// It uses the OS filesystem and git client for all operations.
+// CreateWorkItem creates a new work item with the given parameters.
+// It generates the directory structure, applies templates, creates a git branch,
+// and returns the created work item. The work item starts in PROPOSED status
+// in the discovery phase.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	req := CreateRequest{Type: TypeFeature, Name: "user-auth"}
+//	item, err := manager.CreateWorkItem(ctx, req)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Created work item: %s\n", item.Name)
+func (m *DefaultManager) CreateWorkItem(ctx context.Context, req CreateRequest) (*WorkItem, error) {
+	return m.service.CreateWorkItem(ctx, req)
+}
+
+// ListWorkItems returns work items matching the filter criteria.
+// Use an empty filter to return all work items.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	filter := ListFilter{Status: StatusInProgressExecution}
+//	items, err := manager.ListWorkItems(ctx, filter)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, item := range items {
+//		fmt.Printf("%s: %s\n", item.Name, item.Status)
+//	}
+func (m *DefaultManager) ListWorkItems(ctx context.Context, filter ListFilter) ([]WorkItem, error) {
+	return m.service.ListWorkItems(ctx, filter)
+}
+
+// StreamWorkItems calls fn for each work item matching the filter without
+// holding the whole backlog in memory. Iteration stops early when fn returns
+// an error or ctx is cancelled, and that error is returned.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.StreamWorkItems(ctx, ListFilter{Type: TypeBug}, func(item WorkItem) error {
+//		fmt.Println(item.Name)
+//		return nil
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) StreamWorkItems(ctx context.Context, filter ListFilter, fn func(WorkItem) error) error {
+	return m.service.StreamWorkItems(ctx, filter, fn)
+}
+
+// GetWorkItem retrieves a specific work item by name.
+// Returns an error if the work item doesn't exist.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.GetWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Work item: %s (%s)\n", item.Title, item.Status)
+func (m *DefaultManager) GetWorkItem(ctx context.Context, name string) (*WorkItem, error) {
+	return m.service.GetWorkItem(ctx, name)
+}
+
+// BranchName returns the git branch created for a new work item, following
+// the configured branch_prefix and branch_separator. name is the name given
+// at creation, without the type prefix of the directory name.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	fmt.Println(manager.BranchName(TypeFeature, "user-auth")) // feature/user-auth
+func (m *DefaultManager) BranchName(itemType ItemType, name string) string {
+	return m.service.BranchName(itemType, name)
+}
+
+// CurrentUser returns the identity of whoever runs go-pm: the git user name,
+// falling back to the OS user when git has no user configured.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AssignWorkItem(ctx, "feature-user-auth", manager.CurrentUser())
+func (m *DefaultManager) CurrentUser() string {
+	return m.service.CurrentUser()
+}
+
+// GetWorkItemByPath retrieves a work item from its directory path.
+// Unlike GetWorkItem, the directory may live anywhere, including the
+// completed directory. The name is inferred from the directory basename.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.GetWorkItemByPath(ctx, "work-items/completed/feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Work item: %s (%s)\n", item.Name, item.Status)
+func (m *DefaultManager) GetWorkItemByPath(ctx context.Context, path string) (*WorkItem, error) {
+	return m.service.GetWorkItemByPath(ctx, path)
+}
+
+// RepairWorkItem regenerates a minimal README.md for a backlog directory whose
+// README has been deleted. The work item type is inferred from the directory
+// prefix (feature-, bug-, experiment-) and the item restarts as PROPOSED.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.RepairWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Repaired: %s\n", item.Path)
+func (m *DefaultManager) RepairWorkItem(ctx context.Context, name string) (*WorkItem, error) {
+	return m.service.RepairWorkItem(ctx, name)
+}
+
+// FindMissingReadmes lists backlog directories that have no README.md.
+// These directories are skipped by ListWorkItems and can be fixed with RepairWorkItem.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	names, err := manager.FindMissingReadmes(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%d directories need repair\n", len(names))
+func (m *DefaultManager) FindMissingReadmes(ctx context.Context) ([]string, error) {
+	return m.service.FindMissingReadmes(ctx)
+}
+
+// FindOrphanedWorkItems reports work items left between the backlog and the
+// completed directory by an interrupted archive. Items archived without a
+// postmortem can be finished by archiving them again.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	problems, err := manager.FindOrphanedWorkItems(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, problem := range problems {
+//		fmt.Println(problem.Error())
+//	}
+func (m *DefaultManager) FindOrphanedWorkItems(ctx context.Context) ([]ValidationError, error) {
+	return m.service.FindOrphanedWorkItems(ctx)
+}
+
+// UpdateStatus updates the status of a work item.
+// This may trigger phase transitions or other workflow changes.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.UpdateStatus(ctx, "feature-user-auth", StatusInProgressExecution)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Status updated successfully")
+func (m *DefaultManager) UpdateStatus(ctx context.Context, name string, status ItemStatus) error {
+	return m.service.UpdateStatus(ctx, name, status)
+}
+
+// UpdateProgress updates the progress of a work item.
+// Progress is represented as a percentage (0-100).
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.UpdateProgress(ctx, "feature-user-auth", 75)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Progress updated to 75%")
+func (m *DefaultManager) UpdateProgress(ctx context.Context, name string, progress int) error {
+	return m.service.UpdateProgress(ctx, name, progress)
+}
+
+// RecalculateProgress sets a work item's progress from its task completion,
+// fixing a "## Progress:" that drifted from the checklist. Returns the new
+// progress.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	progress, err := manager.RecalculateProgress(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Progress is now %d%%\n", progress)
+func (m *DefaultManager) RecalculateProgress(ctx context.Context, name string) (int, error) {
+	return m.service.RecalculateProgress(ctx, name)
+}
+
+// AssignWorkItem assigns a work item to a user.
+// The assignee field will be updated in the work item.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AssignWorkItem(ctx, "feature-user-auth", "john.doe@example.com")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Work item assigned to john.doe@example.com")
+func (m *DefaultManager) AssignWorkItem(ctx context.Context, name string, assignee string) error {
+	return m.service.AssignWorkItem(ctx, name, assignee)
+}
+
+// SetPriority sets the triage priority of a work item, written as its
+// "## Priority:" heading.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.SetPriority(ctx, "bug-login-crash", PriorityCritical)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) SetPriority(ctx context.Context, name string, priority Priority) error {
+	return m.service.SetPriority(ctx, name, priority)
+}
+
+// SetEstimate sets the total effort estimate of a work item in hours, written
+// as its "## Estimate:" heading. Progress reports compare it against the time
+// recorded in the item's phase history.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.SetEstimate(ctx, "feature-user-auth", 16)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) SetEstimate(ctx context.Context, name string, hours float64) error {
+	return m.service.SetEstimate(ctx, name, hours)
+}
+
+// AddLabel adds a free-form label, such as "backend" or "q3-goal", to a work
+// item. Adding a label the item already has is a no-op.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AddLabel(ctx, "feature-user-auth", "backend")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) AddLabel(ctx context.Context, name, label string) error {
+	return m.service.AddLabel(ctx, name, label)
+}
+
+// RemoveLabel removes a label from a work item.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.RemoveLabel(ctx, "feature-user-auth", "backend")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) RemoveLabel(ctx context.Context, name, label string) error {
+	return m.service.RemoveLabel(ctx, name, label)
+}
+
+// RenameWorkItem renames a backlog work item's directory, its title when it
+// still matches the old name, other items' dependencies on it and related item
+// links to it and, with git enabled, its branches. newName may omit the type prefix.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.RenameWorkItem(ctx, "feature-user-auht", "user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Renamed to %s\n", item.Name)
+func (m *DefaultManager) RenameWorkItem(ctx context.Context, oldName, newName string) (*WorkItem, error) {
+	return m.service.RenameWorkItem(ctx, oldName, newName)
+}
+
+// AddDependency records that a work item can't start until dependency is
+// COMPLETED. AdvancePhase refuses to move a PROPOSED item into discovery while
+// a dependency is unfinished.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AddDependency(ctx, "feature-sso", "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) AddDependency(ctx context.Context, name, dependency string) error {
+	return m.service.AddDependency(ctx, name, dependency)
+}
+
+// RemoveDependency removes a dependency from a work item.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.RemoveDependency(ctx, "feature-sso", "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) RemoveDependency(ctx context.Context, name, dependency string) error {
+	return m.service.RemoveDependency(ctx, name, dependency)
+}
+
+// ListBlocked returns the unfinished work items with dependencies that aren't
+// COMPLETED yet, each with those dependencies.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	blocked, err := manager.ListBlocked(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, b := range blocked {
+//		fmt.Printf("%s is waiting on %v\n", b.Item.Name, b.BlockedBy)
+//	}
+func (m *DefaultManager) ListBlocked(ctx context.Context) ([]BlockedItem, error) {
+	return m.service.ListBlocked(ctx)
+}
+
+// AdvancePhase advances a work item to the next phase in its workflow.
+// This automatically updates the status and may create new tasks.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AdvancePhase(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Work item advanced to next phase")
+func (m *DefaultManager) AdvancePhase(ctx context.Context, name string) error {
+	return m.service.AdvancePhase(ctx, name)
+}
+
+// AdvancePhaseWithWarnings advances a work item to the next phase like
+// AdvancePhase. With phase_advance_strict disabled, incomplete current-phase
+// tasks don't block the advance and are returned so callers can warn about them.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	skipped, err := manager.AdvancePhaseWithWarnings(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, task := range skipped {
+//		fmt.Printf("Warning: advanced with incomplete task %q\n", task.Description)
+//	}
+func (m *DefaultManager) AdvancePhaseWithWarnings(ctx context.Context, name string) ([]Task, error) {
+	return m.service.AdvancePhaseWithWarnings(ctx, name)
+}
+
+// PreviewAdvance reports what AdvancePhase would do to a work item without
+// changing anything: the current and next phase and status, incomplete tasks,
+// unmet phase requirements and any reviewer handoff. CanAdvance on the result
+// predicts whether AdvancePhase would succeed.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	preview, err := manager.PreviewAdvance(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s -> %s\n", preview.CurrentStatus, preview.NextStatus)
+func (m *DefaultManager) PreviewAdvance(ctx context.Context, name string) (*AdvancePreview, error) {
+	return m.service.PreviewAdvance(ctx, name)
+}
+
+// SetPhase sets a work item to a specific phase.
+// This may reset progress and create appropriate tasks for the phase.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.SetPhase(ctx, "feature-user-auth", PhaseExecution)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Work item set to execution phase")
+func (m *DefaultManager) SetPhase(ctx context.Context, name string, phase WorkPhase) error {
+	return m.service.SetPhase(ctx, name, phase)
+}
+
+// GetPhaseTasks returns tasks for the current phase of a work item.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	tasks, err := manager.GetPhaseTasks(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, task := range tasks {
+//		fmt.Printf("Task: %s (Completed: %v)\n", task.Description, task.Completed)
+//	}
+func (m *DefaultManager) GetPhaseTasks(ctx context.Context, name string) ([]Task, error) {
+	return m.service.GetPhaseTasks(ctx, name)
+}
+
+// CompleteTask marks a task as completed.
+// Task IDs can be obtained using GetPhaseTasks().
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	// Get tasks first to find task ID
+//	tasks, err := manager.GetPhaseTasks(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if len(tasks) > 0 {
+//		err = manager.CompleteTask(ctx, "feature-user-auth", 0) // Complete first task
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Println("Task completed")
+//	}
+func (m *DefaultManager) CompleteTask(ctx context.Context, name string, taskId int) error {
+	return m.service.CompleteTask(ctx, name, taskId)
+}
+
+// MigrateWorkItem upgrades a work item's README to CurrentSchemaVersion and
+// records the new "## Schema Version:". It returns false when nothing changed.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	changed, err := manager.MigrateWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Migrated: %v\n", changed)
+func (m *DefaultManager) MigrateWorkItem(ctx context.Context, name string) (bool, error) {
+	return m.service.MigrateWorkItem(ctx, name)
+}
+
+// PreviewMigration returns the README content MigrateWorkItem would write
+// without changing the file, for dry runs.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	migrated, changed, err := manager.PreviewMigration(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if changed {
+//		fmt.Print(migrated)
+//	}
+func (m *DefaultManager) PreviewMigration(ctx context.Context, name string) (string, bool, error) {
+	return m.service.PreviewMigration(ctx, name)
+}
+
+// SplitWorkItem breaks a work item into linked child items of the same type.
+// The parent is labeled "tracking" and lists its children under "## Related Items".
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	children, err := manager.SplitWorkItem(ctx, "feature-checkout", []string{"checkout-api", "checkout-ui"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, child := range children {
+//		fmt.Printf("Created %s\n", child.Name)
+//	}
+func (m *DefaultManager) SplitWorkItem(ctx context.Context, name string, newNames []string) ([]*WorkItem, error) {
+	return m.service.SplitWorkItem(ctx, name, newNames)
+}
+
+// CompleteTaskByDescription marks a task as completed by matching its description.
+// The query must match exactly one incomplete task in the current phase
+// (case-insensitive substring), so scripts don't depend on shifting task IDs.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.CompleteTaskByDescription(ctx, "feature-user-auth", "write tests")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) CompleteTaskByDescription(ctx context.Context, name, query string) error {
+	return m.service.CompleteTaskByDescription(ctx, name, query)
+}
+
+// UncompleteTask reopens a completed task in the current phase, to undo an
+// accidental completion, and recalculates progress. Task IDs are the same as
+// for CompleteTask.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.UncompleteTask(ctx, "feature-user-auth", 2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) UncompleteTask(ctx context.Context, name string, taskId int) error {
+	return m.service.UncompleteTask(ctx, name, taskId)
+}
+
+// MarkTaskNotApplicable marks an open task in the current phase as not
+// applicable ("- [~]"). N/A tasks don't block advancing the phase and are left
+// out of the progress percentage. Task IDs are the same as for CompleteTask.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.MarkTaskNotApplicable(ctx, "feature-user-auth", 2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) MarkTaskNotApplicable(ctx context.Context, name string, taskId int) error {
+	return m.service.MarkTaskNotApplicable(ctx, name, taskId)
+}
+
+// AssignTask assigns a task in the current phase to assignee by annotating the
+// task line with "(@assignee)". An empty assignee removes the annotation, so
+// the task falls back to the work item assignee. Task IDs are the same as for
+// CompleteTask.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AssignTask(ctx, "feature-user-auth", 2, "alice")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) AssignTask(ctx context.Context, name string, taskId int, assignee string) error {
+	return m.service.AssignTask(ctx, name, taskId, assignee)
+}
+
+// GetProgressMetrics returns progress metrics for a work item.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	metrics, err := manager.GetProgressMetrics(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// The returned WorkItemMetrics contains fields such as OverallProgress,
+//	// TotalTasks, CompletedTasks and PhaseProgress. Use those fields to
+//	// construct a user-facing report. For example:
+//	fmt.Printf("Progress: %d%% (%d/%d tasks completed)\n", metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
+//	for _, pp := range metrics.PhaseProgress {
+//		fmt.Printf("  %s: %d%% (%d/%d tasks)\n", pp.Phase, pp.ProgressPercent, pp.CompletedTasks, pp.TotalTasks)
+func (m *DefaultManager) GetProgressMetrics(ctx context.Context, name string) (*WorkItemMetrics, error) {
+	return m.service.GetProgressMetrics(ctx, name)
+}
+
+// ArchiveWorkItem moves a completed work item to the completed directory.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.ArchiveWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Work item archived")
+func (m *DefaultManager) ArchiveWorkItem(ctx context.Context, name string) error {
+	return m.service.ArchiveWorkItem(ctx, name)
+}
+
+// UnarchiveWorkItem moves an archived work item from the completed directory
+// back into the backlog, leaving its POSTMORTEM.md in place. With reopen, it
+// is set back to IN_PROGRESS_REVIEW in the cleanup phase.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.UnarchiveWorkItem(ctx, "feature-user-auth", true)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Unarchived %s (%s)\n", item.Name, item.Status)
+func (m *DefaultManager) UnarchiveWorkItem(ctx context.Context, name string, reopen bool) (*WorkItem, error) {
+	return m.service.UnarchiveWorkItem(ctx, name, reopen)
+}
+
+// ArchiveCompletedWorkItems archives every work item in the backlog with
+// COMPLETED status. Items in any other status are left untouched. Failures
+// for individual items are aggregated and returned together with the names
+// of the items that were archived successfully.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	archived, err := manager.ArchiveCompletedWorkItems(ctx)
+//	if err != nil {
+//		log.Printf("some items could not be archived: %v", err)
+//	}
+//	fmt.Printf("Archived %d work items\n", len(archived))
+func (m *DefaultManager) ArchiveCompletedWorkItems(ctx context.Context) ([]string, error) {
+	return m.service.ArchiveCompletedWorkItems(ctx)
+}
+
+// DeleteWorkItem permanently removes a work item from the backlog or the
+// completed directory and, when git is enabled, deletes its branches. Work
+// items that aren't COMPLETED are only deleted when force is set.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.DeleteWorkItem(ctx, "feature-user-auth", false)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) DeleteWorkItem(ctx context.Context, name string, force bool) error {
+	return m.service.DeleteWorkItem(ctx, name, force)
+}
+
+// TrashWorkItem moves a backlog work item into the ".trash" directory next to
+// the backlog instead of deleting it, and returns its new directory. Trashed
+// items are excluded from all listings until restored.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	dir, err := manager.TrashWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Moved to %s\n", dir)
+func (m *DefaultManager) TrashWorkItem(ctx context.Context, name string) (string, error) {
+	return m.service.TrashWorkItem(ctx, name)
+}
+
+// ListTrash returns the trashed work items, most recently trashed first.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	trashed, err := manager.ListTrash(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, item := range trashed {
+//		fmt.Printf("%s (trashed %s)\n", item.Name, item.TrashedAt.Format(time.RFC3339))
+//	}
+func (m *DefaultManager) ListTrash(ctx context.Context) ([]TrashedItem, error) {
+	return m.service.ListTrash(ctx)
+}
+
+// RestoreFromTrash moves the most recently trashed work item called name back
+// into the backlog.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	item, err := manager.RestoreFromTrash(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Restored %s\n", item.Name)
+func (m *DefaultManager) RestoreFromTrash(ctx context.Context, name string) (*WorkItem, error) {
+	return m.service.RestoreFromTrash(ctx, name)
+}
+
+// EmptyTrash permanently deletes every trashed work item and returns their names.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	deleted, err := manager.EmptyTrash(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Deleted %d work items\n", len(deleted))
+func (m *DefaultManager) EmptyTrash(ctx context.Context) ([]string, error) {
+	return m.service.EmptyTrash(ctx)
+}
+
+// ExportBundle writes a work item's directory, including its README, notes,
+// postmortem and history, to w as a zip archive for sharing outside the repo.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	var buf bytes.Buffer
+//	if err := manager.ExportBundle(ctx, "feature-user-auth", &buf); err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) ExportBundle(ctx context.Context, name string, w io.Writer) error {
+	return m.service.ExportBundle(ctx, name, w)
+}
+
+// ImportBundle unpacks a zip archive written by ExportBundle into the backlog
+// and returns the imported work item. An existing work item with the same name
+// is a ValidationError unless opts renames the import or allows overwriting.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-func NewDefaultManager(config Config) *DefaultManager {
-	fs := NewOSFileSystem()
-	gitClient := NewOSGitClient()
+//	item, err := manager.ImportBundle(ctx, bytes.NewReader(data), int64(len(data)), BundleImportOptions{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Imported %s\n", item.Name)
+func (m *DefaultManager) ImportBundle(ctx context.Context, r io.ReaderAt, size int64, opts BundleImportOptions) (*WorkItem, error) {
+	return m.service.ImportBundle(ctx, r, size, opts)
+}
 
-	return &DefaultManager{
-		service: NewWorkItemService(config, fs, gitClient),
-	}
+// DiffWorkItems compares two work items, reporting the status, phase and
+// progress that differ and the tasks added, removed, or completed in only
+// one of them. Useful for auditing clones and template drift.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	diff, err := manager.DiffWorkItems(ctx, "experiment-cache", "experiment-cache-v2")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if diff.Empty() {
+//		fmt.Println("No differences")
+//	}
+func (m *DefaultManager) DiffWorkItems(ctx context.Context, a, b string) (*WorkItemDiff, error) {
+	return m.service.DiffWorkItems(ctx, a, b)
 }
 
-// NewDefaultManagerWithDeps creates a new default manager with custom dependencies.
-// This is primarily useful for testing or when custom filesystem/git implementations
-// are needed.
+// BuildDigest builds a digest of backlog work items that need follow-up,
+// such as stale in-progress items, items past their due date and items
+// blocked by unfinished dependencies.
 //
 // Example:
 //
-//	fs := NewMockFileSystem()
-//	git := NewMockGitClient()
-//	manager := NewDefaultManagerWithDeps(config, fs, git)
-func NewDefaultManagerWithDeps(config Config, fs FileSystem, gitClient GitClient) *DefaultManager {
-	return &DefaultManager{
-		service: NewWorkItemService(config, fs, gitClient),
-	}
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	digest, err := manager.BuildDigest(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(digest.Markdown([]DigestSection{DigestSectionOverdue}))
+func (m *DefaultManager) BuildDigest(ctx context.Context) (Digest, error) {
+	return m.service.BuildDigest(ctx)
 }
 
-// CreateWorkItem creates a new work item with the given parameters.
-// It generates the directory structure, applies templates, creates a git branch,
-// and returns the created work item. The work item starts in PROPOSED status
-// in the discovery phase.
+// GenerateChangelog renders markdown release notes for the work items
+// completed after since, grouped by type, with their titles and summaries.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	req := CreateRequest{Type: TypeFeature, Name: "user-auth"}
-//	item, err := manager.CreateWorkItem(ctx, req)
+//	since, err := manager.ResolveRefDate(ctx, "v1.2.0")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Printf("Created work item: %s\n", item.Name)
-func (m *DefaultManager) CreateWorkItem(ctx context.Context, req CreateRequest) (*WorkItem, error) {
-	return m.service.CreateWorkItem(ctx, req)
+//	changelog, err := manager.GenerateChangelog(ctx, since)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(changelog)
+func (m *DefaultManager) GenerateChangelog(ctx context.Context, since time.Time) (string, error) {
+	return m.service.GenerateChangelog(ctx, since)
 }
 
-// ListWorkItems returns work items matching the filter criteria.
-// Use an empty filter to return all work items.
+// ResolveRefDate returns the commit date of a git ref, such as a release tag.
+// It is independent of enable_git, but needs a git repository.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	filter := ListFilter{Status: StatusInProgressExecution}
-//	items, err := manager.ListWorkItems(ctx, filter)
+//	date, err := manager.ResolveRefDate(ctx, "v1.2.0")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	for _, item := range items {
-//		fmt.Printf("%s: %s\n", item.Name, item.Status)
+//	fmt.Printf("v1.2.0 was cut on %s\n", date.Format("2006-01-02"))
+func (m *DefaultManager) ResolveRefDate(ctx context.Context, ref string) (time.Time, error) {
+	return m.service.ResolveRefDate(ctx, ref)
+}
+
+// ListNeedingAttention returns the backlog work items that are overdue,
+// stale, or fail validation, each tagged with the reasons it needs attention.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	items, err := manager.ListNeedingAttention(ctx)
+//	if err != nil {
+//		log.Fatal(err)
 //	}
-func (m *DefaultManager) ListWorkItems(ctx context.Context, filter ListFilter) ([]WorkItem, error) {
-	return m.service.ListWorkItems(ctx, filter)
+//	fmt.Printf("%d work items need attention\n", len(items))
+func (m *DefaultManager) ListNeedingAttention(ctx context.Context) ([]AttentionItem, error) {
+	return m.service.ListNeedingAttention(ctx)
 }
 
-// GetWorkItem retrieves a specific work item by name.
-// Returns an error if the work item doesn't exist.
+// GetBacklogStats computes summary counts for the work items in scope,
+// including items per status and type, average progress, and how many are
+// stale or overdue. Pass ScopeAll to include archived items.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	item, err := manager.GetWorkItem(ctx, "feature-user-auth")
+//	stats, err := manager.GetBacklogStats(ctx, ScopeBacklog)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Printf("Work item: %s (%s)\n", item.Title, item.Status)
-func (m *DefaultManager) GetWorkItem(ctx context.Context, name string) (*WorkItem, error) {
-	return m.service.GetWorkItem(ctx, name)
+//	fmt.Print(stats.Text())
+func (m *DefaultManager) GetBacklogStats(ctx context.Context, scope ListScope) (BacklogStats, error) {
+	return m.service.GetBacklogStats(ctx, scope)
 }
 
-// UpdateStatus updates the status of a work item.
-// This may trigger phase transitions or other workflow changes.
+// GetAssigneeWorkload groups the unfinished work items by assignee, with
+// in-progress, task and overdue counts for each. Items without an assignee
+// are grouped under UnassignedKey.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.UpdateStatus(ctx, "feature-user-auth", StatusInProgressExecution)
+//	workload, err := manager.GetAssigneeWorkload(ctx)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Status updated successfully")
-func (m *DefaultManager) UpdateStatus(ctx context.Context, name string, status ItemStatus) error {
-	return m.service.UpdateStatus(ctx, name, status)
+//	for _, assignee := range AssigneesByLoad(workload) {
+//		fmt.Printf("%s: %d in progress\n", assignee, workload[assignee].InProgress)
+//	}
+func (m *DefaultManager) GetAssigneeWorkload(ctx context.Context) (map[string]AssigneeStats, error) {
+	return m.service.GetAssigneeWorkload(ctx)
 }
 
-// UpdateProgress updates the progress of a work item.
-// Progress is represented as a percentage (0-100).
+// GetVelocity counts the tasks completed in each of the last weeks weeks,
+// oldest first and ending with the current week. Both active and archived
+// work items are counted; weeks without completions have a zero count.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.UpdateProgress(ctx, "feature-user-auth", 75)
+//	velocity, err := manager.GetVelocity(ctx, 6)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Progress updated to 75%")
-func (m *DefaultManager) UpdateProgress(ctx context.Context, name string, progress int) error {
-	return m.service.UpdateProgress(ctx, name, progress)
+//	for _, week := range velocity {
+//		fmt.Printf("%s: %d\n", week.WeekStart.Format("2006-01-02"), week.Completed)
+//	}
+func (m *DefaultManager) GetVelocity(ctx context.Context, weeks int) ([]WeeklyVelocity, error) {
+	return m.service.GetVelocity(ctx, weeks)
 }
 
-// AssignWorkItem assigns a work item to a user.
-// The assignee field will be updated in the work item.
+// GetCompletionTimeseries counts the tasks completed in each bucket (BucketDay,
+// BucketWeek, or any whole number of days), oldest first, from the first
+// completion through now. Both active and archived work items are counted;
+// tasks without a completion date contribute nothing.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.AssignWorkItem(ctx, "feature-user-auth", "john.doe@example.com")
+//	series, err := manager.GetCompletionTimeseries(ctx, BucketDay)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Work item assigned to john.doe@example.com")
-func (m *DefaultManager) AssignWorkItem(ctx context.Context, name string, assignee string) error {
-	return m.service.AssignWorkItem(ctx, name, assignee)
+//	for _, b := range series {
+//		fmt.Printf("%s: %d total\n", b.Start.Format("2006-01-02"), b.Cumulative)
+//	}
+func (m *DefaultManager) GetCompletionTimeseries(ctx context.Context, bucket time.Duration) ([]TimeBucket, error) {
+	return m.service.GetCompletionTimeseries(ctx, bucket)
 }
 
-// AdvancePhase advances a work item to the next phase in its workflow.
-// This automatically updates the status and may create new tasks.
+// BuildBurndown tracks how many tasks of the named work items were left on
+// each day through today, with an ideal line that reaches zero on the latest
+// due date among them (or today when none has one).
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.AdvancePhase(ctx, "feature-user-auth")
+//	burndown, err := manager.BuildBurndown(ctx, []string{"feature-login"})
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Work item advanced to next phase")
-func (m *DefaultManager) AdvancePhase(ctx context.Context, name string) error {
-	return m.service.AdvancePhase(ctx, name)
+//	fmt.Printf("%d of %d tasks left\n", burndown.Points[len(burndown.Points)-1].Remaining, burndown.TotalTasks)
+func (m *DefaultManager) BuildBurndown(ctx context.Context, names []string) (Burndown, error) {
+	return m.service.BuildBurndown(ctx, names)
 }
 
-// SetPhase sets a work item to a specific phase.
-// This may reset progress and create appropriate tasks for the phase.
+// AddReference adds an external link, such as a design doc, ticket or
+// dashboard, to a work item's "## References" section. The URL must be an
+// absolute http or https URL; a link that is already listed is not repeated.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.SetPhase(ctx, "feature-user-auth", PhaseExecution)
+//	ref := Reference{Label: "Design doc", URL: "https://docs.example.com/auth"}
+//	err := manager.AddReference(ctx, "feature-user-auth", ref)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Work item set to execution phase")
-func (m *DefaultManager) SetPhase(ctx context.Context, name string, phase WorkPhase) error {
-	return m.service.SetPhase(ctx, name, phase)
+func (m *DefaultManager) AddReference(ctx context.Context, name string, ref Reference) error {
+	return m.service.AddReference(ctx, name, ref)
 }
 
-// GetPhaseTasks returns tasks for the current phase of a work item.
+// SetMetadata sets a custom key-value field, such as a sprint number or cost
+// center, in a work item's "## Metadata" section. Other keys are kept as is.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	tasks, err := manager.GetPhaseTasks(ctx, "feature-user-auth")
+//	err := manager.SetMetadata(ctx, "feature-user-auth", "sprint", "42")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	for _, task := range tasks {
-//		fmt.Printf("Task: %s (Completed: %v)\n", task.Description, task.Completed)
+func (m *DefaultManager) SetMetadata(ctx context.Context, name, key, value string) error {
+	return m.service.SetMetadata(ctx, name, key, value)
+}
+
+// GetMetadata returns a work item's custom key-value fields from its
+// "## Metadata" section.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	metadata, err := manager.GetMetadata(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
 //	}
-func (m *DefaultManager) GetPhaseTasks(ctx context.Context, name string) ([]Task, error) {
-	return m.service.GetPhaseTasks(ctx, name)
+//	fmt.Println(metadata["sprint"])
+func (m *DefaultManager) GetMetadata(ctx context.Context, name string) (map[string]string, error) {
+	return m.service.GetMetadata(ctx, name)
 }
 
-// CompleteTask marks a task as completed.
-// Task IDs can be obtained using GetPhaseTasks().
+// ConcludeExperiment records an experiment's outcome (succeeded, failed or
+// inconclusive) as a "## Outcome:" heading. A non-empty note is appended to
+// the experiment's notes. Only experiments can be concluded.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	// Get tasks first to find task ID
-//	tasks, err := manager.GetPhaseTasks(ctx, "feature-user-auth")
+//	err := manager.ConcludeExperiment(ctx, "experiment-cache", OutcomeSucceeded, "p95 latency down 40%")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	if len(tasks) > 0 {
-//		err = manager.CompleteTask(ctx, "feature-user-auth", 0) // Complete first task
-//		if err != nil {
-//			log.Fatal(err)
+func (m *DefaultManager) ConcludeExperiment(ctx context.Context, name string, outcome Outcome, note string) error {
+	return m.service.ConcludeExperiment(ctx, name, outcome, note)
+}
+
+// GraduateExperiment creates a feature from an experiment concluded as
+// succeeded, linking the two with graduated-from and graduated-to related items.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	feature, err := manager.GraduateExperiment(ctx, "experiment-cache", "response-cache")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Created %s\n", feature.Name)
+func (m *DefaultManager) GraduateExperiment(ctx context.Context, name, featureName string) (*WorkItem, error) {
+	return m.service.GraduateExperiment(ctx, name, featureName)
+}
+
+// ValidateWorkItem checks a backlog work item for inconsistencies the parser
+// accepts silently, such as a "## Phase:" that doesn't match its "## Status:".
+// It returns one ValidationError per problem; the error is for unreadable items.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	problems, err := manager.ValidateWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, problem := range problems {
+//		fmt.Println(problem.Error())
+//	}
+func (m *DefaultManager) ValidateWorkItem(ctx context.Context, name string) ([]ValidationError, error) {
+	return m.service.ValidateWorkItem(ctx, name)
+}
+
+// RepairPhase sets a work item's phase to the one its status is worked in
+// and reports whether it changed.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	fixed, err := manager.RepairPhase(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(fixed)
+func (m *DefaultManager) RepairPhase(ctx context.Context, name string) (bool, error) {
+	return m.service.RepairPhase(ctx, name)
+}
+
+// WithSession acquires the work item's lock once, runs fn with a Session
+// exposing the mutation methods, and releases the lock when fn returns.
+// Sessions on the same work item run one at a time, and single-call mutations
+// of the item wait for the session to finish.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.WithSession(ctx, "feature-user-auth", func(session *Session) error {
+//		if err := session.CompleteTask(0); err != nil {
+//			return err
 //		}
-//		fmt.Println("Task completed")
+//		return session.UpdateProgress(25)
+//	})
+//	if err != nil {
+//		log.Fatal(err)
 //	}
-func (m *DefaultManager) CompleteTask(ctx context.Context, name string, taskId int) error {
-	return m.service.CompleteTask(ctx, name, taskId)
+func (m *DefaultManager) WithSession(ctx context.Context, name string, fn func(session *Session) error) error {
+	return m.service.WithSession(ctx, name, fn)
 }
 
-// GetProgressMetrics returns progress metrics for a work item.
+// SyncPhaseTasks adds tasks from the current template's phase section that are
+// missing from the work item's current phase, matching by description. Use it
+// after the process templates gain new tasks so in-flight items pick them up.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	metrics, err := manager.GetProgressMetrics(ctx, "feature-user-auth")
+//	if err := manager.SyncPhaseTasks(ctx, "feature-user-auth"); err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) SyncPhaseTasks(ctx context.Context, name string) error {
+	return m.service.SyncPhaseTasks(ctx, name)
+}
+
+// GetPhaseTimeline returns the phases a work item has visited, in order, with
+// when each was entered and how long it lasted. The current phase's duration
+// runs up to now. Transitions are recorded in the work item's history.jsonl.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	timeline, err := manager.GetPhaseTimeline(ctx, "feature-user-auth")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	// The returned WorkItemMetrics contains fields such as OverallProgress,
-//	// TotalTasks, CompletedTasks and PhaseProgress. Use those fields to
-//	// construct a user-facing report. For example:
-//	fmt.Printf("Progress: %d%% (%d/%d tasks completed)\n", metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
-//	for _, pp := range metrics.PhaseProgress {
-//		fmt.Printf("  %s: %d%% (%d/%d tasks)\n", pp.Phase, pp.ProgressPercent, pp.CompletedTasks, pp.TotalTasks)
-func (m *DefaultManager) GetProgressMetrics(ctx context.Context, name string) (*WorkItemMetrics, error) {
-	return m.service.GetProgressMetrics(ctx, name)
+//	for _, t := range timeline {
+//		fmt.Printf("%s entered %s (%v)\n", t.Phase, t.EnteredAt.Format(time.RFC3339), t.Duration)
+//	}
+func (m *DefaultManager) GetPhaseTimeline(ctx context.Context, name string) ([]PhaseTransition, error) {
+	return m.service.GetPhaseTimeline(ctx, name)
 }
 
-// ArchiveWorkItem moves a completed work item to the completed directory.
+// GetStatusDurations returns how long a work item has spent in each status,
+// summed over repeat visits and including the current status up to now.
+// COMPLETED accrues no time. Items created before history logging have none.
 //
 // Example:
 //
 //	config := DefaultConfig()
 //	manager := NewDefaultManager(config)
-//	err := manager.ArchiveWorkItem(ctx, "feature-user-auth")
+//	durations, err := manager.GetStatusDurations(ctx, "feature-user-auth")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Println("Work item archived")
-func (m *DefaultManager) ArchiveWorkItem(ctx context.Context, name string) error {
-	return m.service.ArchiveWorkItem(ctx, name)
+//	for status, d := range durations {
+//		fmt.Printf("%s: %v\n", status, d)
+//	}
+func (m *DefaultManager) GetStatusDurations(ctx context.Context, name string) (map[ItemStatus]time.Duration, error) {
+	return m.service.GetStatusDurations(ctx, name)
+}
+
+// ExportHistory calls fn with every recorded status and phase change of a
+// work item, oldest first, each tagged with the work item's name. With an
+// empty name every work item in the backlog and the completed directory is
+// exported. Items without a history log contribute nothing.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.ExportHistory(ctx, "feature-user-auth", func(record HistoryRecord) error {
+//		fmt.Printf("%s %s: %s -> %s\n", record.Timestamp.Format(time.RFC3339), record.Field, record.Old, record.New)
+//		return nil
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) ExportHistory(ctx context.Context, name string, fn func(HistoryRecord) error) error {
+	return m.service.ExportHistory(ctx, name, fn)
+}
+
+// AppendNote appends a timestamped note to the work item's NOTES.md file.
+// Notes are scratch content kept separate from the tracked README.md and
+// never affect the work item's metadata.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	err := manager.AppendNote(ctx, "feature-user-auth", "Token refresh needs a follow-up")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (m *DefaultManager) AppendNote(ctx context.Context, name, note string) error {
+	return m.service.AppendNote(ctx, name, note)
+}
+
+// GetNotes returns the contents of the work item's NOTES.md file.
+// Returns an empty string if no notes have been written yet.
+//
+// Example:
+//
+//	config := DefaultConfig()
+//	manager := NewDefaultManager(config)
+//	notes, err := manager.GetNotes(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(notes)
+func (m *DefaultManager) GetNotes(ctx context.Context, name string) (string, error) {
+	return m.service.GetNotes(ctx, name)
 }
 
 type CLIHelper struct {
 	manager Manager
 	config  Config
 	fs      FileSystem
+	color   *Colorizer
+}
+
+// SetColorMode overrides automatic color detection for the helper's output.
+// Machine-readable output modes should use ColorNever.
+func (h *CLIHelper) SetColorMode(mode ColorMode) {
+	h.color = NewColorizer(mode, os.Stdout)
 }
 
 // NewCLIHelper creates a new CLI helper that provides formatted output
@@ -298,7 +1316,7 @@ func (h *CLIHelper) CreateAndReport(ctx context.Context, itemType ItemType, name
 	if item.Title != "" {
 		fmt.Printf("📝 Title: %s\n", item.Title)
 	}
-	fmt.Printf("🌿 Branch: %s/%s\n", item.Type, item.Name)
+	fmt.Printf("🌿 Branch: %s\n", h.manager.BranchName(item.Type, name))
 	fmt.Printf("\nNext steps:\n")
 	fmt.Printf("1. Edit %s with details\n", item.Path)
 	fmt.Printf("2. Update status as work progresses\n")
@@ -375,7 +1393,7 @@ func (h *CLIHelper) ListActiveAndReport(ctx context.Context) error {
 	for _, status := range activeStatuses {
 		if items, exists := statusGroups[status]; exists && len(items) > 0 {
 			hasActive = true
-			fmt.Printf("\n%s:\n", status)
+			fmt.Printf("\n%s:\n", h.color.Status(status))
 			for _, item := range items {
 				fmt.Printf("  📋 %s", item.Name)
 				if item.Title != "" {
@@ -423,7 +1441,7 @@ func (h *CLIHelper) ListAllAndReport(ctx context.Context) error {
 	statuses := []ItemStatus{StatusProposed, StatusInProgressDiscovery, StatusInProgressPlanning, StatusInProgressExecution, StatusInProgressCleanup, StatusInProgressReview, StatusCompleted}
 	for _, status := range statuses {
 		if items, exists := statusGroups[status]; exists && len(items) > 0 {
-			fmt.Printf("\n%s:\n", status)
+			fmt.Printf("\n%s:\n", h.color.Status(status))
 			for _, item := range items {
 				fmt.Printf("  📋 %s", item.Name)
 				if item.Title != "" {
@@ -441,6 +1459,49 @@ func (h *CLIHelper) ListAllAndReport(ctx context.Context) error {
 	return nil
 }
 
+// WriteTable writes work items to w as a table with aligned columns
+// (NAME, TYPE, STATUS, PHASE, PROGRESS, ASSIGNEE), one row per item.
+// Empty fields are shown as "-" so every row has the same number of columns.
+//
+// Example:
+//
+//	items, _ := manager.ListWorkItems(ctx, ListFilter{})
+//	err := helper.WriteTable(os.Stdout, items)
+func (h *CLIHelper) WriteTable(w io.Writer, items []WorkItem) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tTYPE\tSTATUS\tPHASE\tPROGRESS\tASSIGNEE")
+	for _, item := range items {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d%%\t%s\n",
+			item.Name, tableCell(string(item.Type)), tableCell(string(item.Status)),
+			tableCell(string(item.Phase)), item.Progress, tableCell(item.AssignedTo))
+	}
+	return tw.Flush()
+}
+
+// WriteStatsTable writes backlog stats to w as aligned COUNT tables per status and type
+func (h *CLIHelper) WriteStatsTable(w io.Writer, stats BacklogStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "STATUS\tCOUNT")
+	for _, status := range statsStatusOrder {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\n", status, stats.ByStatus[status])
+	}
+	_, _ = fmt.Fprintln(tw)
+	_, _ = fmt.Fprintln(tw, "TYPE\tCOUNT")
+	for _, itemType := range statsTypeOrder {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\n", itemType, stats.ByType[itemType])
+	}
+	_, _ = fmt.Fprintf(tw, "TOTAL\t%d\n", stats.Total)
+	return tw.Flush()
+}
+
+// tableCell returns value, or "-" when it is empty
+func tableCell(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 // UpdateStatusAndReport updates work item status and reports the result.
 // Status must be a valid ItemStatus constant.
 // It prints success/error messages to stdout.
@@ -449,7 +1510,7 @@ func (h *CLIHelper) UpdateStatusAndReport(ctx context.Context, name string, stat
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
-	fmt.Printf("✅ Updated '%s' status to: %s\n", name, status)
+	fmt.Printf("✅ Updated '%s' status to: %s\n", name, h.color.Status(status))
 	return nil
 }
 
@@ -479,7 +1540,14 @@ func (h *CLIHelper) ShowDetails(ctx context.Context, name string) error {
 	if item.Title != "" {
 		fmt.Printf("📝 Title: %s\n", item.Title)
 	}
-	fmt.Printf("⏱️  Status: %s\n", item.Status)
+	fmt.Printf("⏱️  Status: %s\n", h.color.Status(item.Status))
+	if !item.DueDate.IsZero() {
+		dueDate := item.DueDate.Format("2006-01-02")
+		if item.IsOverdue(time.Now()) {
+			dueDate = h.color.Red(dueDate + " (overdue)")
+		}
+		fmt.Printf("📅 Due Date: %s\n", dueDate)
+	}
 	fmt.Printf("📂 Path: %s\n", item.Path)
 
 	// Show task completion summary (this would need to be added to the service)
@@ -578,15 +1646,39 @@ func (h *CLIHelper) ShowPhaseTasks(ctx context.Context, name string) error {
 	}
 
 	fmt.Printf("Tasks for '%s' current phase:\n", name)
+	group := ""
 	for i, task := range tasks {
+		// Task IDs stay in file order; group headings are only inserted for display
+		if task.Group != group {
+			group = task.Group
+			if group != "" {
+				fmt.Printf("  %s:\n", group)
+			}
+		}
+		indent := "  "
+		if group != "" {
+			indent = "    "
+		}
 		status := "[ ]"
 		if task.Completed {
-			status = "[x]"
+			status = h.color.Green("[x]")
+		} else if task.NotApplicable {
+			status = "[~]"
 		}
-		fmt.Printf("  %d. %s %s", i, status, task.Description)
+		fmt.Printf("%s%d. %s %s", indent, i, status, task.Description)
 		if task.AssignedTo != "" {
 			fmt.Printf(" (%s)", task.AssignedTo)
 		}
+		if !task.DueDate.IsZero() {
+			due := "due " + task.DueDate.Format("2006-01-02")
+			if task.IsOverdue(time.Now()) {
+				due = h.color.Red(due + ", overdue")
+			}
+			fmt.Printf(" [%s]", due)
+		}
+		if task.Completed && !task.CompletedAt.IsZero() {
+			fmt.Printf(" [done %s]", task.CompletedAt.Format("2006-01-02"))
+		}
 		fmt.Println()
 	}
 
@@ -2,7 +2,14 @@ package pm
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +49,270 @@ func TestManagerCreateWorkItem(t *testing.T) {
 	assert.Equal(t, PhaseDiscovery, item.Phase)
 }
 
+func TestManagerCreateWorkItemWithFields(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	req := CreateRequest{
+		Type:     TypeBug,
+		Name:     "login-crash",
+		Title:    "Login crashes on empty password",
+		Priority: PriorityHigh,
+		Labels:   []string{"auth", "regression"},
+		Assignee: "alice",
+	}
+
+	item, err := manager.CreateWorkItem(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "Login crashes on empty password", item.Title)
+	assert.Equal(t, PriorityHigh, item.Priority)
+	assert.Equal(t, []string{"auth", "regression"}, item.Labels)
+	assert.Equal(t, "alice", item.AssignedTo)
+
+	// Invalid priority is rejected before anything is written
+	_, err = manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeBug, Name: "other", Priority: "URGENT"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "priority", validationErr.Field)
+}
+
+func TestManagerCreateWorkItemInitialState(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	// The phase defaults to the one the status is worked in
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "imported", InitialStatus: StatusInProgressPlanning})
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressPlanning, item.Status)
+	assert.Equal(t, PhasePlanning, item.Phase)
+
+	item, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "review", InitialStatus: StatusInProgressReview, InitialPhase: PhaseCleanup})
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressReview, item.Status)
+	assert.Equal(t, PhaseCleanup, item.Phase)
+
+	item, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "default"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusProposed, item.Status)
+	assert.Equal(t, PhaseDiscovery, item.Phase)
+
+	// Invalid or inconsistent pairs are rejected before anything is written
+	var validationErr *ValidationError
+	for _, req := range []CreateRequest{
+		{Type: TypeFeature, Name: "bad-status", InitialStatus: "STARTED"},
+		{Type: TypeFeature, Name: "bad-phase", InitialPhase: "design"},
+		{Type: TypeFeature, Name: "mismatch", InitialStatus: StatusInProgressExecution, InitialPhase: PhasePlanning},
+		{Type: TypeFeature, Name: "proposed-execution", InitialPhase: PhaseExecution},
+	} {
+		_, err = manager.CreateWorkItem(ctx, req)
+		require.ErrorAs(t, err, &validationErr, req.Name)
+		assert.False(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-"+req.Name)), req.Name)
+	}
+	assert.Equal(t, "phase", validationErr.Field)
+}
+
+func TestManagerCreateWorkItemUnwritableBacklog(t *testing.T) {
+	tempDir := t.TempDir()
+	blocker := filepath.Join(tempDir, "blocker")
+	require.NoError(t, os.WriteFile(blocker, []byte("not a directory"), 0o644))
+
+	config := DefaultConfig()
+	config.BacklogDir = filepath.Join(blocker, "backlog")
+	manager := NewDefaultManagerWithDeps(config, NewOSFileSystem(), NewNoOpGitClient())
+
+	_, err := manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeFeature, Name: "x"})
+	var workItemErr *WorkItemError
+	require.ErrorAs(t, err, &workItemErr)
+	assert.Equal(t, "create", workItemErr.Op)
+	assert.Contains(t, err.Error(), "backlog directory "+config.BacklogDir+" is not writable")
+	assert.Contains(t, err.Error(), blocker+" is not a directory")
+
+	// A missing backlog directory is fine as long as it can be created
+	assert.NoError(t, NewOSFileSystem().CheckWritable(filepath.Join(tempDir, "new", "backlog")))
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the probe file is removed")
+}
+
+func TestManagerCreatedBy(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "authored"})
+	require.NoError(t, err)
+	assert.Equal(t, "test-user", item.CreatedBy)
+	assert.Equal(t, "test-user", manager.CurrentUser())
+
+	// An item created before authors were recorded
+	dir := filepath.Join(config.BacklogDir, "feature-legacy")
+	require.NoError(t, fs.CreateDirectory(dir))
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte("# Feature: legacy\n\n## Status: PROPOSED\n")))
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{CreatedBy: "TEST-USER"})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "feature-authored", items[0].Name)
+
+	items, err = manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestManagerCreateWorkItemRejectsPathTraversal(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "parent"})
+	require.NoError(t, err)
+	dirsBefore := len(fs.dirs)
+
+	for _, name := range []string{
+		"../../etc/evil",
+		"..",
+		"nested/item",
+		`windows\item`,
+		"/etc/passwd",
+		"sneaky..name",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "name", validationErr.Field)
+
+			_, err = manager.SplitWorkItem(ctx, "feature-parent", []string{"ok", name})
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+
+	assert.Len(t, fs.dirs, dirsBefore, "no directories are created for rejected names")
+}
+
+func TestManagerRejectsPathTraversalInNames(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+
+	// A directory outside the backlog that a traversing name would resolve to
+	outside := filepath.Join(filepath.Dir(config.BacklogDir), "outside")
+	require.NoError(t, fs.CreateDirectory(outside))
+	require.NoError(t, fs.WriteFile(filepath.Join(outside, "data.txt"), []byte("keep")))
+
+	for _, name := range []string{
+		"feature-../../outside",
+		"../outside",
+		".",
+		"..",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var validationErr *ValidationError
+			_, err := manager.RepairWorkItem(ctx, name)
+			assert.ErrorAs(t, err, &validationErr, "repair")
+			assert.ErrorAs(t, manager.ArchiveWorkItem(ctx, name), &validationErr, "archive")
+			assert.ErrorAs(t, manager.AppendNote(ctx, name, "note"), &validationErr, "note")
+			assert.ErrorAs(t, manager.CompleteTask(ctx, name, 0), &validationErr, "complete task")
+			assert.ErrorAs(t, manager.SetPhase(ctx, name, PhasePlanning), &validationErr, "set phase")
+			_, err = manager.PreviewAdvance(ctx, name)
+			assert.ErrorAs(t, err, &validationErr, "preview advance")
+		})
+	}
+
+	assert.False(t, fs.FileExists(filepath.Join(outside, config.WorkItemFile)), "repair wrote no README outside the backlog")
+	assert.True(t, fs.FileExists(filepath.Join(outside, "data.txt")), "archive moved nothing out from under the backlog")
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.CompletedDir, "outside")))
+}
+
+func TestManagerCreateWorkItemIfNotExists(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "rerun"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-rerun", StatusInProgressDiscovery))
+
+	// Default behavior still rejects duplicates
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "rerun"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	// IfNotExists returns the existing item untouched
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "rerun", Title: "Ignored", IfNotExists: true})
+	require.NoError(t, err)
+	assert.Equal(t, "feature-rerun", item.Name)
+	assert.Equal(t, StatusInProgressDiscovery, item.Status)
+	assert.Equal(t, "rerun", item.Title)
+
+	// And still creates items that don't exist yet
+	item, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "fresh", IfNotExists: true})
+	require.NoError(t, err)
+	assert.Equal(t, StatusProposed, item.Status)
+}
+
+func TestManagerCreateWorkItemWithParent(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "checkout"})
+	require.NoError(t, err)
+
+	// A missing parent is rejected before anything is created
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "orphan", Parent: "feature-missing"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "parent", validationErr.Field)
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "bug-orphan")))
+
+	child, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "cart-total", Parent: "feature-checkout"})
+	require.NoError(t, err)
+	assert.Equal(t, []RelatedItem{{Relation: RelationChildOf, Name: "feature-checkout"}}, child.RelatedItems)
+
+	parent, err := manager.GetWorkItem(ctx, "feature-checkout")
+	require.NoError(t, err)
+	assert.Equal(t, []RelatedItem{{Relation: RelationParentOf, Name: "bug-cart-total"}}, parent.RelatedItems)
+}
+
+func TestManagerCustomWorkItemFile(t *testing.T) {
+	config := DefaultConfig()
+	config.WorkItemFile = "index.md"
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "custom-file"})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(config.BacklogDir, "feature-custom-file", "index.md"), item.Path)
+	assert.False(t, fs.FileExists(filepath.Join(config.BacklogDir, "feature-custom-file", "README.md")))
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-custom-file", StatusInProgressDiscovery))
+	got, err := manager.GetWorkItem(ctx, "feature-custom-file")
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressDiscovery, got.Status)
+}
+
 func TestManagerListWorkItems(t *testing.T) {
 	config := DefaultConfig()
 	fs := NewMockFileSystem()
@@ -64,6 +335,188 @@ func TestManagerListWorkItems(t *testing.T) {
 	assert.Equal(t, "feature-test-feature", items[0].Name)
 }
 
+func TestManagerListWorkItemsByPhase(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"building", "reviewing", "new"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+	}
+	require.NoError(t, manager.SetPhase(ctx, "feature-building", PhaseExecution))
+	require.NoError(t, manager.SetPhase(ctx, "feature-reviewing", PhaseExecution))
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-reviewing", StatusInProgressReview))
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{Phase: PhaseExecution})
+	require.NoError(t, err)
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	assert.ElementsMatch(t, []string{"feature-building", "feature-reviewing"}, names)
+
+	_, err = manager.ListWorkItems(ctx, ListFilter{Phase: "shipping"})
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestManagerListWorkItemsByName(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, req := range []CreateRequest{
+		{Type: TypeFeature, Name: "mobile-login"},
+		{Type: TypeBug, Name: "mobile-crash"},
+		{Type: TypeFeature, Name: "web-login"},
+	} {
+		_, err := manager.CreateWorkItem(ctx, req)
+		require.NoError(t, err)
+	}
+
+	names := func(filter ListFilter) []string {
+		items, err := manager.ListWorkItems(ctx, filter)
+		require.NoError(t, err)
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	// Names match with or without the type prefix
+	assert.ElementsMatch(t, []string{"feature-mobile-login", "bug-mobile-crash"}, names(ListFilter{NamePrefix: "mobile-"}))
+	assert.ElementsMatch(t, []string{"feature-mobile-login", "feature-web-login"}, names(ListFilter{NamePrefix: "feature-"}))
+	assert.ElementsMatch(t, []string{"feature-mobile-login", "feature-web-login"}, names(ListFilter{NameGlob: "*-login"}))
+
+	// Name filters combine with the others
+	assert.Equal(t, []string{"bug-mobile-crash"}, names(ListFilter{NameGlob: "mobile-*", Type: TypeBug}))
+	assert.Empty(t, names(ListFilter{NamePrefix: "web-", NameGlob: "mobile-*"}))
+
+	_, err := manager.ListWorkItems(ctx, ListFilter{NameGlob: "[mobile"})
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestManagerSetPriority(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, req := range []CreateRequest{
+		{Type: TypeBug, Name: "login-crash"},
+		{Type: TypeFeature, Name: "dark-mode"},
+	} {
+		_, err := manager.CreateWorkItem(ctx, req)
+		require.NoError(t, err)
+	}
+
+	item, err := manager.GetWorkItem(ctx, "bug-login-crash")
+	require.NoError(t, err)
+	assert.Equal(t, PriorityMedium, item.Priority, "templates default to MEDIUM")
+
+	require.NoError(t, manager.SetPriority(ctx, "bug-login-crash", PriorityCritical))
+	item, err = manager.GetWorkItem(ctx, "bug-login-crash")
+	require.NoError(t, err)
+	assert.Equal(t, PriorityCritical, item.Priority)
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{Priority: PriorityCritical})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "bug-login-crash", items[0].Name)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.SetPriority(ctx, "feature-dark-mode", "URGENT"), &validationErr)
+	assert.ErrorIs(t, manager.SetPriority(ctx, "feature-missing", PriorityLow), ErrWorkItemNotFound)
+}
+
+func TestManagerListWorkItemsScope(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"active", "shipped"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+	}
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-shipped", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-shipped"))
+
+	names := func(scope ListScope) []string {
+		items, err := manager.ListWorkItems(ctx, ListFilter{Scope: scope})
+		require.NoError(t, err)
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"feature-active"}, names(""), "the backlog is the default scope")
+	assert.Equal(t, []string{"feature-active"}, names(ScopeBacklog))
+	assert.Equal(t, []string{"feature-shipped"}, names(ScopeCompleted))
+	assert.ElementsMatch(t, []string{"feature-active", "feature-shipped"}, names(ScopeAll))
+
+	stats, err := manager.GetBacklogStats(ctx, ScopeAll)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Total)
+
+	_, err = manager.ListWorkItems(ctx, ListFilter{Scope: "everywhere"})
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestManagerStreamWorkItems(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"one", "two", "three"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+	}
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "four"})
+	require.NoError(t, err)
+
+	var names []string
+	err = manager.StreamWorkItems(ctx, ListFilter{Type: TypeFeature}, func(item WorkItem) error {
+		names = append(names, item.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"feature-one", "feature-two", "feature-three"}, names)
+
+	// Returning an error stops the iteration
+	errStop := errors.New("stop")
+	calls := 0
+	err = manager.StreamWorkItems(ctx, ListFilter{}, func(item WorkItem) error {
+		calls++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, calls)
+
+	// A cancelled context stops before parsing anything
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = manager.StreamWorkItems(cancelled, ListFilter{}, func(item WorkItem) error {
+		t.Fatal("no items expected after cancellation")
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestManagerGetWorkItem(t *testing.T) {
 	config := DefaultConfig()
 	fs := NewMockFileSystem()
@@ -86,6 +539,117 @@ func TestManagerGetWorkItem(t *testing.T) {
 	assert.Equal(t, TypeFeature, item.Type)
 }
 
+func TestManagerGetWorkItemByPath(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	// A work item outside the backlog, e.g. already archived
+	dir := filepath.Join(config.CompletedDir, "bug-old-crash")
+	content := "# Bug: old-crash\n\n## Status: COMPLETED\n## Phase: cleanup\n"
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(content)))
+
+	item, err := manager.GetWorkItemByPath(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, "bug-old-crash", item.Name)
+	assert.Equal(t, TypeBug, item.Type)
+	assert.Equal(t, StatusCompleted, item.Status)
+
+	// The README path itself is accepted too
+	item, err = manager.GetWorkItemByPath(context.Background(), filepath.Join(dir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "bug-old-crash", item.Name)
+
+	_, err = manager.GetWorkItemByPath(context.Background(), filepath.Join(config.CompletedDir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestManagerMissingReadmeErrors(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "lost-readme"})
+	require.NoError(t, err)
+	delete(fs.files, item.Path)
+
+	calls := map[string]func(name string) error{
+		"UpdateStatus": func(name string) error { return manager.UpdateStatus(ctx, name, StatusInProgressPlanning) },
+		"AppendNote":   func(name string) error { return manager.AppendNote(ctx, name, "note") },
+		"GetNotes": func(name string) error {
+			_, err := manager.GetNotes(ctx, name)
+			return err
+		},
+		"SetPhase": func(name string) error { return manager.SetPhase(ctx, name, PhasePlanning) },
+		"GetPhaseTasks": func(name string) error {
+			_, err := manager.GetPhaseTasks(ctx, name)
+			return err
+		},
+		"GetProgressMetrics": func(name string) error {
+			_, err := manager.GetProgressMetrics(ctx, name)
+			return err
+		},
+		"CompleteTask":              func(name string) error { return manager.CompleteTask(ctx, name, 0) },
+		"CompleteTaskByDescription": func(name string) error { return manager.CompleteTaskByDescription(ctx, name, "task") },
+		"UpdateProgress":            func(name string) error { return manager.UpdateProgress(ctx, name, 50) },
+		"AssignWorkItem":            func(name string) error { return manager.AssignWorkItem(ctx, name, "alice") },
+		"AdvancePhase":              func(name string) error { return manager.AdvancePhase(ctx, name) },
+	}
+	for op, call := range calls {
+		t.Run(op, func(t *testing.T) {
+			err := call("bug-lost-readme")
+			assert.ErrorIs(t, err, ErrReadmeMissing)
+			assert.Equal(t, http.StatusNotFound, apiStatusCode(err))
+
+			err = call("bug-never-existed")
+			assert.ErrorIs(t, err, ErrWorkItemNotFound)
+			assert.Equal(t, http.StatusNotFound, apiStatusCode(err))
+		})
+	}
+}
+
+func TestManagerRepairWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "lost-readme"})
+	require.NoError(t, err)
+
+	// Delete the README but leave the directory behind
+	delete(fs.files, item.Path)
+
+	_, err = manager.GetWorkItem(ctx, "bug-lost-readme")
+	assert.ErrorIs(t, err, ErrReadmeMissing)
+	_, err = manager.GetWorkItem(ctx, "bug-never-existed")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+
+	missing, err := manager.FindMissingReadmes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bug-lost-readme"}, missing)
+
+	repaired, err := manager.RepairWorkItem(ctx, "bug-lost-readme")
+	require.NoError(t, err)
+	assert.Equal(t, TypeBug, repaired.Type)
+	assert.Equal(t, StatusProposed, repaired.Status)
+	assert.Equal(t, "lost-readme", repaired.Title)
+
+	_, err = manager.GetWorkItem(ctx, "bug-lost-readme")
+	require.NoError(t, err)
+
+	// Nothing left to repair
+	_, err = manager.RepairWorkItem(ctx, "bug-lost-readme")
+	assert.Error(t, err)
+	_, err = manager.RepairWorkItem(ctx, "bug-never-existed")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
 func TestManagerUpdateStatus(t *testing.T) {
 	config := DefaultConfig()
 	fs := NewMockFileSystem()
@@ -233,6 +797,207 @@ func TestManagerCompleteTask(t *testing.T) {
 	assert.Equal(t, PhasePlanning, item.Phase)
 }
 
+func TestManagerMarkTaskNotApplicable(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	dir := filepath.Join(config.BacklogDir, "feature-na")
+	require.NoError(t, fs.CreateDirectory(dir))
+	readme := `# Feature: na
+
+## Status: IN_PROGRESS_EXECUTION
+## Phase: execution
+## Progress: 0%
+
+## Execution Phase
+
+### Tasks
+- [x] Write code
+- [ ] Write unit tests
+- [ ] Migrate database
+- [ ] Update docs
+`
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.MarkTaskNotApplicable(ctx, "feature-na", 0), &validationErr, "completed tasks can't be N/A")
+	assert.ErrorAs(t, manager.MarkTaskNotApplicable(ctx, "feature-na", 4), &validationErr)
+
+	require.NoError(t, manager.MarkTaskNotApplicable(ctx, "feature-na", 2))
+	data, err := fs.ReadFile(filepath.Join(dir, "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "- [~] Migrate database\n")
+
+	item, err := manager.GetWorkItem(ctx, "feature-na")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 4)
+	assert.True(t, item.Tasks[2].NotApplicable)
+	assert.False(t, item.Tasks[2].Completed)
+	assert.Equal(t, 33, item.Progress, "N/A tasks are left out of progress")
+
+	// N/A tasks don't block advancing, and "- [~]" isn't completed by CompleteTask
+	require.NoError(t, manager.CompleteTask(ctx, "feature-na", 2))
+	require.NoError(t, manager.CompleteTask(ctx, "feature-na", 1))
+	require.NoError(t, manager.CompleteTask(ctx, "feature-na", 3))
+	item, err = manager.GetWorkItem(ctx, "feature-na")
+	require.NoError(t, err)
+	assert.True(t, item.Tasks[2].NotApplicable)
+	assert.Equal(t, 100, item.Progress)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-na"))
+}
+
+func TestManagerUncompleteTask(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	dir := filepath.Join(config.BacklogDir, "feature-undo")
+	require.NoError(t, fs.CreateDirectory(dir))
+	readme := `# Feature: undo
+
+## Status: IN_PROGRESS_EXECUTION
+## Phase: execution
+## Progress: 25%
+
+## Execution Phase
+
+### Tasks
+- [x] Write code
+- [ ] Write unit tests
+- [ ] Migrate database
+- [ ] Update docs
+`
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+
+	require.NoError(t, manager.CompleteTask(ctx, "feature-undo", 2))
+	item, err := manager.GetWorkItem(ctx, "feature-undo")
+	require.NoError(t, err)
+	assert.True(t, item.Tasks[2].Completed)
+	assert.Equal(t, 50, item.Progress)
+
+	require.NoError(t, manager.UncompleteTask(ctx, "feature-undo", 2))
+	data, err := fs.ReadFile(filepath.Join(dir, "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "- [ ] Migrate database\n", "the checkbox and done date are reverted")
+
+	item, err = manager.GetWorkItem(ctx, "feature-undo")
+	require.NoError(t, err)
+	assert.False(t, item.Tasks[2].Completed)
+	assert.Equal(t, 25, item.Progress)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.UncompleteTask(ctx, "feature-undo", 2), &validationErr, "open tasks can't be uncompleted")
+	assert.ErrorAs(t, manager.UncompleteTask(ctx, "feature-undo", 4), &validationErr)
+}
+
+func TestManagerProgressMismatch(t *testing.T) {
+	config := DefaultConfig()
+	config.ProgressMismatchThreshold = 20
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	dir := filepath.Join(config.BacklogDir, "feature-drift")
+	require.NoError(t, fs.CreateDirectory(dir))
+	readme := `# Feature: drift
+
+## Status: IN_PROGRESS_EXECUTION
+## Phase: execution
+## Progress: 90%
+
+## Execution Phase
+
+### Tasks
+- [x] Write code
+- [ ] Write unit tests
+- [ ] Update docs
+- [ ] Release
+`
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+
+	item, err := manager.GetWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.True(t, item.ProgressMismatch, "90% stored vs 25% from tasks")
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.True(t, items[0].ProgressMismatch)
+
+	disabledConfig := config
+	disabledConfig.ProgressMismatchThreshold = 0
+	disabled := NewDefaultManagerWithDeps(disabledConfig, fs, NewNoOpGitClient())
+	item, err = disabled.GetWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.False(t, item.ProgressMismatch, "a threshold of 0 disables the check")
+
+	progress, err := manager.RecalculateProgress(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.Equal(t, 25, progress)
+	item, err = manager.GetWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.Equal(t, 25, item.Progress)
+	assert.False(t, item.ProgressMismatch)
+
+	_, err = manager.RecalculateProgress(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestManagerCompleteTaskByDescription(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	dir := filepath.Join(config.BacklogDir, "feature-match")
+	require.NoError(t, fs.CreateDirectory(dir))
+	readme := `# Feature: match
+
+## Status: IN_PROGRESS_EXECUTION
+## Phase: execution
+## Progress: 0%
+
+## Execution Phase
+
+### Tasks
+- [x] Write code
+- [ ] Write unit tests
+- [ ] Write integration tests
+- [ ] Update docs
+`
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+
+	// Ambiguous and unmatched queries are rejected without changes
+	var validationErr *ValidationError
+	err := manager.CompleteTaskByDescription(ctx, "feature-match", "write")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Message, "matches 2 tasks")
+	err = manager.CompleteTaskByDescription(ctx, "feature-match", "deploy")
+	require.ErrorAs(t, err, &validationErr)
+
+	// Completed tasks are not candidates, so "write code" matches nothing
+	err = manager.CompleteTaskByDescription(ctx, "feature-match", "write code")
+	assert.Error(t, err)
+
+	require.NoError(t, manager.CompleteTaskByDescription(ctx, "feature-match", "UNIT TESTS"))
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-match")
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	assert.True(t, tasks[1].Completed)
+	assert.False(t, tasks[2].Completed)
+
+	// With unit tests done, "write" is now unambiguous
+	require.NoError(t, manager.CompleteTaskByDescription(ctx, "feature-match", "write"))
+	item, err := manager.GetWorkItem(ctx, "feature-match")
+	require.NoError(t, err)
+	assert.Equal(t, 75, item.Progress)
+}
+
 func TestManagerGetPhaseTasks(t *testing.T) {
 	config := DefaultConfig()
 	fs := NewMockFileSystem()
@@ -308,10 +1073,349 @@ func TestManagerArchiveWorkItem(t *testing.T) {
 	assert.True(t, fs.DirectoryExists(completedPath))
 }
 
-func TestManagerAdvancePhaseThroughWorkflow(t *testing.T) {
+func TestManagerArchiveWorkItemInterrupted(t *testing.T) {
 	config := DefaultConfig()
 	fs := NewMockFileSystem()
-	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "half"})
+	require.NoError(t, err)
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "twice"})
+	require.NoError(t, err)
+
+	// Simulate an archive that died after the move, before the postmortem
+	require.NoError(t, fs.CreateDirectory(config.CompletedDir))
+	completedPath := filepath.Join(config.CompletedDir, "feature-half")
+	require.NoError(t, fs.MoveDirectory(filepath.Join(config.BacklogDir, "feature-half"), completedPath))
+	// ...and one whose copy was left behind in the backlog
+	twicePath := filepath.Join(config.CompletedDir, "feature-twice")
+	require.NoError(t, fs.CreateDirectory(twicePath))
+	require.NoError(t, fs.WriteFile(filepath.Join(twicePath, "README.md"), []byte("# Feature: twice\n")))
+	require.NoError(t, fs.WriteFile(filepath.Join(twicePath, "POSTMORTEM.md"), []byte("# Postmortem\n")))
+
+	orphaned, err := manager.FindOrphanedWorkItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, orphaned, 2)
+	slices.SortFunc(orphaned, func(a, b ValidationError) int { return strings.Compare(a.Value, b.Value) })
+	assert.Equal(t, "feature-half", orphaned[0].Value)
+	assert.Contains(t, orphaned[0].Message, "go-pm archive feature-half")
+	assert.Equal(t, "feature-twice", orphaned[1].Value)
+	assert.Contains(t, orphaned[1].Message, "both the backlog and the completed directory")
+
+	// Archiving again finishes the job instead of failing
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-half"))
+	assert.True(t, fs.FileExists(filepath.Join(completedPath, "POSTMORTEM.md")))
+
+	orphaned, err = manager.FindOrphanedWorkItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, orphaned, 1)
+	assert.Equal(t, "feature-twice", orphaned[0].Value)
+
+	// Fully archived items are still not found in the backlog
+	require.NoError(t, fs.RemoveDirectory(filepath.Join(config.BacklogDir, "feature-twice")))
+	assert.Error(t, manager.ArchiveWorkItem(ctx, "feature-twice"))
+	assert.Error(t, manager.ArchiveWorkItem(ctx, "feature-missing"))
+}
+
+func TestManagerUnarchiveWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"early", "reopened"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+		require.NoError(t, manager.UpdateStatus(ctx, "feature-"+name, StatusCompleted))
+		require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-"+name))
+	}
+
+	item, err := manager.UnarchiveWorkItem(ctx, "feature-early", false)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, item.Status)
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.CompletedDir, "feature-early")))
+	assert.True(t, fs.FileExists(filepath.Join(config.BacklogDir, "feature-early", "POSTMORTEM.md")), "the postmortem is kept")
+
+	item, err = manager.UnarchiveWorkItem(ctx, "feature-reopened", true)
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressReview, item.Status)
+	assert.Equal(t, PhaseCleanup, item.Phase)
+	reopened, err := manager.GetWorkItem(ctx, "feature-reopened")
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressReview, reopened.Status)
+
+	var workItemErr *WorkItemError
+	_, err = manager.UnarchiveWorkItem(ctx, "feature-missing", false)
+	require.ErrorAs(t, err, &workItemErr)
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+
+	// An item that exists in the backlog again isn't overwritten
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-early"))
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "early"})
+	require.NoError(t, err)
+	_, err = manager.UnarchiveWorkItem(ctx, "feature-early", false)
+	require.ErrorAs(t, err, &workItemErr)
+	assert.Contains(t, err.Error(), "already exists in backlog")
+}
+
+func TestManagerListArchivedWorkItems(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, name := range []string{"shipped", "done"} {
+		_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: name})
+		require.NoError(t, err)
+		require.NoError(t, manager.UpdateStatus(ctx, "feature-"+name, StatusCompleted))
+	}
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-shipped"))
+
+	items, err := manager.ListWorkItems(ctx, ListFilter{Status: StatusCompleted})
+	require.NoError(t, err)
+	require.Len(t, items, 1, "the backlog scope skips archived items")
+	assert.Equal(t, "feature-done", items[0].Name)
+	assert.False(t, items[0].Archived)
+
+	// As `list completed` does
+	items, err = manager.ListWorkItems(ctx, ListFilter{Status: StatusCompleted, Scope: ScopeAll})
+	require.NoError(t, err)
+	archived := make(map[string]bool)
+	for _, item := range items {
+		archived[item.Name] = item.Archived
+	}
+	assert.Equal(t, map[string]bool{"feature-done": false, "feature-shipped": true}, archived)
+}
+
+func TestManagerArchiveCompletedWorkItems(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	// Create the backlog directory
+	err := fs.CreateDirectory(config.BacklogDir)
+	require.NoError(t, err)
+
+	// Create one completed and one in-progress work item
+	_, err = manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeFeature, Name: "done"})
+	require.NoError(t, err)
+	_, err = manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeBug, Name: "wip"})
+	require.NoError(t, err)
+	err = manager.UpdateStatus(context.Background(), "feature-done", StatusCompleted)
+	require.NoError(t, err)
+	err = manager.UpdateStatus(context.Background(), "bug-wip", StatusInProgressExecution)
+	require.NoError(t, err)
+
+	archived, err := manager.ArchiveCompletedWorkItems(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-done"}, archived)
+
+	// Only the completed item was moved
+	assert.True(t, fs.DirectoryExists(filepath.Join(config.CompletedDir, "feature-done")))
+	assert.True(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "bug-wip")))
+}
+
+func TestManagerAdvancePhaseBranchPerPhase(t *testing.T) {
+	for _, branchPerPhase := range []bool{false, true} {
+		config := DefaultConfig()
+		config.EnableGit = true
+		config.BranchPerPhase = branchPerPhase
+		fs := NewMockFileSystem()
+		git := NewMockGitClient()
+		manager := NewDefaultManagerWithDeps(config, fs, git)
+
+		// Create a work item and advance twice (PROPOSED -> discovery -> planning)
+		_, err := manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeFeature, Name: "branches"})
+		require.NoError(t, err)
+		err = manager.AdvancePhase(context.Background(), "feature-branches")
+		require.NoError(t, err)
+		tasks, err := manager.GetPhaseTasks(context.Background(), "feature-branches")
+		require.NoError(t, err)
+		for i := range tasks {
+			require.NoError(t, manager.CompleteTask(context.Background(), "feature-branches", i))
+		}
+		err = manager.AdvancePhase(context.Background(), "feature-branches")
+		require.NoError(t, err)
+
+		// The work item branch is always created; phase branches only when opted in
+		require.NotEmpty(t, git.branches)
+		assert.Equal(t, "feature/branches", git.branches[0])
+		if branchPerPhase {
+			assert.Len(t, git.branches, 3)
+		} else {
+			assert.Len(t, git.branches, 1)
+		}
+	}
+}
+
+func TestManagerAutoCommit(t *testing.T) {
+	config := DefaultConfig()
+	config.EnableGit = true
+	config.AutoCommit = true
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "auth"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-auth"))
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-auth", StatusInProgressPlanning))
+	assert.Equal(t, []string{
+		"pm: create feature-auth",
+		"pm: advance feature-auth to discovery",
+		"pm: set feature-auth status to IN_PROGRESS_PLANNING",
+	}, git.commits)
+
+	// Creating commits the item where it ended up, after its history was written
+	config.StatusDirectories = true
+	manager = NewDefaultManagerWithDeps(config, fs, git)
+	created, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "moved"})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(config.BacklogDir, "proposed", "bug-moved"), filepath.Dir(created.Path))
+	assert.Equal(t, []string{filepath.Dir(created.Path)}, git.commitPaths[len(git.commitPaths)-1])
+	assert.True(t, fs.FileExists(filepath.Join(filepath.Dir(created.Path), historyFileName)))
+
+	// A failed commit doesn't fail the operation
+	git.commitErr = fmt.Errorf("nothing to commit")
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-auth", StatusInProgressDiscovery))
+
+	// Without auto_commit nothing is committed
+	config.AutoCommit = false
+	git = NewMockGitClient()
+	manager = NewDefaultManagerWithDeps(config, NewMockFileSystem(), git)
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "auth"})
+	require.NoError(t, err)
+	assert.Empty(t, git.commits)
+}
+
+func TestManagerCreateWorkItemBranchPrefix(t *testing.T) {
+	config := DefaultConfig()
+	config.EnableGit = true
+	config.BranchPerPhase = true
+	config.BranchPrefix = "wi"
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	_, err := manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeFeature, Name: "prefixed"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(context.Background(), "feature-prefixed"))
+
+	// The reported branch is the one that was created
+	assert.Equal(t, "wi/feature/prefixed", manager.BranchName(TypeFeature, "prefixed"))
+	require.Len(t, git.branches, 2)
+	assert.Equal(t, "wi/feature/prefixed", git.branches[0])
+	assert.True(t, strings.HasPrefix(git.branches[1], "wi/feature/"))
+}
+
+func TestManagerAdvancePhaseRequirements(t *testing.T) {
+	config := DefaultConfig()
+	config.PhaseRequirements = map[WorkPhase]PhaseRequirement{
+		PhaseDiscovery: {Overview: true, Assignee: true, MinTasks: 100},
+	}
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "gated"})
+	require.NoError(t, err)
+
+	// Leave the item unassigned
+	content, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	require.NoError(t, fs.WriteFile(item.Path, []byte(strings.Replace(string(content), "## Assigned To: agent\n", "", 1))))
+
+	// Starting work on a PROPOSED item isn't gated
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-gated"))
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-gated")
+	require.NoError(t, err)
+	for i := range tasks {
+		require.NoError(t, manager.CompleteTask(ctx, "feature-gated", i))
+	}
+
+	// Every unmet requirement is reported, and the template overview counts as empty
+	err = manager.AdvancePhase(ctx, "feature-gated")
+	var phaseErr *PhaseError
+	require.ErrorAs(t, err, &phaseErr)
+	assert.Equal(t, PhaseDiscovery, phaseErr.CurrentPhase)
+	assert.Equal(t, PhasePlanning, phaseErr.TargetPhase)
+	assert.Contains(t, phaseErr.Reason, "overview")
+	assert.Contains(t, phaseErr.Reason, "assignee")
+	assert.Contains(t, phaseErr.Reason, "at least 100 required")
+
+	// Filling in the overview and assigning the item satisfies the gate
+	content, err = fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	content = []byte(strings.Replace(string(content), "Brief description of the feature and its purpose.", "Gate phase advances on configured conditions.", 1))
+	require.NoError(t, fs.WriteFile(item.Path, content))
+	require.NoError(t, manager.AssignWorkItem(ctx, "feature-gated", "alice"))
+
+	config.PhaseRequirements[PhaseDiscovery] = PhaseRequirement{Overview: true, Assignee: true, MinTasks: 1}
+	manager = NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-gated"))
+}
+
+func TestManagerPreviewAdvance(t *testing.T) {
+	config := DefaultConfig()
+	config.PhaseAdvanceStrict = true
+	config.PhaseRequirements = map[WorkPhase]PhaseRequirement{PhaseDiscovery: {MinTasks: 100}}
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "preview"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-preview"))
+	before, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+
+	preview, err := manager.PreviewAdvance(ctx, "feature-preview")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseDiscovery, preview.CurrentPhase)
+	assert.Equal(t, StatusInProgressDiscovery, preview.CurrentStatus)
+	assert.Equal(t, PhasePlanning, preview.NextPhase)
+	assert.Equal(t, StatusInProgressPlanning, preview.NextStatus)
+	assert.NotEmpty(t, preview.IncompleteTasks)
+	require.Len(t, preview.UnmetRequirements, 1)
+	assert.Contains(t, preview.UnmetRequirements[0], "at least 100 required")
+	assert.Len(t, preview.Blockers, len(preview.IncompleteTasks)+1)
+	assert.False(t, preview.CanAdvance())
+
+	// The preview predicts AdvancePhase and writes nothing
+	assert.Error(t, manager.AdvancePhase(ctx, "feature-preview"))
+	after, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	assert.Equal(t, string(before), string(after))
+
+	// Without strict validation or requirements, incomplete tasks are only warnings
+	config.PhaseAdvanceStrict = false
+	config.PhaseRequirements = nil
+	manager = NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	preview, err = manager.PreviewAdvance(ctx, "feature-preview")
+	require.NoError(t, err)
+	assert.NotEmpty(t, preview.IncompleteTasks)
+	assert.True(t, preview.CanAdvance())
+
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-preview", StatusCompleted))
+	preview, err = manager.PreviewAdvance(ctx, "feature-preview")
+	require.NoError(t, err)
+	assert.Empty(t, preview.NextStatus)
+	assert.Contains(t, preview.Blockers, "work item feature-preview is already COMPLETED")
+
+	_, err = manager.PreviewAdvance(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestManagerAdvancePhaseThroughWorkflow(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
 	manager := NewDefaultManagerWithDeps(config, fs, git)
 
 	// Create the backlog directory
@@ -361,3 +1465,397 @@ func TestManagerAdvancePhaseThroughWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestManagerNotes(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	// Create the backlog directory
+	err := fs.CreateDirectory(config.BacklogDir)
+	require.NoError(t, err)
+
+	// Create a work item first
+	req := CreateRequest{Type: TypeFeature, Name: "test-feature"}
+	_, err = manager.CreateWorkItem(context.Background(), req)
+	require.NoError(t, err)
+
+	// No notes yet
+	notes, err := manager.GetNotes(context.Background(), "feature-test-feature")
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+
+	// Append notes, including text that looks like README metadata
+	err = manager.AppendNote(context.Background(), "feature-test-feature", "first note")
+	require.NoError(t, err)
+	err = manager.AppendNote(context.Background(), "feature-test-feature", "## Status: COMPLETED")
+	require.NoError(t, err)
+
+	notes, err = manager.GetNotes(context.Background(), "feature-test-feature")
+	require.NoError(t, err)
+	assert.Contains(t, notes, "# Notes: feature-test-feature")
+	assert.Contains(t, notes, "first note")
+	assert.Contains(t, notes, "## Status: COMPLETED")
+
+	// Notes never affect the work item metadata
+	item, err := manager.GetWorkItem(context.Background(), "feature-test-feature")
+	require.NoError(t, err)
+	assert.Equal(t, StatusProposed, item.Status)
+
+	// Empty notes are rejected
+	err = manager.AppendNote(context.Background(), "feature-test-feature", "  ")
+	assert.Error(t, err)
+}
+
+func TestManagerSyncPhaseTasks(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "sync"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "bug-sync", StatusInProgressDiscovery))
+
+	tasks, err := manager.GetPhaseTasks(ctx, "bug-sync")
+	require.NoError(t, err)
+	require.NotEmpty(t, tasks)
+	require.NoError(t, manager.CompleteTask(ctx, "bug-sync", 0))
+
+	// Simulate an item created before the last template task existed
+	readme := filepath.Join(config.BacklogDir, "bug-sync", "README.md")
+	content, err := fs.ReadFile(readme)
+	require.NoError(t, err)
+	missing := tasks[len(tasks)-1].Description
+	require.NoError(t, fs.WriteFile(readme, []byte(strings.Replace(string(content), "- [ ] "+missing+"\n", "", 1))))
+
+	require.NoError(t, manager.SyncPhaseTasks(ctx, "bug-sync"))
+	synced, err := manager.GetPhaseTasks(ctx, "bug-sync")
+	require.NoError(t, err)
+	require.Len(t, synced, len(tasks))
+	assert.Equal(t, missing, synced[len(synced)-1].Description)
+	assert.False(t, synced[len(synced)-1].Completed)
+	assert.True(t, synced[0].Completed, "existing task state is preserved")
+
+	// Syncing again is a no-op
+	require.NoError(t, manager.SyncPhaseTasks(ctx, "bug-sync"))
+	again, err := manager.GetPhaseTasks(ctx, "bug-sync")
+	require.NoError(t, err)
+	assert.Len(t, again, len(tasks))
+
+	err = manager.SyncPhaseTasks(ctx, "bug-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestManagerAdvancePhaseAssignsReviewer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		reviewer string
+		assignee string
+		expected string
+	}{
+		{"template assignee", "alice", "", "alice"},
+		{"assigned to author", "alice", "test-user", "alice"},
+		{"assigned to someone else", "alice", "carol", "carol"},
+		{"no reviewer configured", "", "", "agent"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Reviewer = tc.reviewer
+			fs := NewMockFileSystem()
+			manager := NewDefaultManagerWithDeps(config, fs, NewMockGitClient())
+			ctx := context.Background()
+
+			require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+			_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "review", Assignee: tc.assignee})
+			require.NoError(t, err)
+			require.NoError(t, manager.UpdateStatus(ctx, "feature-review", StatusInProgressCleanup))
+
+			tasks, err := manager.GetPhaseTasks(ctx, "feature-review")
+			require.NoError(t, err)
+			for i := range tasks {
+				require.NoError(t, manager.CompleteTask(ctx, "feature-review", i))
+			}
+			require.NoError(t, manager.AdvancePhase(ctx, "feature-review"))
+
+			item, err := manager.GetWorkItem(ctx, "feature-review")
+			require.NoError(t, err)
+			assert.Equal(t, StatusInProgressReview, item.Status)
+			assert.Equal(t, tc.expected, item.AssignedTo)
+		})
+	}
+}
+
+func TestManagerCreateWorkItemDefaultAssigneeByType(t *testing.T) {
+	config := DefaultConfig()
+	config.DefaultAssigneeByType = map[string]string{"bug": "oncall"}
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+
+	bug, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "crash"})
+	require.NoError(t, err)
+	assert.Equal(t, "oncall", bug.AssignedTo)
+
+	explicit, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "leak", Assignee: "dave"})
+	require.NoError(t, err)
+	assert.Equal(t, "dave", explicit.AssignedTo, "an explicit assignee wins")
+
+	feature, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "search"})
+	require.NoError(t, err)
+	assert.Equal(t, "agent", feature.AssignedTo, "types without a default keep the template assignee")
+}
+
+func TestManagerAdvancePhaseNonStrict(t *testing.T) {
+	config := DefaultConfig()
+	config.PhaseAdvanceStrict = false
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "lenient"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-lenient", StatusInProgressDiscovery))
+
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-lenient")
+	require.NoError(t, err)
+	require.NotEmpty(t, tasks)
+	require.NoError(t, manager.CompleteTask(ctx, "feature-lenient", 0))
+
+	incomplete, err := manager.AdvancePhaseWithWarnings(ctx, "feature-lenient")
+	require.NoError(t, err)
+	assert.Len(t, incomplete, len(tasks)-1)
+	for _, task := range incomplete {
+		assert.False(t, task.Completed)
+		assert.Equal(t, PhaseDiscovery, task.Phase)
+	}
+
+	item, err := manager.GetWorkItem(ctx, "feature-lenient")
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressPlanning, item.Status)
+
+	// Strict mode (the default) still blocks
+	strict := NewDefaultManagerWithDeps(DefaultConfig(), fs, NewNoOpGitClient())
+	_, err = strict.AdvancePhaseWithWarnings(ctx, "feature-lenient")
+	var phaseErr *PhaseError
+	assert.ErrorAs(t, err, &phaseErr)
+}
+
+func TestManagerAdvancePhaseAlreadyCompleted(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "done"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-done", StatusCompleted))
+
+	err = manager.AdvancePhase(ctx, "feature-done")
+	var completedErr *AlreadyCompletedError
+	require.ErrorAs(t, err, &completedErr)
+	assert.Equal(t, "feature-done", completedErr.WorkItem)
+	assert.EqualError(t, err, "work item feature-done is already COMPLETED")
+
+	// An unknown status is still reported as a phase error naming the item
+	readme := filepath.Join(config.BacklogDir, "feature-done", "README.md")
+	content, err := fs.ReadFile(readme)
+	require.NoError(t, err)
+	require.NoError(t, fs.WriteFile(readme, []byte(strings.Replace(string(content), "## Status: COMPLETED", "## Status: ON_HOLD", 1))))
+
+	err = manager.AdvancePhase(ctx, "feature-done")
+	var phaseErr *PhaseError
+	require.ErrorAs(t, err, &phaseErr)
+	assert.Equal(t, "feature-done", phaseErr.WorkItem)
+	assert.False(t, errors.As(err, &completedErr))
+}
+
+func TestManagerSplitWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "checkout", Priority: PriorityHigh})
+	require.NoError(t, err)
+
+	children, err := manager.SplitWorkItem(ctx, "feature-checkout", []string{"checkout-api", "checkout-ui"})
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	assert.Equal(t, "feature-checkout-api", children[0].Name)
+	assert.Equal(t, PriorityHigh, children[0].Priority)
+	assert.Equal(t, []RelatedItem{{Relation: RelationChildOf, Name: "feature-checkout"}}, children[1].RelatedItems)
+
+	parent, err := manager.GetWorkItem(ctx, "feature-checkout")
+	require.NoError(t, err)
+	assert.Contains(t, parent.Labels, TrackingLabel)
+	assert.Equal(t, []RelatedItem{
+		{Relation: RelationParentOf, Name: "feature-checkout-api"},
+		{Relation: RelationParentOf, Name: "feature-checkout-ui"},
+	}, parent.RelatedItems)
+	assert.NotEmpty(t, parent.Tasks, "related items are not parsed as tasks")
+
+	// Nothing is created when any child already exists
+	_, err = manager.SplitWorkItem(ctx, "feature-checkout", []string{"checkout-db", "checkout-api"})
+	assert.Error(t, err)
+	assert.False(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-checkout-db")))
+
+	_, err = manager.SplitWorkItem(ctx, "feature-missing", []string{"x"})
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestManagerHonorsCancelledContext(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(context.Background(), CreateRequest{Type: TypeFeature, Name: "slow"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(context.Background(), "feature-slow", StatusCompleted))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = manager.ListWorkItems(ctx, ListFilter{})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = manager.FindMissingReadmes(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = manager.GetBacklogStats(ctx, ScopeBacklog)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	archived, err := manager.ArchiveCompletedWorkItems(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, archived)
+	assert.True(t, fs.DirectoryExists(filepath.Join(config.BacklogDir, "feature-slow")))
+}
+
+func TestCLIHelperWriteTable(t *testing.T) {
+	config := DefaultConfig()
+	helper := NewCLIHelper(NewDefaultManagerWithDeps(config, NewMockFileSystem(), NewNoOpGitClient()), config)
+
+	items := []WorkItem{
+		{Name: "feature-user-authentication", Type: TypeFeature, Status: StatusInProgressExecution, Phase: PhaseExecution, Progress: 40, AssignedTo: "agent"},
+		{Name: "bug-crash", Type: TypeBug, Status: StatusProposed, Phase: PhaseDiscovery},
+	}
+
+	var b strings.Builder
+	require.NoError(t, helper.WriteTable(&b, items))
+
+	lines := strings.Split(strings.TrimRight(b.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "TYPE", "STATUS", "PHASE", "PROGRESS", "ASSIGNEE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"bug-crash", "bug", "PROPOSED", "discovery", "0%", "-"}, strings.Fields(lines[2]))
+
+	// Every column starts at the same offset on every line
+	for column, value := range map[string]string{"TYPE": "feature", "STATUS": "IN_PROGRESS_EXECUTION", "ASSIGNEE": "agent"} {
+		assert.Equal(t, strings.Index(lines[0], " "+column), strings.Index(lines[1], " "+value), column)
+	}
+}
+
+func TestListWorkItemsConcurrency(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	var want []string
+	for i := range 20 {
+		item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: fmt.Sprintf("item-%02d", i)})
+		require.NoError(t, err)
+		want = append(want, item.Name)
+	}
+	require.NoError(t, fs.CreateDirectory(filepath.Join(config.BacklogDir, "feature-no-readme")))
+
+	// Every pool size yields the same items
+	for _, concurrency := range []int{1, 3, 32} {
+		config.ListConcurrency = concurrency
+		manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+		items, err := manager.ListWorkItems(ctx, ListFilter{})
+		require.NoError(t, err)
+		names := make([]string, 0, len(items))
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		assert.ElementsMatch(t, want, names)
+
+		// Stopping early leaves no parse waiting on the caller
+		count := 0
+		stop := errors.New("stop")
+		err = manager.StreamWorkItems(ctx, ListFilter{}, func(WorkItem) error {
+			count++
+			if count == 2 {
+				return stop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+	}
+
+	config.ListConcurrency = 0
+	manager = NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	var validationErr *ValidationError
+	_, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "list_concurrency", validationErr.Field)
+}
+
+func TestManagerJSONEmptySlices(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	dir := filepath.Join(config.BacklogDir, "feature-empty")
+	require.NoError(t, fs.CreateDirectory(dir))
+	readme := "# Feature: empty\n\n## Status: PROPOSED\n## Phase: discovery\n"
+	require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(readme)))
+
+	item, err := manager.GetWorkItem(ctx, "feature-empty")
+	require.NoError(t, err)
+	data, err := json.Marshal(item)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"tasks":[]`)
+	assert.Contains(t, string(data), `"labels":[]`)
+	assert.Contains(t, string(data), `"depends_on":[]`)
+	assert.Contains(t, string(data), `"related_items":[]`)
+	assert.Contains(t, string(data), `"references":[]`)
+	assert.Contains(t, string(data), `"phase_history":[]`)
+
+	preview, err := manager.PreviewAdvance(ctx, "feature-empty")
+	require.NoError(t, err)
+	data, err = json.Marshal(preview)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"incomplete_tasks":[]`)
+	assert.Contains(t, string(data), `"unmet_requirements":[]`)
+	assert.Contains(t, string(data), `"blockers":[]`)
+
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-empty")
+	require.NoError(t, err)
+	data, err = json.Marshal(tasks)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+
+	metrics, err := manager.GetProgressMetrics(ctx, "feature-empty")
+	require.NoError(t, err)
+	data, err = json.Marshal(metrics)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"overall_progress":0`)
+	assert.Contains(t, string(data), `"phase_progress":[{"phase":"discovery"`)
+}
@@ -0,0 +1,74 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// metadataKeyRegex matches the keys SetMetadata accepts, e.g. "sprint" or "cost-center"
+var metadataKeyRegex = regexp.MustCompile(`^[A-Za-z][\w.-]*$`)
+
+// SetMetadata sets a custom key-value field, such as a sprint number or cost
+// center, in the work item's "## Metadata" section. An existing value for key
+// is replaced; other keys, including ones go-pm doesn't know about, are kept.
+//
+// Example:
+//
+//	err := service.SetMetadata(ctx, "feature-user-auth", "sprint", "42")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) SetMetadata(ctx context.Context, name, key, value string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if !metadataKeyRegex.MatchString(key) {
+		return &ValidationError{Field: "key", Value: key, Message: "must start with a letter and contain only letters, digits, '_', '-' and '.'"}
+	}
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "\r\n") {
+		return &ValidationError{Field: "value", Value: value, Message: "value cannot contain newlines"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "set_metadata", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	if err := s.updater.SetMetadata(readmePath, key, value); err != nil {
+		return &WorkItemError{Op: "set_metadata", Name: name, Err: fmt.Errorf("failed to set metadata: %w", err)}
+	}
+	return nil
+}
+
+// GetMetadata returns the work item's custom key-value fields from its
+// "## Metadata" section, or an empty map when it has none.
+//
+// Example:
+//
+//	metadata, err := service.GetMetadata(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(metadata["sprint"])
+func (s *WorkItemService) GetMetadata(ctx context.Context, name string) (map[string]string, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if item.Metadata == nil {
+		return map[string]string{}, nil
+	}
+	return item.Metadata, nil
+}
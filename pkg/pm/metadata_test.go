@@ -0,0 +1,54 @@
+package pm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMetadata(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+
+	metadata, err := manager.GetMetadata(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Empty(t, metadata)
+
+	require.NoError(t, manager.SetMetadata(ctx, "feature-login", "sprint", "41"))
+	require.NoError(t, manager.SetMetadata(ctx, "feature-login", "cost-center", "R&D"))
+
+	// A key added by hand, in the unbulleted form, survives updates
+	content, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	require.NoError(t, fs.WriteFile(item.Path, []byte(strings.Replace(string(content), "- sprint: 41", "- sprint: 41\nteam: identity", 1))))
+	require.NoError(t, manager.SetMetadata(ctx, "feature-login", "sprint", "42"))
+
+	metadata, err = manager.GetMetadata(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sprint": "42", "cost-center": "R&D", "team": "identity"}, metadata)
+
+	// Metadata lines are not parsed as tasks or other fields
+	updated, err := manager.GetWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Len(t, updated.Tasks, len(item.Tasks))
+	assert.Equal(t, item.Status, updated.Status)
+	content, err = fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(content), "## Metadata"))
+
+	var validationErr *ValidationError
+	err = manager.SetMetadata(ctx, "feature-login", "bad key", "x")
+	assert.ErrorAs(t, err, &validationErr)
+	err = manager.SetMetadata(ctx, "feature-login", "notes", "a\nb")
+	assert.ErrorAs(t, err, &validationErr)
+	err = manager.SetMetadata(ctx, "feature-missing", "sprint", "1")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
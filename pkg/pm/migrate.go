@@ -0,0 +1,132 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CurrentSchemaVersion is the README format written by the current templates.
+// READMEs without a "## Schema Version:" heading are version 1.
+const CurrentSchemaVersion = 2
+
+// schemaMigration upgrades README content from one schema version to the next.
+// item is the work item as parsed before migrating.
+type schemaMigration func(content string, item WorkItem) string
+
+// schemaMigrations lists the upgrades in order: schemaMigrations[i] migrates
+// version i+1 to version i+2.
+var schemaMigrations = []schemaMigration{
+	migrateSchemaV1ToV2,
+}
+
+// migrateSchemaV1ToV2 adds the core metadata headings that early READMEs may
+// lack. A missing status defaults to PROPOSED and a missing phase follows the status.
+func migrateSchemaV1ToV2(content string, item WorkItem) string {
+	status := item.Status
+	if !hasMetadataField(content, "Status") {
+		status = StatusProposed
+	}
+	defaults := []struct{ key, value string }{
+		{"Status", string(status)},
+		{"Phase", string(phaseForStatus(status))},
+		{"Progress", fmt.Sprintf("%d%%", item.Progress)},
+	}
+	for _, field := range defaults {
+		if !hasMetadataField(content, field.key) {
+			content = setMetadataField(content, field.key, field.value)
+		}
+	}
+	return content
+}
+
+// phaseForStatus returns the phase a work item in status is worked in
+func phaseForStatus(status ItemStatus) WorkPhase {
+	switch status {
+	case StatusInProgressPlanning:
+		return PhasePlanning
+	case StatusInProgressExecution:
+		return PhaseExecution
+	case StatusInProgressCleanup, StatusInProgressReview, StatusCompleted:
+		return PhaseCleanup
+	}
+	return PhaseDiscovery
+}
+
+// hasMetadataField reports whether content has a "## Key:" metadata line
+func hasMetadataField(content, key string) bool {
+	keyPattern := strings.Join(strings.Fields(regexp.QuoteMeta(key)), `\s+`)
+	return regexp.MustCompile(`(?im)^##\s*` + keyPattern + `:`).MatchString(content)
+}
+
+// migrateContent upgrades README content from item's version to CurrentSchemaVersion
+// and stamps the new version. It reports whether anything changed.
+func migrateContent(content string, item WorkItem) (string, bool) {
+	if item.SchemaVersion >= CurrentSchemaVersion {
+		return content, false
+	}
+	for v := max(item.SchemaVersion, 1); v < CurrentSchemaVersion; v++ {
+		content = schemaMigrations[v-1](content, item)
+	}
+	return setMetadataField(content, "Schema Version", fmt.Sprintf("%d", CurrentSchemaVersion)), true
+}
+
+// PreviewMigration returns the README content MigrateWorkItem would write,
+// without writing it. changed is false when the README is already current.
+func (s *WorkItemService) PreviewMigration(ctx context.Context, name string) (migrated string, changed bool, err error) {
+	if err := validateWorkItemName(name); err != nil {
+		return "", false, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return "", false, &WorkItemError{Op: "migrate", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return "", false, &WorkItemError{Op: "migrate", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	data, err := s.fs.ReadFile(readmePath)
+	if err != nil {
+		return "", false, &WorkItemError{Op: "migrate", Name: name, Err: fmt.Errorf("failed to read work item: %w", err)}
+	}
+
+	migrated, changed = migrateContent(string(data), item)
+	return migrated, changed, nil
+}
+
+// MigrateWorkItem upgrades a work item's README to CurrentSchemaVersion,
+// applying each format migration in turn and recording a "## Schema Version:"
+// heading. It returns false without writing when the README is already current.
+//
+// Example:
+//
+//	changed, err := service.MigrateWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if changed {
+//		fmt.Println("Upgraded feature-user-auth")
+//	}
+func (s *WorkItemService) MigrateWorkItem(ctx context.Context, name string) (bool, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return false, err
+	}
+
+	migrated, changed, err := s.PreviewMigration(ctx, name)
+	if err != nil || !changed {
+		return false, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if err := s.fs.WriteFile(readmePath, []byte(migrated)); err != nil {
+		return false, &WorkItemError{Op: "migrate", Name: name, Err: fmt.Errorf("failed to write work item: %w", err)}
+	}
+	return true, nil
+}
@@ -0,0 +1,62 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	dir := filepath.Join(config.BacklogDir, "feature-legacy")
+	readme := filepath.Join(dir, "README.md")
+	require.NoError(t, fs.CreateDirectory(dir))
+	legacy := "# Feature: legacy\n\n## Status: IN_PROGRESS_PLANNING\n## Assigned To: bob\n\n## Planning Phase\n- [ ] Design\n"
+	require.NoError(t, fs.WriteFile(readme, []byte(legacy)))
+
+	item, err := manager.GetWorkItem(ctx, "feature-legacy")
+	require.NoError(t, err)
+	assert.Equal(t, 1, item.SchemaVersion)
+
+	// Previewing leaves the file untouched
+	preview, changed, err := manager.PreviewMigration(ctx, "feature-legacy")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Contains(t, preview, "## Schema Version: 2")
+	content, err := fs.ReadFile(readme)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, string(content))
+
+	changed, err = manager.MigrateWorkItem(ctx, "feature-legacy")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	item, err = manager.GetWorkItem(ctx, "feature-legacy")
+	require.NoError(t, err)
+	assert.Equal(t, CurrentSchemaVersion, item.SchemaVersion)
+	assert.Equal(t, StatusInProgressPlanning, item.Status, "existing metadata is kept")
+	assert.Equal(t, PhasePlanning, item.Phase, "missing phase follows the status")
+	assert.Len(t, item.Tasks, 1)
+
+	// Migrating again, or migrating a new item, is a no-op
+	changed, err = manager.MigrateWorkItem(ctx, "feature-legacy")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "fresh"})
+	require.NoError(t, err)
+	changed, err = manager.MigrateWorkItem(ctx, "bug-fresh")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = manager.MigrateWorkItem(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
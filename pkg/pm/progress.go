@@ -2,6 +2,9 @@ package pm
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,19 +22,22 @@ func NewProgressTracker(fs FileSystem) *ProgressTracker {
 
 // CalculatePhaseProgress calculates progress for a specific phase.
 // Returns metrics including task counts and completion percentage for the given phase.
+// Not applicable tasks are left out of the counts.
 func (pt *ProgressTracker) CalculatePhaseProgress(workItem *WorkItem, phase WorkPhase) PhaseProgress {
 	var phaseTasks []Task
 	for _, task := range workItem.Tasks {
-		if task.Phase == phase {
+		if task.Phase == phase && !task.NotApplicable {
 			phaseTasks = append(phaseTasks, task)
 		}
 	}
 
 	completed := 0
+	var estimate time.Duration
 	for _, task := range phaseTasks {
 		if task.Completed {
 			completed++
 		}
+		estimate += task.Estimate
 	}
 
 	progressPercent := 0
@@ -45,17 +51,66 @@ func (pt *ProgressTracker) CalculatePhaseProgress(workItem *WorkItem, phase Work
 		CompletedTasks:  completed,
 		ProgressPercent: progressPercent,
 		TimeSpent:       pt.calculateTimeSpentInPhase(workItem, phase),
+		Estimate:        estimate,
+		Groups:          pt.calculateGroupProgress(phaseTasks),
 	}
 }
 
+// calculateGroupProgress breaks down phase tasks by group, in the order groups first appear.
+// Returns nil if none of the tasks belong to a named group.
+func (pt *ProgressTracker) calculateGroupProgress(phaseTasks []Task) []GroupProgress {
+	var groups []GroupProgress
+	index := make(map[string]int)
+	named := false
+
+	for _, task := range phaseTasks {
+		if task.Group != "" {
+			named = true
+		}
+		i, ok := index[task.Group]
+		if !ok {
+			i = len(groups)
+			index[task.Group] = i
+			groups = append(groups, GroupProgress{Group: task.Group})
+		}
+		groups[i].TotalTasks++
+		if task.Completed {
+			groups[i].CompletedTasks++
+		}
+	}
+
+	if !named {
+		return nil
+	}
+
+	for i := range groups {
+		groups[i].ProgressPercent = (groups[i].CompletedTasks * 100) / groups[i].TotalTasks
+	}
+
+	return groups
+}
+
 // CalculateWorkItemMetrics calculates comprehensive metrics for a work item.
 // Returns detailed statistics including task completion, phase progress, and timing.
 func (pt *ProgressTracker) CalculateWorkItemMetrics(workItem *WorkItem) WorkItemMetrics {
-	totalTasks := len(workItem.Tasks)
+	totalTasks := 0
 	completedTasks := 0
+	openTasks := 0
+	estimatedTasks := 0
+	var remaining time.Duration
 	for _, task := range workItem.Tasks {
+		if task.NotApplicable {
+			continue
+		}
+		totalTasks++
 		if task.Completed {
 			completedTasks++
+			continue
+		}
+		openTasks++
+		if task.Estimate > 0 {
+			estimatedTasks++
+			remaining += task.Estimate
 		}
 	}
 
@@ -84,40 +139,55 @@ func (pt *ProgressTracker) CalculateWorkItemMetrics(workItem *WorkItem) WorkItem
 		TotalTimeSpent:  totalTimeSpent,
 		CreatedAt:       workItem.CreatedAt,
 		UpdatedAt:       workItem.UpdatedAt,
+		References:      workItem.References,
+
+		RemainingEstimate: remaining,
+		OpenTasks:         openTasks,
+		EstimatedTasks:    estimatedTasks,
+		EstimatedHours:    workItem.EstimatedHours,
 	}
 }
 
-// calculateTimeSpentInPhase estimates time spent in a phase based on work item timestamps
-// This is a simplified implementation - in a real system this would track actual time
-func (pt *ProgressTracker) calculateTimeSpentInPhase(workItem *WorkItem, phase WorkPhase) time.Duration {
-	// For now, return a simple estimate based on phase order
-	// In a real implementation, this would track actual time spent in each phase
-	if workItem.CreatedAt.IsZero() {
-		return 0
+// remainingEstimateSummary describes the estimated remaining work, e.g.
+// "~3d remaining across 5 open task(s) (2 more open task(s) have no estimate)", or
+// returns "" when no open task has an estimate
+func remainingEstimateSummary(metrics WorkItemMetrics) string {
+	if metrics.EstimatedTasks == 0 {
+		return ""
 	}
-
-	age := time.Since(workItem.CreatedAt)
-	phaseIndex := 0
-
-	switch phase {
-	case PhaseDiscovery:
-		phaseIndex = 0
-	case PhasePlanning:
-		phaseIndex = 1
-	case PhaseExecution:
-		phaseIndex = 2
-	case PhaseCleanup:
-		phaseIndex = 3
+	summary := fmt.Sprintf("~%s remaining across %d open task(s)", FormatEstimate(metrics.RemainingEstimate), metrics.EstimatedTasks)
+	if unestimated := metrics.OpenTasks - metrics.EstimatedTasks; unestimated > 0 {
+		summary += fmt.Sprintf(" (%d more open task(s) have no estimate)", unestimated)
 	}
+	return summary
+}
 
-	// Rough estimate: assume equal time distribution across completed phases
-	// If we're in planning phase, assume we've spent time in discovery
-	estimatedPhases := phaseIndex + 1
-	if estimatedPhases > 0 {
-		return age / time.Duration(estimatedPhases)
+// estimateVarianceSummary compares the work item's estimate with the time
+// actually spent, e.g. "8h estimated, 10h spent (+2h, +25%)", or returns ""
+// when the work item has no estimate
+func estimateVarianceSummary(metrics WorkItemMetrics) string {
+	if metrics.EstimatedHours <= 0 {
+		return ""
 	}
+	actual := metrics.TotalTimeSpent.Hours()
+	variance := actual - metrics.EstimatedHours
+	return fmt.Sprintf("%sh estimated, %sh spent (%+gh, %+.0f%%)",
+		strconv.FormatFloat(metrics.EstimatedHours, 'f', -1, 64),
+		strconv.FormatFloat(math.Round(actual*10)/10, 'f', -1, 64),
+		math.Round(variance*10)/10,
+		variance/metrics.EstimatedHours*100)
+}
 
-	return 0
+// calculateTimeSpentInPhase sums the time a work item spent in a phase over
+// its recorded phase history. Items without a "## Phase History" have none.
+func (pt *ProgressTracker) calculateTimeSpentInPhase(workItem *WorkItem, phase WorkPhase) time.Duration {
+	var spent time.Duration
+	for _, transition := range workItem.PhaseHistory {
+		if transition.Phase == phase {
+			spent += transition.Duration
+		}
+	}
+	return spent
 }
 
 // GetProgressReport generates a human-readable progress report.
@@ -128,6 +198,12 @@ func (pt *ProgressTracker) GetProgressReport(metrics WorkItemMetrics) string {
 	report += fmt.Sprintf("Overall Progress: %d%% (%d/%d tasks completed)\n",
 		metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
 	report += fmt.Sprintf("Total Time Spent: %v\n", metrics.TotalTimeSpent.Round(time.Hour))
+	if remaining := remainingEstimateSummary(metrics); remaining != "" {
+		report += fmt.Sprintf("Remaining Estimate: %s\n", remaining)
+	}
+	if variance := estimateVarianceSummary(metrics); variance != "" {
+		report += fmt.Sprintf("Estimate vs Actual: %s\n", variance)
+	}
 	report += fmt.Sprintf("Created: %s\n", metrics.CreatedAt.Format("2006-01-02 15:04"))
 	report += fmt.Sprintf("Updated: %s\n\n", metrics.UpdatedAt.Format("2006-01-02 15:04"))
 
@@ -139,11 +215,102 @@ func (pt *ProgressTracker) GetProgressReport(metrics WorkItemMetrics) string {
 			report += fmt.Sprintf(" - Spent: %v", pp.TimeSpent.Round(time.Hour))
 		}
 		report += "\n"
+		for _, gp := range pp.Groups {
+			group := gp.Group
+			if group == "" {
+				group = "(ungrouped)"
+			}
+			report += fmt.Sprintf("    %s: %d%% (%d/%d tasks)\n",
+				group, gp.ProgressPercent, gp.CompletedTasks, gp.TotalTasks)
+		}
 	}
 
 	return report
 }
 
+// GetProgressReportMarkdown generates a GitHub-flavored markdown progress report
+// suitable for pasting into a PR or wiki: a phase progress table followed by a
+// checkbox summary of which phases have all their tasks completed, and the
+// work item's references, if any.
+func (pt *ProgressTracker) GetProgressReportMarkdown(metrics WorkItemMetrics) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Progress Report: %s\n\n", metrics.Name)
+	fmt.Fprintf(&b, "**Overall Progress:** %d%% (%d/%d tasks completed)\n\n",
+		metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
+	fmt.Fprintf(&b, "- **Total Time Spent:** %v\n", metrics.TotalTimeSpent.Round(time.Hour))
+	if remaining := remainingEstimateSummary(metrics); remaining != "" {
+		fmt.Fprintf(&b, "- **Remaining Estimate:** %s\n", remaining)
+	}
+	if variance := estimateVarianceSummary(metrics); variance != "" {
+		fmt.Fprintf(&b, "- **Estimate vs Actual:** %s\n", variance)
+	}
+	fmt.Fprintf(&b, "- **Created:** %s\n", metrics.CreatedAt.Format("2006-01-02 15:04"))
+	fmt.Fprintf(&b, "- **Updated:** %s\n", metrics.UpdatedAt.Format("2006-01-02 15:04"))
+
+	if len(metrics.PhaseProgress) == 0 {
+		writeReferencesMarkdown(&b, metrics.References)
+		return b.String()
+	}
+
+	b.WriteString("\n### Phase Progress\n\n")
+	b.WriteString("| Phase | Progress | Tasks | Time Spent |\n")
+	b.WriteString("|-------|----------|-------|------------|\n")
+	for _, pp := range metrics.PhaseProgress {
+		spent := "-"
+		if pp.TimeSpent > 0 {
+			spent = pp.TimeSpent.Round(time.Hour).String()
+		}
+		fmt.Fprintf(&b, "| %s | %d%% | %d/%d | %s |\n",
+			pp.Phase, pp.ProgressPercent, pp.CompletedTasks, pp.TotalTasks, spent)
+		for _, gp := range pp.Groups {
+			group := gp.Group
+			if group == "" {
+				group = "(ungrouped)"
+			}
+			fmt.Fprintf(&b, "| &nbsp;&nbsp;%s | %d%% | %d/%d | |\n",
+				group, gp.ProgressPercent, gp.CompletedTasks, gp.TotalTasks)
+		}
+	}
+
+	b.WriteString("\n### Task Completion\n\n")
+	for _, pp := range metrics.PhaseProgress {
+		check := " "
+		if pp.TotalTasks > 0 && pp.CompletedTasks == pp.TotalTasks {
+			check = "x"
+		}
+		fmt.Fprintf(&b, "- [%s] %s (%d/%d tasks)\n", check, pp.Phase, pp.CompletedTasks, pp.TotalTasks)
+	}
+
+	writeReferencesMarkdown(&b, metrics.References)
+	return b.String()
+}
+
+// writeReferencesMarkdown writes a "### References" list of links to b, or nothing when there are none
+func writeReferencesMarkdown(b *strings.Builder, references []Reference) {
+	if len(references) == 0 {
+		return
+	}
+	b.WriteString("\n### References\n\n")
+	for _, ref := range references {
+		fmt.Fprintf(b, "- [%s](%s)\n", ref.Label, ref.URL)
+	}
+}
+
+// workDay is the length of a "d" in task estimates
+const workDay = 8 * time.Hour
+
+// FormatEstimate formats a task estimate in the largest unit that keeps it
+// at or above one, e.g. "3d", "1.5d" or "4h", rounding to one decimal place
+func FormatEstimate(d time.Duration) string {
+	for _, unit := range []string{"w", "d", "h"} {
+		if d >= estimateUnits[unit] {
+			amount := math.Round(float64(d)/float64(estimateUnits[unit])*10) / 10
+			return strconv.FormatFloat(amount, 'f', -1, 64) + unit
+		}
+	}
+	return fmt.Sprintf("%dm", int(d.Round(time.Minute)/time.Minute))
+}
+
 // PredictCompletionTime estimates when the work item will be completed.
 // Returns the predicted completion time and a status message.
 func (pt *ProgressTracker) PredictCompletionTime(metrics WorkItemMetrics) (time.Time, string) {
@@ -176,19 +343,16 @@ func (pt *ProgressTracker) PredictCompletionTime(metrics WorkItemMetrics) (time.
 	return time.Time{}, "Insufficient data for prediction"
 }
 
-// GetPhaseEfficiency calculates how efficiently time is being used in each phase.
-// Returns a map of phase to efficiency ratio (currently basic implementation).
+// GetPhaseEfficiency calculates how efficiently time is being used in each
+// phase, as the phase's task estimates divided by the time recorded in it:
+// above 1 the phase went faster than estimated, below 1 slower. Phases
+// without task estimates or recorded time are left out.
 func (pt *ProgressTracker) GetPhaseEfficiency(metrics WorkItemMetrics) map[WorkPhase]float64 {
 	efficiency := make(map[WorkPhase]float64)
 
 	for _, pp := range metrics.PhaseProgress {
-		if pp.TimeSpent > 0 {
-			// Without estimated times, we can only report that time has been spent
-			// Efficiency calculation would require estimated vs actual comparison
-			efficiency[pp.Phase] = 1.0 // Placeholder - time has been spent
-		} else {
-			// No progress yet
-			efficiency[pp.Phase] = 0.0
+		if pp.Estimate > 0 && pp.TimeSpent > 0 {
+			efficiency[pp.Phase] = float64(pp.Estimate) / float64(pp.TimeSpent)
 		}
 	}
 
@@ -1,10 +1,13 @@
 package pm
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProgressTracker(t *testing.T) {
@@ -48,6 +51,28 @@ func TestPhaseProgressCalculation(t *testing.T) {
 	assert.Equal(t, 50, progress.ProgressPercent)
 }
 
+func TestPhaseProgressGroups(t *testing.T) {
+	fs := NewMockFileSystem()
+	pt := NewProgressTracker(fs)
+
+	workItem := WorkItem{
+		Tasks: []Task{
+			{Description: "Task 1", Completed: true, Phase: PhaseExecution, Group: "Backend"},
+			{Description: "Task 2", Completed: false, Phase: PhaseExecution, Group: "Backend"},
+			{Description: "Task 3", Completed: true, Phase: PhaseExecution, Group: "Frontend"},
+			{Description: "Task 4", Completed: false, Phase: PhaseDiscovery},
+		},
+	}
+
+	progress := pt.CalculatePhaseProgress(&workItem, PhaseExecution)
+	require.Len(t, progress.Groups, 2)
+	assert.Equal(t, GroupProgress{Group: "Backend", TotalTasks: 2, CompletedTasks: 1, ProgressPercent: 50}, progress.Groups[0])
+	assert.Equal(t, GroupProgress{Group: "Frontend", TotalTasks: 1, CompletedTasks: 1, ProgressPercent: 100}, progress.Groups[1])
+
+	// Phases without named groups have no breakdown
+	assert.Nil(t, pt.CalculatePhaseProgress(&workItem, PhaseDiscovery).Groups)
+}
+
 func TestProgressReport(t *testing.T) {
 	fs := NewMockFileSystem()
 	pt := NewProgressTracker(fs)
@@ -67,6 +92,36 @@ func TestProgressReport(t *testing.T) {
 	assert.Contains(t, report, "2/4 tasks completed")
 }
 
+func TestRemainingEstimate(t *testing.T) {
+	pt := NewProgressTracker(NewMockFileSystem())
+
+	workItem := WorkItem{
+		Name: "test-feature",
+		Tasks: []Task{
+			{Description: "Task 1", Completed: true, Phase: PhaseExecution, Estimate: 16 * time.Hour},
+			{Description: "Task 2", Phase: PhaseExecution, Estimate: 2 * workDay},
+			{Description: "Task 3", Phase: PhaseExecution, Estimate: 4 * time.Hour},
+			{Description: "Task 4", Phase: PhaseExecution},
+			{Description: "Task 5", NotApplicable: true, Phase: PhaseExecution, Estimate: time.Hour},
+		},
+	}
+
+	metrics := pt.CalculateWorkItemMetrics(&workItem)
+	assert.Equal(t, 20*time.Hour, metrics.RemainingEstimate, "only open tasks count")
+	assert.Equal(t, 3, metrics.OpenTasks)
+	assert.Equal(t, 2, metrics.EstimatedTasks)
+	assert.Contains(t, pt.GetProgressReport(metrics), "Remaining Estimate: ~2.5d remaining across 2 open task(s) (1 more open task(s) have no estimate)")
+	assert.Contains(t, pt.GetProgressReportMarkdown(metrics), "- **Remaining Estimate:** ~2.5d")
+
+	// Without estimates the line is left out
+	assert.NotContains(t, pt.GetProgressReport(WorkItemMetrics{OpenTasks: 2}), "Remaining Estimate")
+
+	assert.Equal(t, "30m", FormatEstimate(30*time.Minute))
+	assert.Equal(t, "4h", FormatEstimate(4*time.Hour))
+	assert.Equal(t, "3d", FormatEstimate(3*workDay))
+	assert.Equal(t, "1w", FormatEstimate(5*workDay))
+}
+
 func TestPredictCompletionTime(t *testing.T) {
 	fs := NewMockFileSystem()
 	pt := NewProgressTracker(fs)
@@ -97,12 +152,171 @@ func TestGetPhaseEfficiency(t *testing.T) {
 
 	metrics := WorkItemMetrics{
 		PhaseProgress: []PhaseProgress{
-			{Phase: PhaseDiscovery, TimeSpent: time.Hour},
-			{Phase: PhasePlanning, TimeSpent: 0},
+			{Phase: PhaseDiscovery, TimeSpent: 4 * time.Hour, Estimate: 2 * time.Hour},
+			{Phase: PhasePlanning, TimeSpent: 2 * time.Hour, Estimate: 3 * time.Hour},
+			{Phase: PhaseExecution, TimeSpent: time.Hour},
+			{Phase: PhaseCleanup, Estimate: time.Hour},
 		},
 	}
 
 	efficiency := pt.GetPhaseEfficiency(metrics)
-	assert.Equal(t, 1.0, efficiency[PhaseDiscovery])
-	assert.Equal(t, 0.0, efficiency[PhasePlanning])
+	assert.Equal(t, map[WorkPhase]float64{PhaseDiscovery: 0.5, PhasePlanning: 1.5}, efficiency)
+}
+
+func TestEstimateVariance(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "sso"})
+	require.NoError(t, err)
+	require.NoError(t, manager.SetEstimate(ctx, "feature-sso", 8))
+
+	item, err := manager.GetWorkItem(ctx, "feature-sso")
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, item.EstimatedHours)
+	content, err := fs.ReadFile(filepath.Join(config.BacklogDir, "feature-sso", "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "## Estimate: 8h")
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.SetEstimate(ctx, "feature-sso", 0), &validationErr)
+	assert.ErrorIs(t, manager.SetEstimate(ctx, "feature-missing", 1), ErrWorkItemNotFound)
+
+	// Units follow task estimates: a day is 8 working hours
+	require.NoError(t, fs.WriteFile("/backlog/feature-api/README.md", []byte("# Feature: api\n## Estimate: 1.5d\n")))
+	parsed, err := NewWorkItemParser(fs).ParseWorkItem("feature-api", "/backlog/feature-api/README.md")
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, parsed.EstimatedHours)
+
+	pt := NewProgressTracker(fs)
+	report := pt.GetProgressReport(WorkItemMetrics{Name: "feature-sso", EstimatedHours: 8, TotalTimeSpent: 10 * time.Hour})
+	assert.Contains(t, report, "Estimate vs Actual: 8h estimated, 10h spent (+2h, +25%)")
+	assert.NotContains(t, pt.GetProgressReport(WorkItemMetrics{TotalTimeSpent: time.Hour}), "Estimate vs Actual")
+}
+
+func TestProgressReportMarkdown(t *testing.T) {
+	pt := NewProgressTracker(NewMockFileSystem())
+
+	metrics := WorkItemMetrics{
+		Name:            "test-feature",
+		TotalTasks:      5,
+		CompletedTasks:  3,
+		OverallProgress: 60,
+		PhaseProgress: []PhaseProgress{
+			{Phase: PhaseDiscovery, TotalTasks: 2, CompletedTasks: 2, ProgressPercent: 100},
+			{Phase: PhaseExecution, TotalTasks: 3, CompletedTasks: 1, ProgressPercent: 33, Groups: []GroupProgress{
+				{Group: "Backend", TotalTasks: 2, CompletedTasks: 1, ProgressPercent: 50},
+				{Group: "", TotalTasks: 1, CompletedTasks: 0, ProgressPercent: 0},
+			}},
+		},
+	}
+
+	report := pt.GetProgressReportMarkdown(metrics)
+	assert.Contains(t, report, "## Progress Report: test-feature")
+	assert.Contains(t, report, "**Overall Progress:** 60% (3/5 tasks completed)")
+	assert.Contains(t, report, "| Phase | Progress | Tasks | Time Spent |")
+	assert.Contains(t, report, "| discovery | 100% | 2/2 | - |")
+	assert.Contains(t, report, "| &nbsp;&nbsp;Backend | 50% | 1/2 | |")
+	assert.Contains(t, report, "(ungrouped)")
+	assert.Contains(t, report, "- [x] discovery (2/2 tasks)")
+	assert.Contains(t, report, "- [ ] execution (1/3 tasks)")
+}
+
+func TestPhaseHistoryTimeSpent(t *testing.T) {
+	fs := NewMockFileSystem()
+	content := `# Feature: sso
+
+## Status: COMPLETED
+## Phase: cleanup
+
+## Phase History
+- discovery: 2024-01-01T10:00:00Z
+- planning: 2024-01-03T10:00:00Z
+- execution: 2024-01-04T16:00:00Z
+- planning: 2024-01-05T10:00:00Z
+- execution: 2024-01-05T12:00:00Z
+- cleanup: 2024-01-08T12:00:00Z
+`
+	require.NoError(t, fs.WriteFile("/backlog/feature-sso/README.md", []byte(content)))
+
+	item, err := NewWorkItemParser(fs).ParseWorkItem("feature-sso", "/backlog/feature-sso/README.md")
+	require.NoError(t, err)
+	require.Len(t, item.PhaseHistory, 6)
+	assert.Equal(t, PhaseDiscovery, item.PhaseHistory[0].Phase)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), item.PhaseHistory[0].EnteredAt)
+	assert.Equal(t, 48*time.Hour, item.PhaseHistory[0].Duration)
+
+	metrics := NewProgressTracker(fs).CalculateWorkItemMetrics(&item)
+	spent := make(map[WorkPhase]time.Duration)
+	for _, pp := range metrics.PhaseProgress {
+		spent[pp.Phase] = pp.TimeSpent
+	}
+	assert.Equal(t, 48*time.Hour, spent[PhaseDiscovery])
+	assert.Equal(t, 30*time.Hour+2*time.Hour, spent[PhasePlanning], "repeat visits add up")
+	assert.Equal(t, 18*time.Hour+72*time.Hour, spent[PhaseExecution])
+	assert.Positive(t, spent[PhaseCleanup])
+}
+
+func TestAdvancePhaseRecordsPhaseHistory(t *testing.T) {
+	config := DefaultConfig()
+	config.PhaseAdvanceStrict = false
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "sso"})
+	require.NoError(t, err)
+
+	before := time.Now().Add(-time.Second)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-sso"))
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-sso"))
+	require.NoError(t, manager.SetPhase(ctx, "feature-sso", PhaseExecution))
+	require.NoError(t, manager.SetPhase(ctx, "feature-sso", PhaseExecution), "setting the same phase records nothing")
+
+	item, err := manager.GetWorkItem(ctx, "feature-sso")
+	require.NoError(t, err)
+	var phases []WorkPhase
+	for _, transition := range item.PhaseHistory {
+		phases = append(phases, transition.Phase)
+		assert.True(t, transition.EnteredAt.After(before))
+	}
+	assert.Equal(t, []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution}, phases)
+}
+
+func TestAssignTask(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "owners", Assignee: "bob"})
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AssignTask(ctx, "feature-owners", 0, "@alice"))
+	require.NoError(t, manager.CompleteTask(ctx, "feature-owners", 0))
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-owners")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", tasks[0].AssignedTo)
+	assert.NotContains(t, tasks[0].Description, "@alice")
+	assert.False(t, tasks[0].CompletedAt.IsZero(), "other annotations still parse")
+	assert.Equal(t, "bob", tasks[1].AssignedTo, "unannotated tasks default to the work item assignee")
+
+	// Reassigning replaces the annotation rather than adding a second one
+	require.NoError(t, manager.AssignTask(ctx, "feature-owners", 0, "carol"))
+	content, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "(@carol)")
+	assert.NotContains(t, string(content), "(@alice)")
+
+	require.NoError(t, manager.AssignTask(ctx, "feature-owners", 0, ""))
+	tasks, err = manager.GetPhaseTasks(ctx, "feature-owners")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", tasks[0].AssignedTo)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, manager.AssignTask(ctx, "feature-owners", 0, "two words"), &validationErr)
 }
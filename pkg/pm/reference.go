@@ -0,0 +1,62 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// validateReference checks that a reference has a label that can be written
+// as markdown link text and an absolute http(s) URL
+func validateReference(ref Reference) error {
+	if strings.TrimSpace(ref.Label) == "" {
+		return &ValidationError{Field: "label", Value: ref.Label, Message: "label cannot be empty"}
+	}
+	if strings.ContainsAny(ref.Label, "[]\n") {
+		return &ValidationError{Field: "label", Value: ref.Label, Message: "label cannot contain brackets or newlines"}
+	}
+
+	u, err := url.Parse(ref.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.ContainsAny(ref.URL, " \t\n") {
+		return &ValidationError{Field: "url", Value: ref.URL, Message: "must be an absolute http or https URL"}
+	}
+	return nil
+}
+
+// AddReference adds an external link (design doc, ticket, dashboard) to a work
+// item's "## References" section. The label and URL are validated first, and a
+// link that is already listed is not repeated.
+//
+// Example:
+//
+//	ref := Reference{Label: "Design doc", URL: "https://docs.example.com/auth"}
+//	err := service.AddReference(ctx, "feature-user-auth", ref)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) AddReference(ctx context.Context, name string, ref Reference) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	ref.Label = strings.TrimSpace(ref.Label)
+	ref.URL = strings.TrimSpace(ref.URL)
+	if err := validateReference(ref); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "add_reference", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	if err := s.updater.AddReference(readmePath, ref); err != nil {
+		return &WorkItemError{Op: "add_reference", Name: name, Err: fmt.Errorf("failed to add reference: %w", err)}
+	}
+	return nil
+}
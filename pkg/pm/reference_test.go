@@ -0,0 +1,91 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemParserReferences(t *testing.T) {
+	fs := NewMockFileSystem()
+	parser := NewWorkItemParser(fs)
+
+	content := `# Feature: user-auth
+
+## Status: PROPOSED
+
+## References
+- [Design doc](https://docs.example.com/auth)
+- plain note, not a link
+* [Ticket](https://tickets.example.com/PM-12)
+- [x](https://example.com/not-a-task)
+
+## Discovery Phase
+- [ ] Research
+`
+	require.NoError(t, fs.WriteFile("/test/README.md", []byte(content)))
+
+	item, err := parser.ParseWorkItem("feature-user-auth", "/test/README.md")
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{
+		{Label: "Design doc", URL: "https://docs.example.com/auth"},
+		{Label: "Ticket", URL: "https://tickets.example.com/PM-12"},
+		{Label: "x", URL: "https://example.com/not-a-task"},
+	}, item.References)
+	require.Len(t, item.Tasks, 1)
+	assert.Equal(t, "Research", item.Tasks[0].Description)
+}
+
+func TestAddReference(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "auth"})
+	require.NoError(t, err)
+
+	design := Reference{Label: "Design doc", URL: "https://docs.example.com/auth"}
+	require.NoError(t, manager.AddReference(ctx, "feature-auth", design))
+	require.NoError(t, manager.AddReference(ctx, "feature-auth", Reference{Label: "Dashboard", URL: "http://grafana.local/d/auth"}))
+	// Adding the same link again is a no-op
+	require.NoError(t, manager.AddReference(ctx, "feature-auth", design))
+
+	item, err := manager.GetWorkItem(ctx, "feature-auth")
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{design, {Label: "Dashboard", URL: "http://grafana.local/d/auth"}}, item.References)
+
+	content, err := fs.ReadFile(filepath.Join(config.BacklogDir, "feature-auth", config.WorkItemFile))
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(content), "## References"))
+
+	var validationErr *ValidationError
+	for _, ref := range []Reference{
+		{Label: "", URL: "https://example.com"},
+		{Label: "[bad]", URL: "https://example.com"},
+		{Label: "Relative", URL: "/docs/auth"},
+		{Label: "Scheme", URL: "ftp://example.com/file"},
+		{Label: "Spaces", URL: "https://example.com/a b"},
+	} {
+		require.ErrorAs(t, manager.AddReference(ctx, "feature-auth", ref), &validationErr, ref.Label)
+	}
+
+	assert.ErrorIs(t, manager.AddReference(ctx, "feature-missing", design), ErrWorkItemNotFound)
+}
+
+func TestProgressReportMarkdownReferences(t *testing.T) {
+	tracker := NewProgressTracker(NewMockFileSystem())
+
+	report := tracker.GetProgressReportMarkdown(WorkItemMetrics{
+		Name:       "feature-auth",
+		References: []Reference{{Label: "Design doc", URL: "https://docs.example.com/auth"}},
+	})
+	assert.Contains(t, report, "### References\n\n- [Design doc](https://docs.example.com/auth)\n")
+
+	report = tracker.GetProgressReportMarkdown(WorkItemMetrics{Name: "feature-auth"})
+	assert.NotContains(t, report, "### References")
+}
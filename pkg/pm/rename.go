@@ -0,0 +1,140 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// RenameWorkItem renames a backlog work item: its directory is moved, a title
+// that still matches the old name is updated to the new one, other work items
+// that depend on it or link to it under "## Related Items" (parent, child and
+// graduation links) are updated, and when Config.EnableGit is set its item and
+// phase branches are renamed. newName may be given with or without the type
+// prefix ("login" or "feature-login"); the type can't change. Returns the
+// renamed work item.
+//
+// Example:
+//
+//	item, err := service.RenameWorkItem(ctx, "feature-user-auht", "user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(item.Name) // feature-user-auth
+func (s *WorkItemService) RenameWorkItem(ctx context.Context, oldName, newName string) (*WorkItem, error) {
+	renamed, err := s.renameWorkItem(ctx, oldName, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other items are updated under their own locks once this one's is
+	// released, so two renames of linked items can't wait on each other
+	if err := s.renameReferences(ctx, oldName, renamed.Name); err != nil {
+		return nil, &WorkItemError{Op: "rename", Name: renamed.Name, Err: err}
+	}
+	return renamed, nil
+}
+
+// renameWorkItem moves the work item and updates its own README and branches
+// while holding its lock
+func (s *WorkItemService) renameWorkItem(ctx context.Context, oldName, newName string) (*WorkItem, error) {
+	ctx, unlock := s.lockItem(ctx, oldName)
+	defer unlock()
+
+	if err := validateWorkItemName(oldName); err != nil {
+		return nil, err
+	}
+	item, readmePath, err := s.backlogItem(oldName, "rename")
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := string(item.Type) + "-"
+	newBase := strings.TrimPrefix(newName, prefix)
+	if err := validateWorkItemName(newBase); err != nil {
+		return nil, err
+	}
+	newDirName := s.getWorkItemDirName(item.Type, newBase)
+	if newDirName == oldName {
+		return nil, &ValidationError{Field: "name", Value: newName, Message: "new name is the same as the current one"}
+	}
+	if err := s.checkNameAvailable(item.Type, newBase); err != nil {
+		return nil, err
+	}
+
+	oldDir := filepath.Dir(readmePath)
+	newDir := filepath.Join(filepath.Dir(oldDir), newDirName)
+	if err := s.fs.MoveDirectory(oldDir, newDir); err != nil {
+		return nil, &WorkItemError{Op: "rename", Name: oldName, Err: fmt.Errorf("failed to move work item: %w", err)}
+	}
+	newReadme := filepath.Join(newDir, s.config.WorkItemFile)
+
+	// Keep a title that was generated from the name in sync with it
+	if item.Title == strings.TrimPrefix(oldName, prefix) || item.Title == oldName {
+		if err := s.updater.UpdateTitle(newReadme, newBase); err != nil {
+			return nil, &WorkItemError{Op: "rename", Name: newDirName, Err: fmt.Errorf("failed to update title: %w", err)}
+		}
+	}
+
+	if s.config.EnableGit {
+		if _, err := s.git.RenameWorkItemBranches(item.Type, oldName, newDirName); err != nil {
+			return nil, &WorkItemError{Op: "rename", Name: newDirName, Err: fmt.Errorf("work item renamed, but not all of its branches: %w", err)}
+		}
+	}
+
+	renamed, err := s.parser.ParseWorkItem(newDirName, newReadme)
+	if err != nil {
+		return nil, &WorkItemError{Op: "rename", Name: newDirName, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	return &renamed, nil
+}
+
+// renameReferences replaces oldName with newName in the "## Depends On:"
+// lines and "## Related Items" links of the work items, archived ones
+// included, that refer to it
+func (s *WorkItemService) renameReferences(ctx context.Context, oldName, newName string) error {
+	items, err := s.ListWorkItems(ctx, ListFilter{Scope: ScopeAll})
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if refersTo(item, oldName) {
+			if err := s.renameReferencesIn(ctx, item, oldName, newName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renameReferencesIn rewrites item's references to oldName while holding its
+// lock, re-reading it first so changes made since it was listed aren't lost
+func (s *WorkItemService) renameReferencesIn(ctx context.Context, item WorkItem, oldName, newName string) error {
+	_, unlock := s.lockItem(ctx, item.Name)
+	defer unlock()
+
+	current, err := s.parser.ParseWorkItem(item.Name, item.Path)
+	if err != nil {
+		return fmt.Errorf("failed to parse '%s': %w", item.Name, err)
+	}
+	if i := slices.Index(current.DependsOn, oldName); i >= 0 {
+		current.DependsOn[i] = newName
+		if err := s.updater.UpdateDependencies(item.Path, current.DependsOn); err != nil {
+			return fmt.Errorf("failed to update dependencies of '%s': %w", item.Name, err)
+		}
+	}
+	if slices.ContainsFunc(current.RelatedItems, func(related RelatedItem) bool { return related.Name == oldName }) {
+		if err := s.updater.RenameRelatedItem(item.Path, oldName, newName); err != nil {
+			return fmt.Errorf("failed to update related items of '%s': %w", item.Name, err)
+		}
+	}
+	return nil
+}
+
+// refersTo reports whether item depends on or links to the work item name
+func refersTo(item WorkItem, name string) bool {
+	return slices.Contains(item.DependsOn, name) ||
+		slices.ContainsFunc(item.RelatedItems, func(related RelatedItem) bool { return related.Name == name })
+}
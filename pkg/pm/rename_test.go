@@ -0,0 +1,111 @@
+package pm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	config.EnableGit = true
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for _, req := range []CreateRequest{
+		{Type: TypeFeature, Name: "user-auht"},
+		{Type: TypeFeature, Name: "sso"},
+		{Type: TypeFeature, Name: "taken"},
+	} {
+		_, err := manager.CreateWorkItem(ctx, req)
+		require.NoError(t, err)
+	}
+	require.NoError(t, manager.AddDependency(ctx, "feature-sso", "feature-user-auht"))
+	oldBranch := manager.service.git.BranchName(TypeFeature, "user-auht")
+	require.True(t, git.BranchExists(oldBranch))
+
+	item, err := manager.RenameWorkItem(ctx, "feature-user-auht", "user-auth")
+	require.NoError(t, err)
+	assert.Equal(t, "feature-user-auth", item.Name)
+	assert.Equal(t, "user-auth", item.Title)
+	assert.False(t, fs.DirectoryExists(config.BacklogDir+"/feature-user-auht"))
+	assert.True(t, fs.DirectoryExists(config.BacklogDir+"/feature-user-auth"))
+
+	assert.False(t, git.BranchExists(oldBranch))
+	assert.True(t, git.BranchExists(manager.service.git.BranchName(TypeFeature, "user-auth")))
+
+	sso, err := manager.GetWorkItem(ctx, "feature-sso")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-user-auth"}, sso.DependsOn)
+
+	// A title that no longer matches the name is kept
+	require.NoError(t, manager.service.updater.UpdateTitle(config.BacklogDir+"/feature-user-auth/"+config.WorkItemFile, "Single sign-on"))
+	item, err = manager.RenameWorkItem(ctx, "feature-user-auth", "feature-login")
+	require.NoError(t, err)
+	assert.Equal(t, "Single sign-on", item.Title)
+
+	var validationErr *ValidationError
+	_, err = manager.RenameWorkItem(ctx, "feature-login", "taken")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "work item already exists", validationErr.Message)
+	_, err = manager.RenameWorkItem(ctx, "feature-login", "feature-login")
+	assert.ErrorAs(t, err, &validationErr)
+	_, err = manager.RenameWorkItem(ctx, "feature-login", "../escape")
+	assert.ErrorAs(t, err, &validationErr)
+	_, err = manager.RenameWorkItem(ctx, "feature-missing", "other")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestRenameWorkItemUpdatesRelatedItems(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "checkout"})
+	require.NoError(t, err)
+	_, err = manager.SplitWorkItem(ctx, "feature-checkout", []string{"checkout-api", "checkout-ui"})
+	require.NoError(t, err)
+
+	// The child's own lock is taken before its links are rewritten
+	inSession := make(chan struct{})
+	renamed := make(chan struct{})
+	err = manager.WithSession(ctx, "feature-checkout-api", func(session *Session) error {
+		go func() {
+			<-inSession
+			_, err := manager.RenameWorkItem(ctx, "feature-checkout", "payments")
+			assert.NoError(t, err)
+			close(renamed)
+		}()
+		close(inSession)
+
+		select {
+		case <-renamed:
+			t.Error("the rename rewrote a child while its session held the lock")
+		case <-time.After(20 * time.Millisecond):
+		}
+		return session.Assign("alice")
+	})
+	require.NoError(t, err)
+	<-renamed
+
+	for _, name := range []string{"feature-checkout-api", "feature-checkout-ui"} {
+		child, err := manager.GetWorkItem(ctx, name)
+		require.NoError(t, err)
+		assert.Equal(t, []RelatedItem{{Relation: RelationChildOf, Name: "feature-payments"}}, child.RelatedItems)
+	}
+	child, err := manager.GetWorkItem(ctx, "feature-checkout-api")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", child.AssignedTo, "the session's change is kept")
+
+	parent, err := manager.GetWorkItem(ctx, "feature-payments")
+	require.NoError(t, err)
+	assert.Len(t, parent.RelatedItems, 2, "the renamed item's own links are unchanged")
+}
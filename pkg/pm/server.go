@@ -0,0 +1,282 @@
+package pm
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// apiMaxBodyBytes caps the size of JSON request bodies accepted by the API
+const apiMaxBodyBytes = 1 << 20
+
+// createWorkItemRequest is the body of POST /workitems
+type createWorkItemRequest struct {
+	Type     ItemType `json:"type"`
+	Name     string   `json:"name"`
+	Title    string   `json:"title"`
+	Priority Priority `json:"priority"`
+	Labels   []string `json:"labels"`
+	Assignee string   `json:"assignee"`
+}
+
+// updateWorkItemRequest is the body of PATCH /workitems/{name}; omitted fields are left unchanged
+type updateWorkItemRequest struct {
+	Status   *ItemStatus `json:"status"`
+	Progress *int        `json:"progress"`
+	Assignee *string     `json:"assignee"`
+}
+
+// apiError is the JSON body returned for failed requests
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// apiServer serves the work item HTTP API
+type apiServer struct {
+	manager Manager
+	token   string
+}
+
+// NewAPIHandler returns an http.Handler serving the work item API backed by manager:
+//
+//	GET   /workitems                  list work items (optional ?status=)
+//	GET   /workitems/{name}           get a work item
+//	POST  /workitems                  create a work item
+//	PATCH /workitems/{name}           update status, progress and/or assignee
+//	POST  /workitems/{name}/advance   advance a work item to its next phase
+//	GET   /metrics                    backlog stats in the Prometheus text format
+//
+// Write endpoints require an "Authorization: Bearer <token>" header matching
+// token; when token is empty, writes are rejected. Responses are JSON work
+// items; failures map ValidationError to 400, missing items to 404 and
+// phase conflicts or duplicate creates to 409.
+func NewAPIHandler(manager Manager, token string) http.Handler {
+	srv := &apiServer{manager: manager, token: token}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workitems", srv.handleList)
+	mux.HandleFunc("GET /workitems/{name}", srv.handleGet)
+	mux.HandleFunc("POST /workitems", srv.requireToken(srv.handleCreate))
+	mux.HandleFunc("PATCH /workitems/{name}", srv.requireToken(srv.handleUpdate))
+	mux.HandleFunc("POST /workitems/{name}/advance", srv.requireToken(srv.handleAdvance))
+	mux.HandleFunc("GET /metrics", srv.handleMetrics)
+	return mux
+}
+
+// requireToken rejects requests without the configured bearer token
+func (srv *apiServer) requireToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if srv.token == "" {
+			writeAPIError(w, http.StatusForbidden, errors.New("write access is disabled; configure api_token to enable it"))
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(srv.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeAPIError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (srv *apiServer) handleList(w http.ResponseWriter, r *http.Request) {
+	filter := ListFilter{Status: ItemStatus(r.URL.Query().Get("status"))}
+	items, err := srv.manager.ListWorkItems(r.Context(), filter)
+	if err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	if items == nil {
+		items = []WorkItem{}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (srv *apiServer) handleGet(w http.ResponseWriter, r *http.Request) {
+	name, ok := workItemPathName(w, r)
+	if !ok {
+		return
+	}
+	srv.writeWorkItem(w, r.Context(), name, http.StatusOK)
+}
+
+func (srv *apiServer) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var req createWorkItemRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Creating an existing item is a conflict, not a validation failure
+	if req.Type != "" && req.Name != "" {
+		if _, err := srv.manager.GetWorkItem(r.Context(), fmt.Sprintf("%s-%s", req.Type, req.Name)); err == nil {
+			writeAPIError(w, http.StatusConflict, fmt.Errorf("work item %s-%s already exists", req.Type, req.Name))
+			return
+		}
+	}
+
+	item, err := srv.manager.CreateWorkItem(r.Context(), CreateRequest{
+		Type:     req.Type,
+		Name:     req.Name,
+		Title:    req.Title,
+		Priority: req.Priority,
+		Labels:   req.Labels,
+		Assignee: req.Assignee,
+	})
+	if err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, item)
+}
+
+func (srv *apiServer) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	name, ok := workItemPathName(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateWorkItemRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Status == nil && req.Progress == nil && req.Assignee == nil {
+		writeAPIError(w, http.StatusBadRequest, errors.New("request must set at least one of status, progress or assignee"))
+		return
+	}
+
+	// Validate up front so a bad field doesn't leave the item partially updated
+	if req.Progress != nil && (*req.Progress < 0 || *req.Progress > 100) {
+		writeManagerError(w, &ValidationError{Field: "progress", Value: fmt.Sprintf("%d", *req.Progress), Message: "progress must be between 0 and 100"})
+		return
+	}
+	if req.Assignee != nil && strings.TrimSpace(*req.Assignee) == "" {
+		writeManagerError(w, &ValidationError{Field: "assignee", Value: *req.Assignee, Message: "assignee cannot be empty"})
+		return
+	}
+	if _, err := srv.manager.GetWorkItem(r.Context(), name); err != nil {
+		writeManagerError(w, err)
+		return
+	}
+
+	if req.Status != nil {
+		if err := srv.manager.UpdateStatus(r.Context(), name, *req.Status); err != nil {
+			writeManagerError(w, err)
+			return
+		}
+	}
+	if req.Progress != nil {
+		if err := srv.manager.UpdateProgress(r.Context(), name, *req.Progress); err != nil {
+			writeManagerError(w, err)
+			return
+		}
+	}
+	if req.Assignee != nil {
+		if err := srv.manager.AssignWorkItem(r.Context(), name, *req.Assignee); err != nil {
+			writeManagerError(w, err)
+			return
+		}
+	}
+
+	srv.writeWorkItem(w, r.Context(), name, http.StatusOK)
+}
+
+func (srv *apiServer) handleAdvance(w http.ResponseWriter, r *http.Request) {
+	name, ok := workItemPathName(w, r)
+	if !ok {
+		return
+	}
+	if _, err := srv.manager.GetWorkItem(r.Context(), name); err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	if err := srv.manager.AdvancePhase(r.Context(), name); err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	srv.writeWorkItem(w, r.Context(), name, http.StatusOK)
+}
+
+func (srv *apiServer) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	stats, err := srv.manager.GetBacklogStats(r.Context(), ScopeBacklog)
+	if err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = fmt.Fprint(w, stats.Prometheus())
+}
+
+// workItemPathName returns the {name} path value, responding 400 when it isn't
+// a valid work item name. The router decodes "%2F" to "/", so a name such as
+// "..%2Foutside" would otherwise reach outside the backlog.
+func workItemPathName(w http.ResponseWriter, r *http.Request) (string, bool) {
+	name := r.PathValue("name")
+	if err := validateWorkItemName(name); err != nil {
+		writeManagerError(w, err)
+		return "", false
+	}
+	return name, true
+}
+
+// writeWorkItem responds with the current state of a work item
+func (srv *apiServer) writeWorkItem(w http.ResponseWriter, ctx context.Context, name string, status int) {
+	item, err := srv.manager.GetWorkItem(ctx, name)
+	if err != nil {
+		writeManagerError(w, err)
+		return
+	}
+	writeJSON(w, status, item)
+}
+
+// decodeJSONBody decodes a single JSON object from the request body, rejecting unknown fields
+func decodeJSONBody(w http.ResponseWriter, r *http.Request, v any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, apiMaxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+// apiStatusCode maps the package's typed errors onto HTTP status codes
+func apiStatusCode(err error) int {
+	var validationErr *ValidationError
+	var phaseErr *PhaseError
+	var completedErr *AlreadyCompletedError
+
+	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrWorkItemNotFound), errors.Is(err, ErrReadmeMissing):
+		return http.StatusNotFound
+	case errors.As(err, &phaseErr), errors.As(err, &completedErr):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// writeManagerError responds with the HTTP status matching a Manager error
+func writeManagerError(w http.ResponseWriter, err error) {
+	writeAPIError(w, apiStatusCode(err), err)
+}
+
+// writeAPIError responds with a JSON error body
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, apiError{Error: err.Error()})
+}
+
+// writeJSON responds with v encoded as JSON
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
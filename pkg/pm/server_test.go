@@ -0,0 +1,153 @@
+package pm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPIServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+
+	server := httptest.NewServer(NewAPIHandler(manager, token))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func apiRequest(t *testing.T, server *httptest.Server, method, path, token, body string) (*http.Response, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var decoded map[string]any
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+	}
+	return resp, decoded
+}
+
+func TestAPIWriteEndpoints(t *testing.T) {
+	server := newTestAPIServer(t, "secret")
+
+	resp, body := apiRequest(t, server, http.MethodPost, "/workitems", "secret", `{"type": "feature", "name": "api", "priority": "HIGH"}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "feature-api", body["name"])
+	assert.Equal(t, "HIGH", body["priority"])
+
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems", "secret", `{"type": "feature", "name": "api"}`)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, body = apiRequest(t, server, http.MethodPatch, "/workitems/feature-api", "secret", `{"progress": 40, "assignee": "alice"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(40), body["progress"])
+	assert.Equal(t, "alice", body["assigned_to"])
+
+	resp, body = apiRequest(t, server, http.MethodPost, "/workitems/feature-api/advance", "secret", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, string(StatusInProgressDiscovery), body["status"])
+
+	resp, _ = apiRequest(t, server, http.MethodGet, "/workitems/feature-api", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAPIErrors(t *testing.T) {
+	server := newTestAPIServer(t, "secret")
+
+	// Writes require the bearer token
+	resp, _ := apiRequest(t, server, http.MethodPost, "/workitems", "", `{"type": "feature", "name": "x"}`)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems", "wrong", `{"type": "feature", "name": "x"}`)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// Invalid input
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems", "secret", `{"type": "chore", "name": "x"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems", "secret", `{"typo": "feature"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Unknown items
+	resp, body := apiRequest(t, server, http.MethodPatch, "/workitems/feature-missing", "secret", `{"progress": 10}`)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Contains(t, body["error"], "not found")
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems/feature-missing/advance", "secret", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Partial updates are rejected before anything is written
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems", "secret", `{"type": "feature", "name": "x"}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPatch, "/workitems/feature-x", "secret", `{"status": "IN_PROGRESS_EXECUTION", "progress": 150}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	_, body = apiRequest(t, server, http.MethodGet, "/workitems/feature-x", "", "")
+	assert.Equal(t, string(StatusProposed), body["status"])
+
+	// Phase conflicts
+	resp, _ = apiRequest(t, server, http.MethodPatch, "/workitems/feature-x", "secret", `{"status": "COMPLETED"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems/feature-x/advance", "secret", "")
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestAPIRejectsPathTraversal(t *testing.T) {
+	dir := t.TempDir()
+	config := DefaultConfig()
+	config.BacklogDir = filepath.Join(dir, "backlog")
+	config.CompletedDir = filepath.Join(dir, "completed")
+	require.NoError(t, os.MkdirAll(config.BacklogDir, 0755))
+	outside := filepath.Join(dir, "outside", "README.md")
+	require.NoError(t, os.MkdirAll(filepath.Dir(outside), 0755))
+	content := "# Feature: outside\n\n## Status: PROPOSED\n## Phase: discovery\n## Progress: 0%\n## Assigned To: bob\n"
+	require.NoError(t, os.WriteFile(outside, []byte(content), 0644))
+
+	manager := NewDefaultManagerWithDeps(config, NewOSFileSystem(), NewNoOpGitClient())
+	server := httptest.NewServer(NewAPIHandler(manager, "secret"))
+	t.Cleanup(server.Close)
+
+	// The router decodes %2F, so the name reaches the handler as "../outside"
+	resp, _ := apiRequest(t, server, http.MethodGet, "/workitems/..%2Foutside", "", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPatch, "/workitems/..%2Foutside", "secret", `{"assignee": "mallory"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, _ = apiRequest(t, server, http.MethodPost, "/workitems/..%2Foutside/advance", "secret", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	data, err := os.ReadFile(outside)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data), "the file outside the backlog is untouched")
+
+	// The service rejects the name too, for callers other than the API
+	_, err = manager.GetWorkItem(t.Context(), "../outside")
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.ErrorAs(t, manager.AssignWorkItem(t.Context(), "../outside", "mallory"), &validationErr)
+}
+
+func TestAPIWritesDisabledWithoutToken(t *testing.T) {
+	server := newTestAPIServer(t, "")
+
+	resp, _ := apiRequest(t, server, http.MethodPost, "/workitems", "", `{"type": "feature", "name": "x"}`)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, _ = apiRequest(t, server, http.MethodGet, "/workitems", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = apiRequest(t, server, http.MethodGet, "/metrics", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
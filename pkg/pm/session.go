@@ -0,0 +1,166 @@
+package pm
+
+import (
+	"context"
+	"sync"
+)
+
+// itemLocks hands out one mutex per work item name, so that mutations of
+// different work items don't wait on each other. Entries are removed once no
+// caller holds or waits for them.
+type itemLocks struct {
+	mu    sync.Mutex
+	items map[string]*itemLock
+}
+
+// itemLock is the mutex of one work item and how many callers hold or wait for it
+type itemLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock acquires the lock for name and returns the function that releases it
+func (l *itemLocks) lock(name string) func() {
+	l.mu.Lock()
+	if l.items == nil {
+		l.items = make(map[string]*itemLock)
+	}
+	entry, ok := l.items[name]
+	if !ok {
+		entry = &itemLock{}
+		l.items[name] = entry
+	}
+	entry.refs++
+	l.mu.Unlock()
+
+	entry.mu.Lock()
+	return func() {
+		entry.mu.Unlock()
+
+		l.mu.Lock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(l.items, name)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// heldItemLock marks a context as holding a work item's lock, so that
+// mutations called with it, from a Session or from another locked mutation,
+// don't try to take the lock again
+type heldItemLock struct {
+	locks *itemLocks
+	name  string
+}
+
+// lockItem takes the lock of the work item name for a mutation, unless ctx
+// already holds it. It returns ctx marked as holding the lock, for nested
+// calls, and the function that releases the lock. The lock is in-process: it
+// serializes mutations made through this service, such as concurrent API
+// requests and agent sessions, not those of other go-pm processes.
+func (s *WorkItemService) lockItem(ctx context.Context, name string) (context.Context, func()) {
+	held := heldItemLock{locks: s.locks, name: name}
+	if ctx.Value(held) != nil {
+		return ctx, func() {}
+	}
+	unlock := s.locks.lock(name)
+	return context.WithValue(ctx, held, true), unlock
+}
+
+// Session performs a batch of mutations on one work item while its lock is held.
+// It is only valid inside the WithSession callback that received it.
+type Session struct {
+	ctx     context.Context
+	name    string
+	service *WorkItemService
+}
+
+// Name returns the work item the session operates on
+func (se *Session) Name() string {
+	return se.name
+}
+
+// WorkItem re-reads the work item, reflecting mutations made earlier in the session
+func (se *Session) WorkItem() (*WorkItem, error) {
+	return se.service.GetWorkItem(se.ctx, se.name)
+}
+
+// UpdateStatus changes the work item's status
+func (se *Session) UpdateStatus(status ItemStatus) error {
+	return se.service.UpdateStatus(se.ctx, se.name, status)
+}
+
+// SetPhase moves the work item directly to phase
+func (se *Session) SetPhase(phase WorkPhase) error {
+	return se.service.SetPhase(se.ctx, se.name, phase)
+}
+
+// AdvancePhase moves the work item to its next phase, returning any
+// incomplete tasks the advance was allowed to leave behind
+func (se *Session) AdvancePhase() ([]Task, error) {
+	return se.service.AdvancePhaseWithWarnings(se.ctx, se.name)
+}
+
+// CompleteTask marks the task with taskID in the current phase as completed
+func (se *Session) CompleteTask(taskID int) error {
+	return se.service.CompleteTask(se.ctx, se.name, taskID)
+}
+
+// CompleteTaskByDescription marks the current-phase task matching query as completed
+func (se *Session) CompleteTaskByDescription(query string) error {
+	return se.service.CompleteTaskByDescription(se.ctx, se.name, query)
+}
+
+// UpdateProgress sets the work item's progress percentage
+func (se *Session) UpdateProgress(progress int) error {
+	return se.service.UpdateProgress(se.ctx, se.name, progress)
+}
+
+// Assign assigns the work item to assignee
+func (se *Session) Assign(assignee string) error {
+	return se.service.AssignWorkItem(se.ctx, se.name, assignee)
+}
+
+// AppendNote adds a timestamped note to the work item
+func (se *Session) AppendNote(note string) error {
+	return se.service.AppendNote(se.ctx, se.name, note)
+}
+
+// SyncPhaseTasks adds template tasks missing from the current phase
+func (se *Session) SyncPhaseTasks() error {
+	return se.service.SyncPhaseTasks(se.ctx, se.name)
+}
+
+// WithSession acquires the work item's lock once, runs fn with a Session
+// exposing the mutation methods, and releases the lock when fn returns.
+// Use it to batch many mutations, e.g. from an agent, without locking per call.
+// The single-call mutation methods take the same lock, so they wait for the
+// session rather than interleave with it, while the session's own calls reuse
+// the lock it holds. fn's error is returned as is.
+//
+// Example:
+//
+//	err := service.WithSession(ctx, "feature-user-auth", func(session *Session) error {
+//		if err := session.CompleteTask(0); err != nil {
+//			return err
+//		}
+//		if err := session.AppendNote("Finished discovery"); err != nil {
+//			return err
+//		}
+//		_, err := session.AdvancePhase()
+//		return err
+//	})
+func (s *WorkItemService) WithSession(ctx context.Context, name string, fn func(session *Session) error) error {
+	if _, err := s.GetWorkItem(ctx, name); err != nil {
+		return err
+	}
+
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fn(&Session{ctx: ctx, name: name, service: s})
+}
@@ -0,0 +1,146 @@
+package pm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSession(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	service := NewWorkItemService(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := service.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "session"})
+	require.NoError(t, err)
+
+	// Mutations in a session are visible to later calls in the same session
+	err = service.WithSession(ctx, "feature-session", func(session *Session) error {
+		assert.Equal(t, "feature-session", session.Name())
+		if _, err := session.AdvancePhase(); err != nil {
+			return err
+		}
+		if err := session.CompleteTask(0); err != nil {
+			return err
+		}
+		if err := session.Assign("agent"); err != nil {
+			return err
+		}
+		item, err := session.WorkItem()
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, StatusInProgressDiscovery, item.Status)
+		assert.Equal(t, "agent", item.AssignedTo)
+		return nil
+	})
+	require.NoError(t, err)
+
+	tasks, err := service.GetPhaseTasks(ctx, "feature-session")
+	require.NoError(t, err)
+	require.NotEmpty(t, tasks)
+	assert.True(t, tasks[0].Completed)
+
+	// fn's error is returned as is
+	sentinel := errors.New("stop")
+	err = service.WithSession(ctx, "feature-session", func(*Session) error { return sentinel })
+	assert.ErrorIs(t, err, sentinel)
+
+	// Missing work items fail before fn runs
+	called := false
+	err = service.WithSession(ctx, "feature-missing", func(*Session) error {
+		called = true
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+	assert.False(t, called)
+}
+
+func TestWithSessionSerializesSameItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	service := NewWorkItemService(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := service.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "serial"})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := service.WithSession(ctx, "feature-serial", func(*Session) error {
+				mu.Lock()
+				active++
+				maxActive = max(maxActive, active)
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxActive)
+}
+
+func TestSingleCallsWaitForSession(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	service := NewWorkItemService(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := service.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "shared"})
+	require.NoError(t, err)
+
+	inSession := make(chan struct{})
+	updated := make(chan struct{})
+	err = service.WithSession(ctx, "feature-shared", func(session *Session) error {
+		go func() {
+			<-inSession
+			assert.NoError(t, service.UpdateProgress(ctx, "feature-shared", 90))
+			close(updated)
+		}()
+		close(inSession)
+
+		if err := session.UpdateProgress(10); err != nil {
+			return err
+		}
+		select {
+		case <-updated:
+			t.Error("a single-call mutation ran while the session held the lock")
+		case <-time.After(20 * time.Millisecond):
+		}
+		item, err := session.WorkItem()
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, 10, item.Progress)
+		return nil
+	})
+	require.NoError(t, err)
+
+	<-updated
+	item, err := service.GetWorkItem(ctx, "feature-shared")
+	require.NoError(t, err)
+	assert.Equal(t, 90, item.Progress, "the single call ran after the session")
+
+	service.locks.mu.Lock()
+	defer service.locks.mu.Unlock()
+	assert.Empty(t, service.locks.items, "released locks are removed")
+}
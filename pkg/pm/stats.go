@@ -0,0 +1,354 @@
+package pm
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// BacklogStats summarizes the work items in the backlog
+type BacklogStats struct {
+	GeneratedAt     time.Time          // When the stats were computed
+	Total           int                // Number of work items in the backlog
+	ByStatus        map[ItemStatus]int // Work item count per status
+	ByType          map[ItemType]int   // Work item count per type
+	AverageProgress int                // Mean progress percentage across all items (0-100)
+	Stale           int                // In-progress items not updated within PhaseTimeoutDays
+	Overdue         int                // Unfinished items past their due date
+}
+
+// statsStatusOrder is the order statuses are rendered in, following the workflow
+var statsStatusOrder = []ItemStatus{
+	StatusProposed,
+	StatusInProgressDiscovery,
+	StatusInProgressPlanning,
+	StatusInProgressExecution,
+	StatusInProgressCleanup,
+	StatusInProgressReview,
+	StatusCompleted,
+}
+
+// statsTypeOrder is the order work item types are rendered in
+var statsTypeOrder = []ItemType{TypeFeature, TypeBug, TypeExperiment}
+
+// newBacklogStats computes stats for the given work items
+func newBacklogStats(items []WorkItem, timeoutDays int, now time.Time) BacklogStats {
+	stats := BacklogStats{
+		GeneratedAt: now,
+		Total:       len(items),
+		ByStatus:    make(map[ItemStatus]int),
+		ByType:      make(map[ItemType]int),
+	}
+
+	progressSum := 0
+	for _, item := range items {
+		stats.ByStatus[item.Status]++
+		stats.ByType[item.Type]++
+		progressSum += item.Progress
+		if isStale(item, timeoutDays, now) {
+			stats.Stale++
+		}
+		if isOverdue(item, now) {
+			stats.Overdue++
+		}
+	}
+	if len(items) > 0 {
+		stats.AverageProgress = progressSum / len(items)
+	}
+
+	return stats
+}
+
+// Text renders the stats as a plain-text report suitable for a terminal
+func (bs BacklogStats) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "📊 Backlog Stats (%s)\n\n", bs.GeneratedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(&b, "Total work items: %d\n", bs.Total)
+	fmt.Fprintf(&b, "Average progress: %d%%\n", bs.AverageProgress)
+	fmt.Fprintf(&b, "Stale: %d\n", bs.Stale)
+	fmt.Fprintf(&b, "Overdue: %d\n", bs.Overdue)
+
+	b.WriteString("\nBy status:\n")
+	for _, status := range statsStatusOrder {
+		fmt.Fprintf(&b, "  %-22s %d\n", status, bs.ByStatus[status])
+	}
+
+	b.WriteString("\nBy type:\n")
+	for _, itemType := range statsTypeOrder {
+		fmt.Fprintf(&b, "  %-22s %d\n", itemType, bs.ByType[itemType])
+	}
+
+	return b.String()
+}
+
+// Prometheus renders the stats as gauges in the Prometheus text exposition format.
+// Every known status and type is emitted, including zero counts, so series never disappear.
+func (bs BacklogStats) Prometheus() string {
+	var b strings.Builder
+	writeGauge := func(name, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+	}
+
+	writeGauge("gopm_workitems", "Number of work items in the backlog by status.")
+	for _, status := range statsStatusOrder {
+		fmt.Fprintf(&b, "gopm_workitems{status=%q} %d\n", status, bs.ByStatus[status])
+	}
+
+	writeGauge("gopm_workitems_by_type", "Number of work items in the backlog by type.")
+	for _, itemType := range statsTypeOrder {
+		fmt.Fprintf(&b, "gopm_workitems_by_type{type=%q} %d\n", itemType, bs.ByType[itemType])
+	}
+
+	writeGauge("gopm_workitems_total", "Number of work items in the backlog.")
+	fmt.Fprintf(&b, "gopm_workitems_total %d\n", bs.Total)
+	writeGauge("gopm_average_progress_percent", "Mean progress percentage across all work items.")
+	fmt.Fprintf(&b, "gopm_average_progress_percent %d\n", bs.AverageProgress)
+	writeGauge("gopm_stale_total", "In-progress work items not updated within the phase timeout.")
+	fmt.Fprintf(&b, "gopm_stale_total %d\n", bs.Stale)
+	writeGauge("gopm_overdue_total", "Unfinished work items past their due date.")
+	fmt.Fprintf(&b, "gopm_overdue_total %d\n", bs.Overdue)
+
+	return b.String()
+}
+
+// UnassignedKey is the workload key for work items without an assignee
+const UnassignedKey = "(unassigned)"
+
+// AssigneeStats summarizes the open work assigned to one person or agent
+type AssigneeStats struct {
+	InProgress     int // Items in an IN_PROGRESS_* status
+	TotalTasks     int // Tasks across the assignee's open items
+	CompletedTasks int // Completed tasks across the assignee's open items
+	Overdue        int // Open items past their due date
+}
+
+// OpenTasks returns the number of tasks still to be done
+func (as AssigneeStats) OpenTasks() int {
+	return as.TotalTasks - as.CompletedTasks
+}
+
+// newAssigneeWorkload groups the unfinished work items by assignee.
+// Completed items carry no load and are skipped.
+func newAssigneeWorkload(items []WorkItem, now time.Time) map[string]AssigneeStats {
+	workload := make(map[string]AssigneeStats)
+	for _, item := range items {
+		if item.Status == StatusCompleted {
+			continue
+		}
+
+		assignee := strings.TrimSpace(item.AssignedTo)
+		if assignee == "" {
+			assignee = UnassignedKey
+		}
+
+		stats := workload[assignee]
+		if strings.HasPrefix(string(item.Status), "IN_PROGRESS_") {
+			stats.InProgress++
+		}
+		for _, task := range item.Tasks {
+			if task.NotApplicable {
+				continue
+			}
+			stats.TotalTasks++
+			if task.Completed {
+				stats.CompletedTasks++
+			}
+		}
+		if isOverdue(item, now) {
+			stats.Overdue++
+		}
+		workload[assignee] = stats
+	}
+	return workload
+}
+
+// AssigneesByLoad returns the assignees in a workload, busiest first: by
+// in-progress items, then open tasks, then name.
+func AssigneesByLoad(workload map[string]AssigneeStats) []string {
+	assignees := make([]string, 0, len(workload))
+	for assignee := range workload {
+		assignees = append(assignees, assignee)
+	}
+	slices.SortFunc(assignees, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(workload[b].InProgress, workload[a].InProgress),
+			cmp.Compare(workload[b].OpenTasks(), workload[a].OpenTasks()),
+			cmp.Compare(a, b),
+		)
+	})
+	return assignees
+}
+
+// WeeklyVelocity is the number of tasks completed in one week
+type WeeklyVelocity struct {
+	WeekStart time.Time `json:"week_start"` // Monday 00:00 local time starting the week
+	Completed int       `json:"completed"`  // Tasks completed during the week
+}
+
+// weekStart returns midnight on the Monday of t's week, in t's location
+func weekStart(t time.Time) time.Time {
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
+
+// Completion timeseries bucket sizes
+const (
+	BucketDay  = 24 * time.Hour
+	BucketWeek = 7 * BucketDay
+)
+
+// TimeBucket is the number of tasks completed in one bucket of a completion
+// timeseries, with the running total for burn-up charts
+type TimeBucket struct {
+	Start      time.Time `json:"start"`      // Midnight local time starting the bucket
+	Completed  int       `json:"completed"`  // Tasks completed during the bucket
+	Cumulative int       `json:"cumulative"` // Tasks completed up to the end of the bucket
+}
+
+// bucketStart returns the start of the bucket containing t: midnight for daily
+// buckets, and midnight on the Monday of t's week for longer ones
+func bucketStart(t time.Time, bucket time.Duration) time.Time {
+	if bucket >= BucketWeek {
+		return weekStart(t)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// newCompletionTimeseries counts completed tasks per bucket, from the bucket of
+// the earliest completion through now's bucket, oldest first. bucket must be a
+// whole number of days. Tasks without a completion date contribute nothing;
+// buckets without completions have a zero count.
+func newCompletionTimeseries(items []WorkItem, bucket time.Duration, now time.Time) []TimeBucket {
+	days := int(bucket / BucketDay)
+
+	var completions []time.Time
+	for _, item := range items {
+		for _, task := range item.Tasks {
+			if task.Completed && !task.CompletedAt.IsZero() {
+				completions = append(completions, task.CompletedAt.In(now.Location()))
+			}
+		}
+	}
+	if len(completions) == 0 {
+		return nil
+	}
+	slices.SortFunc(completions, func(a, b time.Time) int { return a.Compare(b) })
+
+	var series []TimeBucket
+	next := 0
+	cumulative := 0
+	for start := bucketStart(completions[0], bucket); !start.After(now); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days)
+		completed := 0
+		for next < len(completions) && completions[next].Before(end) {
+			completed++
+			next++
+		}
+		cumulative += completed
+		series = append(series, TimeBucket{Start: start, Completed: completed, Cumulative: cumulative})
+	}
+	return series
+}
+
+// newVelocity counts completed tasks per week for the weeks ending with now's
+// week, oldest first. Tasks without a completion date are not counted; weeks
+// without completions have a zero count.
+func newVelocity(items []WorkItem, weeks int, now time.Time) []WeeklyVelocity {
+	velocity := make([]WeeklyVelocity, weeks)
+	current := weekStart(now)
+	for i := range velocity {
+		velocity[i].WeekStart = current.AddDate(0, 0, -7*(weeks-1-i))
+	}
+
+	for _, item := range items {
+		for _, task := range item.Tasks {
+			if !task.Completed || task.CompletedAt.IsZero() {
+				continue
+			}
+			start := weekStart(task.CompletedAt.In(now.Location()))
+			for i := range velocity {
+				if velocity[i].WeekStart.Equal(start) {
+					velocity[i].Completed++
+					break
+				}
+			}
+		}
+	}
+	return velocity
+}
+
+// BurndownPoint is the number of tasks left at the end of one day of a burndown
+type BurndownPoint struct {
+	Date      time.Time `json:"date"`      // Midnight local time starting the day
+	Remaining int       `json:"remaining"` // Tasks still open at the end of the day
+	Ideal     float64   `json:"ideal"`     // Tasks that would be open on a steady pace to the target date
+}
+
+// Burndown tracks the open tasks of a set of work items day by day, alongside
+// the ideal line that burns them down at a steady pace
+type Burndown struct {
+	Items      []string        `json:"items"`       // Work items the burndown covers
+	TotalTasks int             `json:"total_tasks"` // Tasks plotted, open or completed with a date
+	Start      time.Time       `json:"start"`       // First day of the burndown
+	Target     time.Time       `json:"target"`      // Day the ideal line reaches zero
+	Points     []BurndownPoint `json:"points"`      // One point per day from Start through today
+}
+
+// newBurndown computes the burndown of items from the day of the earliest
+// creation or task completion through now. The ideal line reaches zero on the
+// latest due date among the items, or today when none has one. Not applicable
+// tasks, and completed tasks without a completion date, which can't be placed
+// on the chart, are left out.
+func newBurndown(items []WorkItem, now time.Time) Burndown {
+	day := func(t time.Time) time.Time {
+		t = t.In(now.Location())
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+	}
+	today := day(now)
+
+	burndown := Burndown{Items: make([]string, 0, len(items)), Start: today, Target: today}
+	var completions []time.Time
+	for _, item := range items {
+		burndown.Items = append(burndown.Items, item.Name)
+		if !item.CreatedAt.IsZero() && day(item.CreatedAt).Before(burndown.Start) {
+			burndown.Start = day(item.CreatedAt)
+		}
+		if !item.DueDate.IsZero() && day(item.DueDate).After(burndown.Target) {
+			burndown.Target = day(item.DueDate)
+		}
+		for _, task := range item.Tasks {
+			if task.NotApplicable {
+				continue
+			}
+			if !task.Completed {
+				burndown.TotalTasks++
+				continue
+			}
+			if task.CompletedAt.IsZero() {
+				continue
+			}
+			burndown.TotalTasks++
+			completed := day(task.CompletedAt)
+			completions = append(completions, completed)
+			if completed.Before(burndown.Start) {
+				burndown.Start = completed
+			}
+		}
+	}
+	slices.SortFunc(completions, func(a, b time.Time) int { return a.Compare(b) })
+
+	span := max(1, int(burndown.Target.Sub(burndown.Start).Round(BucketDay)/BucketDay))
+	remaining := burndown.TotalTasks
+	next := 0
+	for date, i := burndown.Start, 0; !date.After(today); date, i = date.AddDate(0, 0, 1), i+1 {
+		for next < len(completions) && !completions[next].After(date) {
+			remaining--
+			next++
+		}
+		ideal := max(0, float64(burndown.TotalTasks)*float64(span-i)/float64(span))
+		burndown.Points = append(burndown.Points, BurndownPoint{Date: date, Remaining: remaining, Ideal: ideal})
+	}
+	return burndown
+}
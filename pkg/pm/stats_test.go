@@ -0,0 +1,241 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBacklogStats(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	git := NewNoOpGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	for name, body := range map[string]string{
+		"feature-a": "# Feature: a\n\n## Status: PROPOSED\n## Progress: 0%\n",
+		"feature-b": "# Feature: b\n\n## Status: IN_PROGRESS_EXECUTION\n## Progress: 50%\n## Due Date: 2000-01-01\n",
+		"bug-c":     "# Bug: c\n\n## Status: COMPLETED\n## Progress: 100%\n",
+	} {
+		dir := filepath.Join(config.BacklogDir, name)
+		require.NoError(t, fs.CreateDirectory(dir))
+		require.NoError(t, fs.WriteFile(filepath.Join(dir, "README.md"), []byte(body)))
+	}
+
+	stats, err := manager.GetBacklogStats(context.Background(), ScopeBacklog)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Total)
+	assert.Equal(t, 2, stats.ByType[TypeFeature])
+	assert.Equal(t, 1, stats.ByType[TypeBug])
+	assert.Equal(t, 1, stats.ByStatus[StatusCompleted])
+	assert.Equal(t, 50, stats.AverageProgress)
+	assert.Equal(t, 1, stats.Overdue)
+}
+
+func TestBacklogStatsText(t *testing.T) {
+	stats := newBacklogStats(nil, 7, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, 0, stats.AverageProgress)
+
+	text := stats.Text()
+	assert.Contains(t, text, "Backlog Stats (2024-05-01 12:00:00)")
+	assert.Contains(t, text, "Total work items: 0")
+	assert.Contains(t, text, "PROPOSED")
+	assert.Contains(t, text, "experiment")
+}
+
+func TestBacklogStatsPrometheus(t *testing.T) {
+	stats := newBacklogStats([]WorkItem{
+		{Type: TypeFeature, Status: StatusInProgressExecution, Progress: 40, DueDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: TypeBug, Status: StatusInProgressExecution, Progress: 60},
+	}, 7, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	out := stats.Prometheus()
+	assert.Contains(t, out, "# TYPE gopm_workitems gauge\n")
+	assert.Contains(t, out, "gopm_workitems{status=\"IN_PROGRESS_EXECUTION\"} 2\n")
+	assert.Contains(t, out, "gopm_workitems{status=\"PROPOSED\"} 0\n")
+	assert.Contains(t, out, "gopm_workitems_by_type{type=\"bug\"} 1\n")
+	assert.Contains(t, out, "gopm_workitems_total 2\n")
+	assert.Contains(t, out, "gopm_average_progress_percent 50\n")
+	assert.Contains(t, out, "gopm_overdue_total 1\n")
+}
+
+func TestAssigneeWorkload(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	workload := newAssigneeWorkload([]WorkItem{
+		{AssignedTo: "alice", Status: StatusInProgressExecution, Tasks: []Task{{Completed: true}, {}}},
+		{AssignedTo: "bob", Status: StatusInProgressPlanning, Tasks: []Task{{}, {}, {}}},
+		{AssignedTo: "bob", Status: StatusProposed, DueDate: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{AssignedTo: "alice", Status: StatusCompleted, Tasks: []Task{{Completed: true}}},
+		{Status: StatusProposed, Tasks: []Task{{}}},
+	}, now)
+
+	assert.Equal(t, AssigneeStats{InProgress: 1, TotalTasks: 2, CompletedTasks: 1}, workload["alice"])
+	assert.Equal(t, AssigneeStats{InProgress: 1, TotalTasks: 3, Overdue: 1}, workload["bob"])
+	assert.Equal(t, AssigneeStats{TotalTasks: 1}, workload[UnassignedKey])
+
+	// Ties on in-progress items are broken by open tasks
+	assert.Equal(t, []string{"bob", "alice", UnassignedKey}, AssigneesByLoad(workload))
+}
+
+func TestVelocity(t *testing.T) {
+	// Wednesday; its week starts on Monday 2024-06-10
+	now := time.Date(2024, 6, 12, 15, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.Local) }
+
+	items := []WorkItem{
+		{Name: "feature-a", Tasks: []Task{
+			{Description: "this week", Completed: true, CompletedAt: day(10)},
+			{Description: "this week too", Completed: true, CompletedAt: day(12)},
+			{Description: "last week", Completed: true, CompletedAt: day(9)},
+			{Description: "no date", Completed: true},
+			{Description: "open", Completed: false},
+		}},
+		{Name: "feature-b", Status: StatusCompleted, Tasks: []Task{
+			{Description: "three weeks ago", Completed: true, CompletedAt: day(1)},
+			{Description: "too old", Completed: true, CompletedAt: time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local)},
+		}},
+	}
+
+	velocity := newVelocity(items, 4, now)
+	require.Len(t, velocity, 4)
+	assert.Equal(t, "2024-05-20", velocity[0].WeekStart.Format("2006-01-02"))
+	assert.Equal(t, "2024-06-10", velocity[3].WeekStart.Format("2006-01-02"))
+
+	var counts []int
+	for _, week := range velocity {
+		counts = append(counts, week.Completed)
+	}
+	assert.Equal(t, []int{0, 1, 1, 2}, counts)
+}
+
+func TestManagerGetVelocityValidatesWeeks(t *testing.T) {
+	config := DefaultConfig()
+	manager := NewDefaultManagerWithDeps(config, NewMockFileSystem(), NewNoOpGitClient())
+
+	_, err := manager.GetVelocity(context.Background(), 0)
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	velocity, err := manager.GetVelocity(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Len(t, velocity, 3, "an empty backlog still reports every week")
+}
+
+func TestCompletionTimeseries(t *testing.T) {
+	now := time.Date(2024, 6, 12, 15, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.Local) }
+
+	items := []WorkItem{
+		{Name: "feature-a", Tasks: []Task{
+			{Description: "first", Completed: true, CompletedAt: day(8)},
+			{Description: "same day", Completed: true, CompletedAt: day(8)},
+			{Description: "today", Completed: true, CompletedAt: day(12)},
+			{Description: "no date", Completed: true},
+			{Description: "open", Completed: false},
+		}},
+		{Name: "feature-b", Status: StatusCompleted, Tasks: []Task{
+			{Description: "archived", Completed: true, CompletedAt: day(10)},
+		}},
+	}
+
+	daily := newCompletionTimeseries(items, BucketDay, now)
+	require.Len(t, daily, 5)
+	assert.Equal(t, "2024-06-08", daily[0].Start.Format("2006-01-02"))
+	assert.Equal(t, "2024-06-12", daily[4].Start.Format("2006-01-02"))
+	var completed, cumulative []int
+	for _, bucket := range daily {
+		completed = append(completed, bucket.Completed)
+		cumulative = append(cumulative, bucket.Cumulative)
+	}
+	assert.Equal(t, []int{2, 0, 1, 0, 1}, completed)
+	assert.Equal(t, []int{2, 2, 3, 3, 4}, cumulative)
+
+	// Saturday the 8th falls in the week starting Monday the 3rd
+	weekly := newCompletionTimeseries(items, BucketWeek, now)
+	require.Len(t, weekly, 2)
+	assert.Equal(t, TimeBucket{Start: day(3), Completed: 2, Cumulative: 2}, weekly[0])
+	assert.Equal(t, TimeBucket{Start: day(10), Completed: 2, Cumulative: 4}, weekly[1])
+
+	assert.Empty(t, newCompletionTimeseries([]WorkItem{{Name: "feature-c"}}, BucketDay, now))
+}
+
+func TestManagerGetCompletionTimeseriesValidatesBucket(t *testing.T) {
+	config := DefaultConfig()
+	manager := NewDefaultManagerWithDeps(config, NewMockFileSystem(), NewNoOpGitClient())
+
+	var validationErr *ValidationError
+	_, err := manager.GetCompletionTimeseries(context.Background(), time.Hour)
+	assert.ErrorAs(t, err, &validationErr)
+	_, err = manager.GetCompletionTimeseries(context.Background(), 36*time.Hour)
+	assert.ErrorAs(t, err, &validationErr)
+
+	series, err := manager.GetCompletionTimeseries(context.Background(), BucketWeek)
+	require.NoError(t, err)
+	assert.Empty(t, series)
+}
+
+func TestBurndown(t *testing.T) {
+	now := time.Date(2024, 6, 12, 15, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.Local) }
+
+	items := []WorkItem{
+		{Name: "feature-a", CreatedAt: day(8), DueDate: day(16), Tasks: []Task{
+			{Description: "first", Completed: true, CompletedAt: day(9)},
+			{Description: "second", Completed: true, CompletedAt: day(11)},
+			{Description: "no date", Completed: true},
+			{Description: "open", Completed: false},
+		}},
+		{Name: "bug-b", CreatedAt: day(12), Tasks: []Task{
+			{Description: "same day", Completed: true, CompletedAt: day(11)},
+			{Description: "open", Completed: false},
+		}},
+	}
+
+	burndown := newBurndown(items, now)
+	assert.Equal(t, []string{"feature-a", "bug-b"}, burndown.Items)
+	assert.Equal(t, 5, burndown.TotalTasks, "completed tasks without a date are left out")
+	assert.Equal(t, day(8), burndown.Start)
+	assert.Equal(t, day(16), burndown.Target, "the ideal line ends on the latest due date")
+
+	require.Len(t, burndown.Points, 5)
+	var remaining []int
+	for _, point := range burndown.Points {
+		remaining = append(remaining, point.Remaining)
+	}
+	assert.Equal(t, []int{5, 4, 4, 2, 2}, remaining)
+	assert.Equal(t, 5.0, burndown.Points[0].Ideal)
+	assert.InDelta(t, 2.5, burndown.Points[4].Ideal, 0.001)
+
+	// Without due dates the ideal line reaches zero today
+	items[0].DueDate = time.Time{}
+	burndown = newBurndown(items, now)
+	assert.Equal(t, day(12), burndown.Target)
+	assert.Equal(t, 0.0, burndown.Points[4].Ideal)
+}
+
+func TestManagerBuildBurndown(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.BuildBurndown(ctx, nil)
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = manager.BuildBurndown(ctx, []string{"feature-missing"})
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	burndown, err := manager.BuildBurndown(ctx, []string{item.Name})
+	require.NoError(t, err)
+	assert.Equal(t, len(item.Tasks), burndown.TotalTasks)
+	require.NotEmpty(t, burndown.Points)
+	assert.Equal(t, burndown.TotalTasks, burndown.Points[len(burndown.Points)-1].Remaining)
+}
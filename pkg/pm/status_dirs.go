@@ -0,0 +1,90 @@
+package pm
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+)
+
+// statusDirNames are the backlog subdirectories work items are grouped into
+// when Config.StatusDirectories is enabled
+var statusDirNames = []string{"proposed", "in-progress", "review", "done"}
+
+// statusDirName returns the backlog subdirectory a work item in status lives
+// in when Config.StatusDirectories is enabled, or "" for an unknown status
+func statusDirName(status ItemStatus) string {
+	switch status {
+	case StatusProposed:
+		return "proposed"
+	case StatusInProgressDiscovery, StatusInProgressPlanning, StatusInProgressExecution, StatusInProgressCleanup:
+		return "in-progress"
+	case StatusInProgressReview:
+		return "review"
+	case StatusCompleted:
+		return "done"
+	}
+	return ""
+}
+
+// itemDir returns the backlog directory of the work item name. With
+// Config.StatusDirectories enabled the item may live in the flat backlog or
+// in any status subdirectory; the flat path is returned when it isn't found
+// anywhere so callers report it as missing.
+func (s *WorkItemService) itemDir(name string) string {
+	flat := filepath.Join(s.config.BacklogDir, name)
+	if !s.config.StatusDirectories || s.fs.DirectoryExists(flat) {
+		return flat
+	}
+	for _, group := range statusDirNames {
+		if dir := filepath.Join(s.config.BacklogDir, group, name); s.fs.DirectoryExists(dir) {
+			return dir
+		}
+	}
+	return flat
+}
+
+// backlogDirs returns the directories backlog work items are listed from: the
+// backlog itself plus its status subdirectories when Config.StatusDirectories
+// is enabled
+func (s *WorkItemService) backlogDirs() []string {
+	dirs := []string{s.config.BacklogDir}
+	if !s.config.StatusDirectories {
+		return dirs
+	}
+	for _, group := range statusDirNames {
+		dirs = append(dirs, filepath.Join(s.config.BacklogDir, group))
+	}
+	return dirs
+}
+
+// isStatusDir reports whether name, a directory directly under the backlog,
+// is a status subdirectory rather than a work item
+func (s *WorkItemService) isStatusDir(name string) bool {
+	return s.config.StatusDirectories && slices.Contains(statusDirNames, name)
+}
+
+// relocateByStatus moves the work item name into the status subdirectory for
+// status when Config.StatusDirectories is enabled. Items already in place, and
+// unknown statuses, are left where they are.
+func (s *WorkItemService) relocateByStatus(name string, status ItemStatus) error {
+	if !s.config.StatusDirectories {
+		return nil
+	}
+	group := statusDirName(status)
+	if group == "" {
+		return nil
+	}
+
+	source := s.itemDir(name)
+	dest := filepath.Join(s.config.BacklogDir, group, name)
+	if source == dest {
+		return nil
+	}
+	if err := s.fs.CreateDirectory(filepath.Dir(dest)); err != nil {
+		return fmt.Errorf("failed to create status directory: %w", err)
+	}
+	if err := s.fs.MoveDirectory(source, dest); err != nil {
+		return fmt.Errorf("failed to move work item to %s: %w", group, err)
+	}
+	return nil
+}
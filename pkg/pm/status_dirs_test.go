@@ -0,0 +1,69 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusDirectories(t *testing.T) {
+	config := DefaultConfig()
+	config.StatusDirectories = true
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	proposedDir := filepath.Join(config.BacklogDir, "proposed", "feature-login")
+	assert.Equal(t, filepath.Join(proposedDir, config.WorkItemFile), item.Path)
+	assert.True(t, fs.DirectoryExists(proposedDir))
+
+	// A status change moves the item along with its files
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-login", StatusInProgressExecution))
+	inProgressDir := filepath.Join(config.BacklogDir, "in-progress", "feature-login")
+	assert.False(t, fs.DirectoryExists(proposedDir))
+	assert.True(t, fs.FileExists(filepath.Join(inProgressDir, config.WorkItemFile)))
+
+	item, err = manager.GetWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressExecution, item.Status)
+
+	// Listing scans every status directory, which aren't work items themselves
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeBug, Name: "crash"})
+	require.NoError(t, err)
+	items, err := manager.ListWorkItems(ctx, ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.ElementsMatch(t, []string{"feature-login", "bug-crash"}, []string{items[0].Name, items[1].Name})
+	missing, err := manager.FindMissingReadmes(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	// Names stay unique across status directories
+	_, err = manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-login", StatusCompleted))
+	require.NoError(t, manager.ArchiveWorkItem(ctx, "feature-login"))
+	assert.True(t, fs.FileExists(filepath.Join(config.CompletedDir, "feature-login", config.WorkItemFile)))
+}
+
+func TestStatusDirectoriesDisabled(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-login", StatusInProgressReview))
+
+	flatDir := filepath.Join(config.BacklogDir, "feature-login")
+	assert.Equal(t, filepath.Join(flatDir, config.WorkItemFile), item.Path)
+	assert.True(t, fs.DirectoryExists(flatDir))
+}
@@ -13,7 +13,7 @@ func TestTemplateProcessing(t *testing.T) {
 	tp := NewTemplateProcessor(fs, config)
 
 	// Test feature template processing
-	err := tp.ProcessTemplate("/tmp/test-feature.md", "user-auth", TypeFeature)
+	err := tp.ProcessTemplate("/tmp/test-feature.md", "user-auth", TypeFeature, nil)
 	require.NoError(t, err)
 
 	content, err := fs.ReadFile("/tmp/test-feature.md")
@@ -27,7 +27,7 @@ func TestTemplateProcessingBug(t *testing.T) {
 	config := DefaultConfig()
 	tp := NewTemplateProcessor(fs, config)
 
-	err := tp.ProcessTemplate("/tmp/test-bug.md", "null-pointer", TypeBug)
+	err := tp.ProcessTemplate("/tmp/test-bug.md", "null-pointer", TypeBug, nil)
 	require.NoError(t, err)
 
 	content, err := fs.ReadFile("/tmp/test-bug.md")
@@ -40,7 +40,7 @@ func TestTemplateProcessingExperiment(t *testing.T) {
 	config := DefaultConfig()
 	tp := NewTemplateProcessor(fs, config)
 
-	err := tp.ProcessTemplate("/tmp/test-experiment.md", "ai-assistant", TypeExperiment)
+	err := tp.ProcessTemplate("/tmp/test-experiment.md", "ai-assistant", TypeExperiment, nil)
 	require.NoError(t, err)
 
 	content, err := fs.ReadFile("/tmp/test-experiment.md")
@@ -53,7 +53,62 @@ func TestTemplateProcessingInvalidType(t *testing.T) {
 	config := DefaultConfig()
 	tp := NewTemplateProcessor(fs, config)
 
-	err := tp.ProcessTemplate("/tmp/test-invalid.md", "test", ItemType("invalid"))
+	err := tp.ProcessTemplate("/tmp/test-invalid.md", "test", ItemType("invalid"), nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unsupported item type")
 }
+
+func TestResolveTemplate(t *testing.T) {
+	tp := NewTemplateProcessor(NewMockFileSystem(), DefaultConfig())
+
+	for _, itemType := range TemplateTypes() {
+		source, err := tp.ResolveTemplate(itemType)
+		require.NoError(t, err)
+		assert.True(t, source.Embedded)
+		assert.Equal(t, "templates/workitem-"+string(itemType)+".md", source.Path)
+		assert.Contains(t, source.Content, "{{name}}")
+	}
+
+	_, err := tp.ResolveTemplate(ItemType("invalid"))
+	assert.Error(t, err)
+}
+
+func TestResolveTemplateFromTemplatesDir(t *testing.T) {
+	fs := NewMockFileSystem()
+	config := DefaultConfig()
+	config.TemplatesDir = "/repo/.pm/templates"
+	require.NoError(t, fs.WriteFile(config.TemplatesDir+"/workitem-feature.md", []byte(
+		"# Feature: {{name}}\n\n## Status: PROPOSED\n\n## RFC Checklist\n- [ ] Security review\n")))
+	tp := NewTemplateProcessor(fs, config)
+
+	source, err := tp.ResolveTemplate(TypeFeature)
+	require.NoError(t, err)
+	assert.False(t, source.Embedded)
+	assert.Equal(t, config.TemplatesDir+"/workitem-feature.md", source.Path)
+
+	require.NoError(t, tp.ProcessTemplate("/backlog/feature-sso/README.md", "sso", TypeFeature, nil))
+	content, err := fs.ReadFile("/backlog/feature-sso/README.md")
+	require.NoError(t, err)
+	assert.Equal(t, "# Feature: sso\n\n## Status: PROPOSED\n\n## RFC Checklist\n- [ ] Security review\n", string(content))
+
+	// Types without a custom template fall back to the embedded one
+	source, err = tp.ResolveTemplate(TypeBug)
+	require.NoError(t, err)
+	assert.True(t, source.Embedded)
+	assert.Equal(t, embeddedTemplateWorkItemBug, source.Content)
+}
+
+func TestRenderTemplateVars(t *testing.T) {
+	tp := NewTemplateProcessor(NewMockFileSystem(), DefaultConfig())
+
+	content, err := tp.RenderTemplate("user-auth", TypeFeature, map[string]string{"name": "other", "team": "platform"})
+	require.NoError(t, err)
+	assert.Contains(t, content, "Feature: user-auth", "vars cannot override built-in placeholders")
+	assert.Empty(t, UnreplacedPlaceholders(content))
+}
+
+func TestUnreplacedPlaceholders(t *testing.T) {
+	content := "Team: {{team}}\nEpic: {{epic}}\nOwner: {{team}}\nNot a placeholder: {{ spaced }}"
+	assert.Equal(t, []string{"team", "epic"}, UnreplacedPlaceholders(content))
+	assert.Empty(t, UnreplacedPlaceholders("no placeholders"))
+}
@@ -1,12 +1,19 @@
 package pm
 
 import (
+	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 )
 
-// MockFileSystem is a mock implementation of FileSystem for testing
+// MockFileSystem is a mock implementation of FileSystem for testing. It is
+// safe for concurrent use, like the OS file system it stands in for.
 type MockFileSystem struct {
+	mu    sync.RWMutex
 	files map[string][]byte
 	dirs  map[string]bool
 }
@@ -19,6 +26,8 @@ func NewMockFileSystem() *MockFileSystem {
 }
 
 func (fs *MockFileSystem) ReadFile(path string) ([]byte, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 	if content, exists := fs.files[path]; exists {
 		return content, nil
 	}
@@ -26,25 +35,35 @@ func (fs *MockFileSystem) ReadFile(path string) ([]byte, error) {
 }
 
 func (fs *MockFileSystem) WriteFile(path string, content []byte) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 	fs.files[path] = content
 	return nil
 }
 
 func (fs *MockFileSystem) FileExists(path string) bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 	_, exists := fs.files[path]
 	return exists
 }
 
 func (fs *MockFileSystem) DirectoryExists(path string) bool {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 	return fs.dirs[path]
 }
 
 func (fs *MockFileSystem) CreateDirectory(path string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 	fs.dirs[path] = true
 	return nil
 }
 
 func (fs *MockFileSystem) ListDirectories(path string) ([]string, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 	var dirs []string
 	for dir := range fs.dirs {
 		if strings.HasPrefix(dir, path+"/") || dir == path {
@@ -61,6 +80,8 @@ func (fs *MockFileSystem) ListDirectories(path string) ([]string, error) {
 }
 
 func (fs *MockFileSystem) CopyFile(src, dst string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 	if content, exists := fs.files[src]; exists {
 		fs.files[dst] = content
 		return nil
@@ -69,18 +90,181 @@ func (fs *MockFileSystem) CopyFile(src, dst string) error {
 }
 
 func (fs *MockFileSystem) ListFiles(path string) ([]string, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 	var files []string
 	for file := range fs.files {
-		if strings.HasPrefix(file, path) {
-			files = append(files, file)
+		// Like the OS file system, list only the names of direct children
+		if name, ok := strings.CutPrefix(file, path+"/"); ok && !strings.Contains(name, "/") {
+			files = append(files, name)
 		}
 	}
 	return files, nil
 }
 
 func (fs *MockFileSystem) MoveDirectory(src, dst string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 	// Mark destination as existing and remove source
 	fs.dirs[dst] = true
 	delete(fs.dirs, src)
+
+	// Move the files inside the directory along with it
+	for path, content := range fs.files {
+		if rel, ok := strings.CutPrefix(path, src+"/"); ok {
+			fs.files[dst+"/"+rel] = content
+			delete(fs.files, path)
+		}
+	}
 	return nil
 }
+
+func (fs *MockFileSystem) RemoveDirectory(path string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for dir := range fs.dirs {
+		if dir == path || strings.HasPrefix(dir, path+"/") {
+			delete(fs.dirs, dir)
+		}
+	}
+	for file := range fs.files {
+		if strings.HasPrefix(file, path+"/") {
+			delete(fs.files, file)
+		}
+	}
+	return nil
+}
+
+func (fs *MockFileSystem) CheckWritable(path string) error {
+	return nil
+}
+
+// MockGitClient is a mock implementation of GitClient that records created
+// branches and serves files added with AddFileAtRef
+type MockGitClient struct {
+	branches []string
+	refFiles map[string]map[string][]byte
+	refDates map[string]time.Time
+	// commits are the messages CommitChanges was called with, in order
+	commits []string
+	// commitPaths are the paths of each of commits
+	commitPaths [][]string
+	// commitErr, when set, makes CommitChanges fail
+	commitErr error
+}
+
+func NewMockGitClient() *MockGitClient {
+	return &MockGitClient{}
+}
+
+func (gc *MockGitClient) CreateBranch(branchName string) error {
+	gc.branches = append(gc.branches, branchName)
+	return nil
+}
+
+func (gc *MockGitClient) BranchExists(branchName string) bool {
+	for _, branch := range gc.branches {
+		if branch == branchName {
+			return true
+		}
+	}
+	return false
+}
+
+func (gc *MockGitClient) DeleteBranch(branchName string) error {
+	i := slices.Index(gc.branches, branchName)
+	if i < 0 {
+		return fmt.Errorf("branch '%s' not found", branchName)
+	}
+	gc.branches = slices.Delete(gc.branches, i, i+1)
+	return nil
+}
+
+func (gc *MockGitClient) RenameBranch(oldName, newName string) error {
+	i := slices.Index(gc.branches, oldName)
+	if i < 0 {
+		return fmt.Errorf("branch '%s' not found", oldName)
+	}
+	if slices.Contains(gc.branches, newName) {
+		return fmt.Errorf("branch '%s' already exists", newName)
+	}
+	gc.branches[i] = newName
+	return nil
+}
+
+func (gc *MockGitClient) GetCurrentBranch() (string, error) {
+	if len(gc.branches) == 0 {
+		return "main", nil
+	}
+	return gc.branches[len(gc.branches)-1], nil
+}
+
+func (gc *MockGitClient) GetGitUserName() (string, error) {
+	return "test-user", nil
+}
+
+func (gc *MockGitClient) CommitChanges(paths []string, message string) error {
+	if gc.commitErr != nil {
+		return gc.commitErr
+	}
+	gc.commits = append(gc.commits, message)
+	gc.commitPaths = append(gc.commitPaths, paths)
+	return nil
+}
+
+// SetRefDate makes ref resolve to date
+func (gc *MockGitClient) SetRefDate(ref string, date time.Time) {
+	if gc.refDates == nil {
+		gc.refDates = make(map[string]time.Time)
+	}
+	gc.refDates[ref] = date
+}
+
+func (gc *MockGitClient) GetRefDate(ref string) (time.Time, error) {
+	date, ok := gc.refDates[ref]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown revision '%s'", ref)
+	}
+	return date, nil
+}
+
+// AddFileAtRef makes path read back with content at ref
+func (gc *MockGitClient) AddFileAtRef(ref, path string, content []byte) {
+	if gc.refFiles == nil {
+		gc.refFiles = make(map[string]map[string][]byte)
+	}
+	if gc.refFiles[ref] == nil {
+		gc.refFiles[ref] = make(map[string][]byte)
+	}
+	gc.refFiles[ref][path] = content
+}
+
+func (gc *MockGitClient) ReadFileAtRef(path, ref string) ([]byte, error) {
+	files, ok := gc.refFiles[ref]
+	if !ok {
+		return nil, fmt.Errorf("invalid object name '%s'", ref)
+	}
+	if content, ok := files[path]; ok {
+		return content, nil
+	}
+
+	// Like git, list a directory's entries as a tree
+	entries := map[string]bool{}
+	for file := range files {
+		if rest, ok := strings.CutPrefix(file, path+"/"); ok {
+			entry, _, isDir := strings.Cut(rest, "/")
+			if isDir {
+				entry += "/"
+			}
+			entries[entry] = true
+		}
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("path '%s' does not exist in '%s'", path, ref)
+	}
+	listing := fmt.Sprintf("tree %s:%s\n\n", ref, path)
+	for _, entry := range slices.Sorted(maps.Keys(entries)) {
+		listing += entry + "\n"
+	}
+	return []byte(listing), nil
+}
@@ -0,0 +1,164 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// trashTimeFormat is the timestamp appended to trashed work item directories.
+// It sorts chronologically and contains no "." so it splits off unambiguously.
+const trashTimeFormat = "20060102T150405Z"
+
+// TrashedItem is a work item moved to the trash by TrashWorkItem
+type TrashedItem struct {
+	Name      string    // Work item name, as it was in the backlog
+	TrashedAt time.Time // When the work item was trashed
+	Path      string    // Directory of the trashed work item
+}
+
+// trashDir returns the directory trashed work items are kept in: ".trash" next
+// to the backlog directory, outside every listing scope
+func (s *WorkItemService) trashDir() string {
+	return filepath.Join(filepath.Dir(s.config.BacklogDir), ".trash")
+}
+
+// TrashWorkItem moves a backlog work item into the trash instead of deleting
+// it. The directory is kept under a timestamped name so the same name can be
+// trashed more than once, and it no longer appears in any listing. It returns
+// the trashed item's directory.
+//
+// Example:
+//
+//	dir, err := service.TrashWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Moved to %s\n", dir)
+func (s *WorkItemService) TrashWorkItem(ctx context.Context, name string) (string, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return "", err
+	}
+	source := s.itemDir(name)
+	if !s.fs.DirectoryExists(source) {
+		return "", &WorkItemError{Op: "trash", Name: name, Err: ErrWorkItemNotFound}
+	}
+
+	if err := s.fs.CreateDirectory(s.trashDir()); err != nil {
+		return "", &WorkItemError{Op: "trash", Name: name, Err: fmt.Errorf("failed to create trash directory: %w", err)}
+	}
+	dest := filepath.Join(s.trashDir(), name+"."+time.Now().UTC().Format(trashTimeFormat))
+	if err := s.fs.MoveDirectory(source, dest); err != nil {
+		return "", &WorkItemError{Op: "trash", Name: name, Err: fmt.Errorf("failed to move work item to trash: %w", err)}
+	}
+	return dest, nil
+}
+
+// ListTrash returns the trashed work items, most recently trashed first
+func (s *WorkItemService) ListTrash(ctx context.Context) ([]TrashedItem, error) {
+	if !s.fs.DirectoryExists(s.trashDir()) {
+		return nil, nil
+	}
+	dirs, err := s.fs.ListDirectories(s.trashDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	var trashed []TrashedItem
+	for _, dir := range dirs {
+		i := strings.LastIndex(dir, ".")
+		if i <= 0 {
+			continue
+		}
+		trashedAt, err := time.Parse(trashTimeFormat, dir[i+1:])
+		if err != nil {
+			continue
+		}
+		trashed = append(trashed, TrashedItem{Name: dir[:i], TrashedAt: trashedAt, Path: filepath.Join(s.trashDir(), dir)})
+	}
+
+	sort.SliceStable(trashed, func(i, j int) bool {
+		return trashed[i].TrashedAt.After(trashed[j].TrashedAt)
+	})
+	return trashed, nil
+}
+
+// RestoreFromTrash moves the most recently trashed work item called name back
+// into the backlog. It fails with a ValidationError if a work item with that
+// name exists in the backlog again.
+//
+// Example:
+//
+//	item, err := service.RestoreFromTrash(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Restored %s (%s)\n", item.Name, item.Status)
+func (s *WorkItemService) RestoreFromTrash(ctx context.Context, name string) (*WorkItem, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	trashed, err := s.ListTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var source string
+	for _, item := range trashed {
+		if item.Name == name {
+			source = item.Path
+			break
+		}
+	}
+	if source == "" {
+		return nil, &WorkItemError{Op: "restore", Name: name, Err: fmt.Errorf("%w in trash", ErrWorkItemNotFound)}
+	}
+	if s.fs.DirectoryExists(s.itemDir(name)) {
+		return nil, &ValidationError{Field: "name", Value: name, Message: "work item already exists in the backlog"}
+	}
+
+	if err := s.fs.CreateDirectory(s.config.BacklogDir); err != nil {
+		return nil, &WorkItemError{Op: "restore", Name: name, Err: fmt.Errorf("failed to create backlog directory: %w", err)}
+	}
+	if err := s.fs.MoveDirectory(source, filepath.Join(s.config.BacklogDir, name)); err != nil {
+		return nil, &WorkItemError{Op: "restore", Name: name, Err: fmt.Errorf("failed to move work item out of trash: %w", err)}
+	}
+
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.relocateByStatus(name, item.Status); err != nil {
+		return nil, &WorkItemError{Op: "restore", Name: name, Err: err}
+	}
+	item.Path = filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	return item, nil
+}
+
+// EmptyTrash permanently deletes every trashed work item and returns the
+// names of the deleted items
+func (s *WorkItemService) EmptyTrash(ctx context.Context) ([]string, error) {
+	trashed, err := s.ListTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	for _, item := range trashed {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		if err := s.fs.RemoveDirectory(item.Path); err != nil {
+			return deleted, &WorkItemError{Op: "empty_trash", Name: item.Name, Err: fmt.Errorf("failed to delete trashed work item: %w", err)}
+		}
+		deleted = append(deleted, item.Name)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,57 @@
+package pm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashWorkItem(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	require.NoError(t, fs.CreateDirectory(config.BacklogDir))
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-login", StatusInProgressPlanning))
+
+	dir, err := manager.TrashWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(filepath.Dir(config.BacklogDir), ".trash"), filepath.Dir(dir))
+	assert.True(t, fs.FileExists(filepath.Join(dir, config.WorkItemFile)))
+
+	// Trashed items are out of every listing
+	items, err := manager.ListWorkItems(ctx, ListFilter{Scope: ScopeAll})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	trashed, err := manager.ListTrash(ctx)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, "feature-login", trashed[0].Name)
+
+	_, err = manager.TrashWorkItem(ctx, "feature-login")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+
+	// Restoring brings the item back as it was
+	item, err := manager.RestoreFromTrash(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Equal(t, StatusInProgressPlanning, item.Status)
+	assert.Equal(t, filepath.Join(config.BacklogDir, "feature-login", config.WorkItemFile), item.Path)
+	_, err = manager.RestoreFromTrash(ctx, "feature-login")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+
+	_, err = manager.TrashWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	deleted, err := manager.EmptyTrash(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-login"}, deleted)
+	trashed, err = manager.ListTrash(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, trashed)
+	assert.Empty(t, fs.files)
+}
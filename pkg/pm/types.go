@@ -4,9 +4,15 @@ package pm
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/spf13/viper"
@@ -15,42 +21,177 @@ import (
 // Global viper instance for configuration
 var configViper *viper.Viper
 
-// initializeViper sets up viper configuration
-func initializeViper() {
+// repoDir is where config loading and repository root detection start from;
+// the working directory unless changed with UseRepo
+var repoDir = "."
+
+// loadConfigViper creates a viper instance with the config file, environment
+// variables and defaults loaded, and overrides applied on top
+func loadConfigViper(overrides ConfigOverrides) *viper.Viper {
+	v := viper.New()
+
 	// Set config file name and paths
-	configViper.SetConfigName("config") // name of config file (without extension)
-	configViper.AddConfigPath(".")      // look for config in the working directory
-	configViper.AddConfigPath("$HOME")  // look for config in home directory
-
-	// Set default values
-	configViper.SetDefault("auto_detect_repo_root", true)
-	configViper.SetDefault("backlog_dir", "work-items/backlog")
-	configViper.SetDefault("completed_dir", "work-items/completed")
-	configViper.SetDefault("phase_timeout_days", 7)
-	configViper.SetDefault("enable_git", false)
-
-	// Bind environment variables (these override config file values)
-	_ = configViper.BindEnv("auto_detect_repo_root", "PM_AUTO_DETECT_REPO_ROOT")
-	_ = configViper.BindEnv("backlog_dir", "PM_BACKLOG_DIR")
-	_ = configViper.BindEnv("completed_dir", "PM_COMPLETED_DIR")
-	_ = configViper.BindEnv("phase_timeout_days", "PM_PHASE_TIMEOUT_DAYS")
-	_ = configViper.BindEnv("enable_git", "PM_ENABLE_GIT")
+	v.SetConfigName("config") // name of config file (without extension)
+	v.AddConfigPath(repoDir)  // look for config in the working directory
+	if repoRoot, ok := configRepoRoot(overrides); ok {
+		v.AddConfigPath(repoRoot) // then at the repository root, as used for work item directories
+	}
+	v.AddConfigPath("$HOME") // look for config in home directory
+
+	// Set default values and bind environment variables (these override config file values)
+	for _, setting := range configSettings {
+		v.SetDefault(setting.Key, setting.Default)
+		_ = v.BindEnv(setting.Key, setting.Env)
+	}
+
+	// Explicit overrides take precedence over environment variables and the config file
+	for key, value := range overrides.settings() {
+		v.Set(key, value)
+	}
 
 	// Read config file (ignore error if file doesn't exist)
-	_ = configViper.ReadInConfig()
+	_ = v.ReadInConfig()
+	return v
+}
+
+// configSetting is a config key with its environment variable and default value
+type configSetting struct {
+	Key     string
+	Env     string
+	Default any
+}
+
+// configSettings lists every config key, in the order they are documented
+var configSettings = []configSetting{
+	{"auto_detect_repo_root", "PM_AUTO_DETECT_REPO_ROOT", true},
+	{"backlog_dir", "PM_BACKLOG_DIR", "work-items/backlog"},
+	{"completed_dir", "PM_COMPLETED_DIR", "work-items/completed"},
+	{"phase_timeout_days", "PM_PHASE_TIMEOUT_DAYS", 7},
+	{"enable_git", "PM_ENABLE_GIT", false},
+	{"branch_per_phase", "PM_BRANCH_PER_PHASE", false},
+	{"auto_commit", "PM_AUTO_COMMIT", false},
+	{"branch_prefix", "PM_BRANCH_PREFIX", ""},
+	{"branch_separator", "PM_BRANCH_SEPARATOR", DefaultBranchSeparator},
+	{"webhook_url", "PM_WEBHOOK_URL", ""},
+	{"work_item_file", "PM_WORK_ITEM_FILE", DefaultWorkItemFile},
+	{"reviewer", "PM_REVIEWER", ""},
+	{"api_token", "PM_API_TOKEN", ""},
+	{"github_token", "PM_GITHUB_TOKEN", ""},
+	{"default_assignee_by_type", "PM_DEFAULT_ASSIGNEE_BY_TYPE", map[string]string{}},
+	{"phase_advance_strict", "PM_PHASE_ADVANCE_STRICT", true},
+	{"phase_requirements", "PM_PHASE_REQUIREMENTS", map[string]any{}},
+	{"postmortem_template", "PM_POSTMORTEM_TEMPLATE", ""},
+	{"templates_dir", "PM_TEMPLATES_DIR", ""},
+	{"warn_phase_mismatch", "PM_WARN_PHASE_MISMATCH", false},
+	{"status_directories", "PM_STATUS_DIRECTORIES", false},
+	{"list_concurrency", "PM_LIST_CONCURRENCY", runtime.NumCPU()},
+	{"progress_mismatch_threshold", "PM_PROGRESS_MISMATCH_THRESHOLD", 20},
+}
+
+// configRepoRoot returns the repository root to search for a config file.
+// The search is skipped when an override or PM_AUTO_DETECT_REPO_ROOT disables
+// auto-detection, since config files haven't been read yet at this point.
+func configRepoRoot(overrides ConfigOverrides) (string, bool) {
+	if autoDetect := overrides.AutoDetectRepoRoot; autoDetect != nil {
+		if !*autoDetect {
+			return "", false
+		}
+	} else if autoDetect, err := strconv.ParseBool(os.Getenv("PM_AUTO_DETECT_REPO_ROOT")); err == nil && !autoDetect {
+		return "", false
+	}
+
+	repoRoot := detectRepoRoot()
+	if repoRoot == repoDir {
+		return "", false
+	}
+	return repoRoot, true
+}
+
+// UseRepo makes config loading and repository root detection start from dir
+// instead of the working directory, as if go-pm were run from dir. With
+// auto_detect_repo_root the base directory is the git root containing dir;
+// otherwise it is dir itself. It reloads the configuration, so call it before
+// DefaultConfig.
+func UseRepo(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("invalid repository path %s: not a directory", dir)
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	repoDir = absDir
+	configViper = loadConfigViper(ConfigOverrides{})
+	return nil
+}
+
+// ConfigOverrides holds explicitly chosen settings, such as parsed command-line
+// flags, that take precedence over environment variables, the config file and
+// defaults. Unset fields (nil or "") leave the setting as loaded.
+type ConfigOverrides struct {
+	// BaseDir makes config loading and repository root detection start from
+	// this directory, as UseRepo does
+	BaseDir            string
+	AutoDetectRepoRoot *bool
+	EnableGit          *bool
+	ListConcurrency    *int
+}
+
+// settings returns the config keys the overrides set, with their values
+func (o ConfigOverrides) settings() map[string]any {
+	settings := make(map[string]any)
+	if o.AutoDetectRepoRoot != nil {
+		settings["auto_detect_repo_root"] = *o.AutoDetectRepoRoot
+	}
+	if o.EnableGit != nil {
+		settings["enable_git"] = *o.EnableGit
+	}
+	if o.ListConcurrency != nil {
+		settings["list_concurrency"] = *o.ListConcurrency
+	}
+	return settings
+}
+
+// DefaultConfigWithOverrides loads the configuration with overrides applied on
+// top and returns it like DefaultConfig: an override beats an environment
+// variable, which beats the config file, which beats the default. The
+// overrides only apply to the returned Config (and its EffectiveConfig); later
+// DefaultConfig calls don't see them. A BaseDir is applied with UseRepo, so it
+// stays in effect like any UseRepo call.
+//
+// Example:
+//
+//	enableGit := true
+//	config, err := DefaultConfigWithOverrides(ConfigOverrides{BaseDir: "../other-repo", EnableGit: &enableGit})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	manager := NewDefaultManager(config)
+func DefaultConfigWithOverrides(overrides ConfigOverrides) (Config, error) {
+	if overrides.BaseDir != "" {
+		if err := UseRepo(overrides.BaseDir); err != nil {
+			return Config{}, err
+		}
+	}
+	config := configFromViper(loadConfigViper(overrides))
+	config.overrides = overrides
+	return config, nil
 }
 
 // init initializes the global viper configuration
 func init() {
-	configViper = viper.New()
-	initializeViper()
+	configViper = loadConfigViper(ConfigOverrides{})
 }
 
 // reloadConfigForTesting reloads the configuration (used for testing)
 func reloadConfigForTesting() {
-	// Reset viper instance
-	configViper = viper.New()
-	initializeViper()
+	configViper = loadConfigViper(ConfigOverrides{})
 }
 
 // ItemType represents the type of work item
@@ -85,54 +226,205 @@ const (
 	PhaseCleanup   WorkPhase = "cleanup"
 )
 
+// Priority represents the triage priority of a work item
+type Priority string
+
+const (
+	PriorityLow      Priority = "LOW"
+	PriorityMedium   Priority = "MEDIUM"
+	PriorityHigh     Priority = "HIGH"
+	PriorityCritical Priority = "CRITICAL"
+)
+
+// Outcome is the conclusion recorded for an experiment
+type Outcome string
+
+const (
+	OutcomeSucceeded    Outcome = "succeeded"
+	OutcomeFailed       Outcome = "failed"
+	OutcomeInconclusive Outcome = "inconclusive"
+)
+
 // Task represents a phase-specific task
 type Task struct {
-	Description string
-	Completed   bool
-	Phase       WorkPhase
-	AssignedTo  string // "human" or "agent"
+	Description   string        `json:"description"`
+	Completed     bool          `json:"completed"`
+	NotApplicable bool          `json:"not_applicable,omitempty"` // Checked off as "- [~]": resolved for advancing, but not completed
+	Phase         WorkPhase     `json:"phase"`
+	AssignedTo    string        `json:"assigned_to,omitempty"` // From an inline "(@name)" annotation, else the work item assignee
+	Group         string        `json:"group,omitempty"`       // "###" subheading the task is listed under ("" for the default "### Tasks" list)
+	DueDate       time.Time     `json:"due_date,omitzero"`     // From an inline "(due: YYYY-MM-DD)" annotation (zero if none)
+	CompletedAt   time.Time     `json:"completed_at,omitzero"` // From the "(done: YYYY-MM-DD)" annotation CompleteTask adds (zero if none)
+	Estimate      time.Duration `json:"estimate,omitempty"`    // From an inline "(estimate: 2h)" annotation (zero if none)
+}
+
+// Resolved reports whether the task no longer blocks advancing its phase:
+// it is either completed or not applicable
+func (t Task) Resolved() bool {
+	return t.Completed || t.NotApplicable
 }
 
 // WorkItem represents a project management work item with its metadata
 type WorkItem struct {
 	// Name is the directory name (e.g., "feature-auth")
-	Name string
+	Name string `json:"name"`
 	// Title is the human-readable title extracted from the README
-	Title string
+	Title string `json:"title"`
+	// Summary is the first paragraph of the "## Overview" section ("" if none)
+	Summary string `json:"summary,omitempty"`
 	// Type is the work item type (feature, bug, experiment)
-	Type ItemType
+	Type ItemType `json:"type"`
 	// Status is the current workflow status
-	Status ItemStatus
+	Status ItemStatus `json:"status"`
 	// Phase is the current work phase
-	Phase WorkPhase
+	Phase WorkPhase `json:"phase"`
 	// Progress is the completion percentage (0-100)
-	Progress int
+	Progress int `json:"progress"`
+	// ProgressMismatch is set when Progress differs from task completion by
+	// more than Config.ProgressMismatchThreshold points
+	ProgressMismatch bool `json:"progress_mismatch,omitempty"`
 	// AssignedTo is the current assignee ("human", "agent", or specific agent ID)
-	AssignedTo string
+	AssignedTo string `json:"assigned_to,omitempty"`
+	// Priority is the triage priority (empty if no "## Priority:" is set)
+	Priority Priority `json:"priority,omitempty"`
+	// EstimatedHours is the total effort estimate from "## Estimate:", in
+	// hours (0 if none is set)
+	EstimatedHours float64 `json:"estimated_hours,omitempty"`
+	// CreatedBy is the author recorded at creation (empty for older items)
+	CreatedBy string `json:"created_by,omitempty"`
+	// Labels are free-form labels parsed from "## Labels:"
+	Labels []string `json:"labels"`
+	// DependsOn are the work items, parsed from "## Depends On:", that must be
+	// COMPLETED before this one can start
+	DependsOn []string `json:"depends_on"`
+	// Outcome is an experiment's conclusion from "## Outcome:" (empty until concluded)
+	Outcome Outcome `json:"outcome,omitempty"`
 	// Path is the full path to the work item directory
-	Path string
+	Path string `json:"path"`
 	// CreatedAt is when the work item was created
-	CreatedAt time.Time
+	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is when the work item was last updated
-	UpdatedAt time.Time
+	UpdatedAt time.Time `json:"updated_at"`
+	// DueDate is when the work item is due (zero if no "## Due Date:" is set)
+	DueDate time.Time `json:"due_date,omitzero"`
+	// IssueURL is the GitHub issue the work item mirrors, from "## Issue:" (empty if none)
+	IssueURL string `json:"issue_url,omitempty"`
+	// SchemaVersion is the README format version from "## Schema Version:" (1 if absent)
+	SchemaVersion int `json:"schema_version"`
+	// RelatedItems are links to other work items listed under "## Related Items"
+	RelatedItems []RelatedItem `json:"related_items"`
+	// References are external links (design docs, tickets, dashboards) listed
+	// under "## References"
+	References []Reference `json:"references"`
+	// PhaseHistory are the phase transitions recorded under "## Phase History"
+	// by AdvancePhase and SetPhase, oldest first. Each lasts until the next;
+	// the last lasts until now, or until the item was last updated once
+	// COMPLETED
+	PhaseHistory []PhaseTransition `json:"phase_history"`
+	// Archived is set on work items listed from Config.CompletedDir
+	Archived bool `json:"archived,omitempty"`
+	// Metadata holds custom fields (sprint, cost center, ...) listed as
+	// "- key: value" lines under "## Metadata"
+	Metadata map[string]string `json:"metadata,omitempty"`
 	// Tasks are the phase-specific task checklists
-	Tasks []Task
+	Tasks []Task `json:"tasks"`
 }
 
+// Relation describes how a work item relates to another
+type Relation string
+
+const (
+	// RelationChildOf links a work item to the item it was split from
+	RelationChildOf Relation = "child-of"
+	// RelationParentOf links a work item to an item split from it
+	RelationParentOf Relation = "parent-of"
+	// RelationGraduatedFrom links a feature to the experiment it graduated from
+	RelationGraduatedFrom Relation = "graduated-from"
+	// RelationGraduatedTo links a succeeded experiment to the feature it became
+	RelationGraduatedTo Relation = "graduated-to"
+)
+
+// RelatedItem is a link from a work item to another, written as
+// "- <relation>: <name>" under the "## Related Items" heading
+type RelatedItem struct {
+	Relation Relation `json:"relation"` // How this item relates to Name
+	Name     string   `json:"name"`     // Directory name of the related work item
+}
+
+// Reference is an external link from a work item, written as a
+// "- [label](url)" bullet under the README's "## References" heading
+type Reference struct {
+	Label string `json:"label"` // Link text, e.g. "Design doc"
+	URL   string `json:"url"`   // Absolute http(s) URL
+}
+
+// TrackingLabel marks a work item whose scope was split into child items
+const TrackingLabel = "tracking"
+
 // CreateRequest contains the parameters for creating a new work item
 type CreateRequest struct {
 	// Type is the work item type to create
 	Type ItemType
 	// Name is the work item name (without type prefix)
 	Name string
+	// Title overrides the template title (optional, defaults to Name)
+	Title string
+	// Priority sets the initial priority (optional)
+	Priority Priority
+	// Labels sets the initial labels (optional)
+	Labels []string
+	// Assignee overrides the template assignee (optional)
+	Assignee string
+	// TemplateVars replaces custom "{{key}}" template placeholders after the
+	// built-in ones (optional)
+	TemplateVars map[string]string
+	// InitialStatus starts the work item in this status instead of PROPOSED,
+	// e.g. when importing work that is already underway (optional)
+	InitialStatus ItemStatus
+	// InitialPhase starts the work item in this phase instead of discovery; it
+	// must match the status, and defaults to the phase the status is worked in
+	// (optional)
+	InitialPhase WorkPhase
+	// IfNotExists returns the existing work item instead of an error when it
+	// already exists; the other fields are not applied to it (optional)
+	IfNotExists bool
+	// Parent links the new work item to this existing one, as child-of in the
+	// new item and parent-of in the parent (optional)
+	Parent string
 }
 
+// ListScope selects which work item directories are scanned
+type ListScope string
+
+const (
+	ScopeBacklog   ListScope = "backlog"   // Config.BacklogDir only (the default)
+	ScopeCompleted ListScope = "completed" // Config.CompletedDir only
+	ScopeAll       ListScope = "all"       // Both the backlog and completed directories
+)
+
 // ListFilter contains filtering options for listing work items
 type ListFilter struct {
+	// Scope selects the directories to scan (empty means ScopeBacklog); items
+	// from the completed directory have WorkItem.Archived set
+	Scope ListScope
 	// Status filters by work item status (empty means all statuses)
 	Status ItemStatus
 	// Type filters by work item type (empty means all types)
 	Type ItemType
+	// Phase filters by work phase regardless of status (empty means all phases)
+	Phase WorkPhase
+	// Priority filters by triage priority (empty means all priorities)
+	Priority Priority
+	// Labels filters to work items that have every one of these labels (empty means any labels)
+	Labels []string
+	// CreatedBy filters by work item author, case-insensitively (empty means any author)
+	CreatedBy string
+	// NamePrefix filters by the start of the work item name, with or without
+	// its type prefix, so "mobile-" matches "feature-mobile-login" (empty means any name)
+	NamePrefix string
+	// NameGlob filters by a path.Match pattern against the work item name, with
+	// or without its type prefix, e.g. "mobile-*" (empty means any name)
+	NameGlob string
 }
 
 // Manager defines the interface for project management operations
@@ -143,21 +435,76 @@ type Manager interface {
 	// ListWorkItems returns work items matching the filter criteria
 	ListWorkItems(ctx context.Context, filter ListFilter) ([]WorkItem, error)
 
+	// StreamWorkItems calls fn for each work item matching the filter, one at a time
+	StreamWorkItems(ctx context.Context, filter ListFilter, fn func(WorkItem) error) error
+
 	// GetWorkItem retrieves a specific work item by name
 	GetWorkItem(ctx context.Context, name string) (*WorkItem, error)
 
+	// BranchName returns the git branch created for a new work item
+	BranchName(itemType ItemType, name string) string
+
+	// CurrentUser returns the git user name, or the OS user without one
+	CurrentUser() string
+
+	// GetWorkItemByPath retrieves a work item from its directory path
+	GetWorkItemByPath(ctx context.Context, path string) (*WorkItem, error)
+
+	// RepairWorkItem regenerates a missing README.md for a work item directory
+	RepairWorkItem(ctx context.Context, name string) (*WorkItem, error)
+
+	// FindMissingReadmes lists backlog directories that have no README.md
+	FindMissingReadmes(ctx context.Context) ([]string, error)
+
+	// FindOrphanedWorkItems reports work items left half-archived by an interrupted archive
+	FindOrphanedWorkItems(ctx context.Context) ([]ValidationError, error)
+
 	// UpdateStatus updates the status of a work item
 	UpdateStatus(ctx context.Context, name string, status ItemStatus) error
 
 	// UpdateProgress updates the progress of a work item
 	UpdateProgress(ctx context.Context, name string, progress int) error
 
+	// RecalculateProgress sets a work item's progress from its task completion
+	RecalculateProgress(ctx context.Context, name string) (int, error)
+
 	// AssignWorkItem assigns a work item to an assignee
 	AssignWorkItem(ctx context.Context, name, assignee string) error
 
+	// SetPriority sets the triage priority of a work item
+	SetPriority(ctx context.Context, name string, priority Priority) error
+
+	// SetEstimate sets the total effort estimate of a work item, in hours
+	SetEstimate(ctx context.Context, name string, hours float64) error
+
+	// AddLabel adds a free-form label to a work item
+	AddLabel(ctx context.Context, name, label string) error
+
+	// RemoveLabel removes a label from a work item
+	RemoveLabel(ctx context.Context, name, label string) error
+
+	// RenameWorkItem renames a work item's directory, generated title and git branches
+	RenameWorkItem(ctx context.Context, oldName, newName string) (*WorkItem, error)
+
+	// AddDependency records that a work item can't start until another is completed
+	AddDependency(ctx context.Context, name, dependency string) error
+
+	// RemoveDependency removes a dependency from a work item
+	RemoveDependency(ctx context.Context, name, dependency string) error
+
+	// ListBlocked returns the unfinished work items with dependencies that aren't completed
+	ListBlocked(ctx context.Context) ([]BlockedItem, error)
+
 	// AdvancePhase advances a work item to the next phase
 	AdvancePhase(ctx context.Context, name string) error
 
+	// AdvancePhaseWithWarnings advances a work item to the next phase, returning
+	// the incomplete tasks it skipped when strict phase validation is disabled
+	AdvancePhaseWithWarnings(ctx context.Context, name string) ([]Task, error)
+
+	// PreviewAdvance reports what AdvancePhase would do without changing anything
+	PreviewAdvance(ctx context.Context, name string) (*AdvancePreview, error)
+
 	// SetPhase sets the phase of a work item (admin override)
 	SetPhase(ctx context.Context, name string, phase WorkPhase) error
 
@@ -167,13 +514,145 @@ type Manager interface {
 	// CompleteTask marks a task as completed
 	CompleteTask(ctx context.Context, name string, taskId int) error
 
+	// UncompleteTask reopens a completed current-phase task
+	UncompleteTask(ctx context.Context, name string, taskId int) error
+
+	// CompleteTaskByDescription marks the single incomplete current-phase task matching query as completed
+	CompleteTaskByDescription(ctx context.Context, name, query string) error
+
+	// MarkTaskNotApplicable marks an open current-phase task as not applicable
+	MarkTaskNotApplicable(ctx context.Context, name string, taskId int) error
+
+	// AssignTask assigns a current-phase task with an inline "(@assignee)" annotation
+	AssignTask(ctx context.Context, name string, taskId int, assignee string) error
+
 	// GetProgressMetrics returns progress metrics for a work item
 	GetProgressMetrics(ctx context.Context, name string) (*WorkItemMetrics, error)
 
 	// ArchiveWorkItem moves a completed work item to the completed directory
 	ArchiveWorkItem(ctx context.Context, name string) error
+
+	// UnarchiveWorkItem moves an archived work item back into the backlog,
+	// with reopen setting it back to IN_PROGRESS_REVIEW
+	UnarchiveWorkItem(ctx context.Context, name string, reopen bool) (*WorkItem, error)
+
+	// ArchiveCompletedWorkItems archives every COMPLETED work item in the backlog
+	ArchiveCompletedWorkItems(ctx context.Context) ([]string, error)
+
+	// DeleteWorkItem permanently removes a work item and, with git enabled, its branches
+	DeleteWorkItem(ctx context.Context, name string, force bool) error
+
+	// TrashWorkItem moves a backlog work item into the trash, returning its new directory
+	TrashWorkItem(ctx context.Context, name string) (string, error)
+
+	// ListTrash returns the trashed work items, most recently trashed first
+	ListTrash(ctx context.Context) ([]TrashedItem, error)
+
+	// RestoreFromTrash moves the latest trashed work item called name back into the backlog
+	RestoreFromTrash(ctx context.Context, name string) (*WorkItem, error)
+
+	// EmptyTrash permanently deletes every trashed work item
+	EmptyTrash(ctx context.Context) ([]string, error)
+
+	// ExportBundle writes a work item's directory to w as a zip archive
+	ExportBundle(ctx context.Context, name string, w io.Writer) error
+
+	// ImportBundle unpacks a zip archive written by ExportBundle into the backlog
+	ImportBundle(ctx context.Context, r io.ReaderAt, size int64, opts BundleImportOptions) (*WorkItem, error)
+
+	// DiffWorkItems compares two work items' status, phase, progress and tasks
+	DiffWorkItems(ctx context.Context, a, b string) (*WorkItemDiff, error)
+
+	// BuildDigest builds a digest of stale, overdue and blocked work items
+	BuildDigest(ctx context.Context) (Digest, error)
+
+	// GenerateChangelog renders markdown release notes for work items completed after since
+	GenerateChangelog(ctx context.Context, since time.Time) (string, error)
+
+	// ResolveRefDate returns the commit date of a git ref, such as a release tag
+	ResolveRefDate(ctx context.Context, ref string) (time.Time, error)
+
+	// ListNeedingAttention returns work items that are overdue, stale, or fail validation
+	ListNeedingAttention(ctx context.Context) ([]AttentionItem, error)
+
+	// GetBacklogStats computes summary counts for the work items in scope
+	GetBacklogStats(ctx context.Context, scope ListScope) (BacklogStats, error)
+
+	// GetAssigneeWorkload groups unfinished work items by assignee
+	GetAssigneeWorkload(ctx context.Context) (map[string]AssigneeStats, error)
+
+	// GetVelocity counts tasks completed per week over the last weeks weeks
+	GetVelocity(ctx context.Context, weeks int) ([]WeeklyVelocity, error)
+
+	// GetCompletionTimeseries counts tasks completed per bucket since the first completion
+	GetCompletionTimeseries(ctx context.Context, bucket time.Duration) ([]TimeBucket, error)
+
+	// BuildBurndown tracks the open tasks of the named work items day by day
+	BuildBurndown(ctx context.Context, names []string) (Burndown, error)
+
+	// AddReference adds an external link to a work item's "## References" section
+	AddReference(ctx context.Context, name string, ref Reference) error
+
+	// SetMetadata sets a custom key-value field in a work item's "## Metadata" section
+	SetMetadata(ctx context.Context, name, key, value string) error
+
+	// GetMetadata returns a work item's custom key-value fields
+	GetMetadata(ctx context.Context, name string) (map[string]string, error)
+
+	// ConcludeExperiment records an experiment's outcome, with an optional note
+	ConcludeExperiment(ctx context.Context, name string, outcome Outcome, note string) error
+
+	// GraduateExperiment creates a feature linked to a succeeded experiment
+	GraduateExperiment(ctx context.Context, name, featureName string) (*WorkItem, error)
+
+	// ValidateWorkItem reports inconsistencies in a work item, such as a phase that doesn't match its status
+	ValidateWorkItem(ctx context.Context, name string) ([]ValidationError, error)
+
+	// RepairPhase sets a work item's phase to match its status, reporting whether it changed
+	RepairPhase(ctx context.Context, name string) (bool, error)
+
+	// WithSession runs fn with a Session that batches mutations under one lock
+	WithSession(ctx context.Context, name string, fn func(session *Session) error) error
+
+	// SyncPhaseTasks adds template tasks missing from the item's current phase
+	SyncPhaseTasks(ctx context.Context, name string) error
+
+	// MigrateWorkItem upgrades a work item's README to the current schema version
+	MigrateWorkItem(ctx context.Context, name string) (bool, error)
+
+	// PreviewMigration returns the README content MigrateWorkItem would write
+	PreviewMigration(ctx context.Context, name string) (string, bool, error)
+
+	// SplitWorkItem creates child work items linked to name and marks it as a tracking item
+	SplitWorkItem(ctx context.Context, name string, newNames []string) ([]*WorkItem, error)
+
+	// GetPhaseTimeline returns the phases a work item has visited with timestamps
+	GetPhaseTimeline(ctx context.Context, name string) ([]PhaseTransition, error)
+
+	// GetStatusDurations returns how long a work item has spent in each status
+	GetStatusDurations(ctx context.Context, name string) (map[ItemStatus]time.Duration, error)
+
+	// ExportHistory calls fn with each history entry of a work item, or of every work item when name is empty
+	ExportHistory(ctx context.Context, name string, fn func(HistoryRecord) error) error
+
+	// AppendNote appends a timestamped note to the work item's NOTES.md
+	AppendNote(ctx context.Context, name, note string) error
+
+	// GetNotes returns the contents of the work item's NOTES.md
+	GetNotes(ctx context.Context, name string) (string, error)
 }
 
+var (
+	// ErrWorkItemNotFound is returned when no directory exists for a work item
+	ErrWorkItemNotFound = errors.New("work item not found")
+	// ErrReadmeMissing is returned when a work item directory exists but its
+	// README.md does not. This is repairable with RepairWorkItem.
+	ErrReadmeMissing = errors.New("work item directory exists but its README is missing")
+	// ErrNotSupported is returned when an operation isn't available, such as
+	// reading from a git ref with NoOpGitClient
+	ErrNotSupported = errors.New("not supported")
+)
+
 // WorkItemError represents an error that occurred during a work item operation
 type WorkItemError struct {
 	// Op is the operation that failed (create, update, etc.)
@@ -218,28 +697,90 @@ func (e *PhaseError) Error() string {
 	return fmt.Sprintf("cannot advance %s from %s to %s: %s", e.WorkItem, e.CurrentPhase, e.TargetPhase, e.Reason)
 }
 
+// AdvancePreview describes what advancing a work item's phase would do,
+// computed without changing anything
+type AdvancePreview struct {
+	Name          string     `json:"name"`                  // Work item name
+	CurrentPhase  WorkPhase  `json:"current_phase"`         // Phase before the advance
+	CurrentStatus ItemStatus `json:"current_status"`        // Status before the advance
+	NextPhase     WorkPhase  `json:"next_phase,omitempty"`  // Phase after the advance; empty when there is none
+	NextStatus    ItemStatus `json:"next_status,omitempty"` // Status after the advance; empty when there is none
+	// IncompleteTasks are the current phase's unfinished tasks. They block the
+	// advance when Config.PhaseAdvanceStrict is set and are warnings otherwise.
+	IncompleteTasks []Task `json:"incomplete_tasks"`
+	// UnmetRequirements are the Config.PhaseRequirements for the current phase
+	// that the work item doesn't meet yet
+	UnmetRequirements []string `json:"unmet_requirements"`
+	// Blockers explain why the advance would fail; empty when it would succeed
+	Blockers []string `json:"blockers"`
+	// NewAssignee is the reviewer the item would be handed to on entering review
+	NewAssignee string `json:"new_assignee,omitempty"`
+}
+
+// CanAdvance reports whether the previewed advance would succeed
+func (p AdvancePreview) CanAdvance() bool {
+	return len(p.Blockers) == 0
+}
+
+// PhaseRequirement lists the conditions a work item must meet before it can
+// advance out of a phase, in addition to completing the phase's tasks
+type PhaseRequirement struct {
+	// Overview requires the "## Overview" paragraph to be filled in rather
+	// than empty or left as the template's text
+	Overview bool `json:"overview,omitempty" mapstructure:"overview"`
+	// Assignee requires the work item to be assigned
+	Assignee bool `json:"assignee,omitempty" mapstructure:"assignee"`
+	// MinTasks is the minimum number of tasks the phase must list
+	MinTasks int `json:"min_tasks,omitempty" mapstructure:"min_tasks"`
+}
+
+// AlreadyCompletedError is returned when advancing a work item that is already
+// COMPLETED; there is no phase after completion, so archive it instead
+type AlreadyCompletedError struct {
+	WorkItem string
+}
+
+func (e *AlreadyCompletedError) Error() string {
+	return fmt.Sprintf("work item %s is already COMPLETED", e.WorkItem)
+}
+
 // WorkItemMetrics represents comprehensive metrics for a work item.
 // It includes task completion statistics, phase progress, and timing information
 // used for progress tracking and reporting.
 type WorkItemMetrics struct {
-	Name            string          // Work item name
-	TotalTasks      int             // Total number of tasks across all phases
-	CompletedTasks  int             // Number of completed tasks
-	OverallProgress int             // Overall progress percentage (0-100)
-	PhaseProgress   []PhaseProgress // Progress metrics for each phase
-	TotalTimeSpent  time.Duration   // Total time spent on the work item
-	CreatedAt       time.Time       // When the work item was created
-	UpdatedAt       time.Time       // When the work item was last updated
+	Name              string          `json:"name"`                 // Work item name
+	TotalTasks        int             `json:"total_tasks"`          // Total number of tasks across all phases
+	CompletedTasks    int             `json:"completed_tasks"`      // Number of completed tasks
+	OverallProgress   int             `json:"overall_progress"`     // Overall progress percentage (0-100)
+	PhaseProgress     []PhaseProgress `json:"phase_progress"`       // Progress metrics for each phase
+	TotalTimeSpent    time.Duration   `json:"total_time_spent"`     // Total time spent on the work item
+	CreatedAt         time.Time       `json:"created_at"`           // When the work item was created
+	UpdatedAt         time.Time       `json:"updated_at"`           // When the work item was last updated
+	References        []Reference     `json:"references,omitempty"` // External links listed in the work item
+	RemainingEstimate time.Duration   `json:"remaining_estimate"`   // Sum of the "(estimate: ...)" annotations of incomplete tasks
+	OpenTasks         int             `json:"open_tasks"`           // Incomplete tasks, not counting not applicable ones
+	EstimatedTasks    int             `json:"estimated_tasks"`      // Incomplete tasks that have an estimate
+	EstimatedHours    float64         `json:"estimated_hours"`      // The work item's "## Estimate:" in hours, 0 if none
 }
 
 // PhaseProgress represents progress metrics for a specific phase.
 // It tracks task completion and time spent within a particular work phase.
 type PhaseProgress struct {
-	Phase           WorkPhase     // The work phase these metrics apply to
-	TotalTasks      int           // Total tasks in this phase
-	CompletedTasks  int           // Completed tasks in this phase
-	ProgressPercent int           // Progress percentage for this phase (0-100)
-	TimeSpent       time.Duration // Time spent working on this phase
+	Phase           WorkPhase       `json:"phase"`            // The work phase these metrics apply to
+	TotalTasks      int             `json:"total_tasks"`      // Total tasks in this phase
+	CompletedTasks  int             `json:"completed_tasks"`  // Completed tasks in this phase
+	ProgressPercent int             `json:"progress_percent"` // Progress percentage for this phase (0-100)
+	TimeSpent       time.Duration   `json:"time_spent"`       // Time spent working on this phase
+	Estimate        time.Duration   `json:"estimate"`         // Sum of the "(estimate: ...)" annotations of the phase's tasks
+	Groups          []GroupProgress `json:"groups,omitempty"` // Per-group breakdown, empty if the phase has no named task groups
+}
+
+// GroupProgress represents progress metrics for a named task group within a phase
+type GroupProgress struct {
+	Group           string `json:"group"`            // Group name from the "###" subheading
+	TotalTasks      int    `json:"total_tasks"`      // Total tasks in this group
+	CompletedTasks  int    `json:"completed_tasks"`  // Completed tasks in this group
+	ProgressPercent int    `json:"progress_percent"` // Progress percentage for this group (0-100)
 }
 
 // Config holds configuration for the PM system
@@ -254,26 +795,99 @@ type Config struct {
 	PhaseTimeoutDays int
 	// EnableGit indicates whether to enable git integration (default: false)
 	EnableGit bool
+	// BranchPerPhase indicates whether advancing a phase creates a new
+	// "{type}/{name}/{phase}" branch instead of staying on the work item's branch (default: false)
+	BranchPerPhase bool
+	// AutoCommit indicates whether creating a work item, updating its status
+	// and advancing its phase commit the work item's changes, when git is
+	// enabled (default: false)
+	AutoCommit bool
+	// BranchPrefix is prepended as the first segment of generated branch names,
+	// e.g. "wi" gives "wi/feature/name" (default: "", no prefix)
+	BranchPrefix string
+	// BranchSeparator joins the segments of generated branch names (default: "/")
+	BranchSeparator string
+	// WebhookURL is where notifications such as the digest are posted (default: "", disabled)
+	WebhookURL string
+	// WorkItemFile is the markdown file in each work item directory that holds
+	// its metadata and tasks (default: "README.md")
+	WorkItemFile string
+	// Reviewer is who work items are assigned to when they advance into
+	// IN_PROGRESS_REVIEW (default: "", disabled)
+	Reviewer string
+	// APIToken is the bearer token required by the HTTP API's write endpoints
+	// (default: "", writes disabled)
+	APIToken string
+	// GitHubToken is the token `go-pm sync github` uses to comment on and
+	// label the GitHub issues work items link with "## Issue:" (default: "",
+	// sync disabled)
+	GitHubToken string
+	// DefaultAssigneeByType maps a work item type (e.g. "bug") to who new items
+	// of that type are assigned to (default: empty, new items are unassigned)
+	DefaultAssigneeByType map[string]string
+	// PhaseAdvanceStrict blocks advancing a phase while it has incomplete tasks;
+	// when false the advance proceeds and the tasks are reported as warnings (default: true)
+	PhaseAdvanceStrict bool
+	// PhaseRequirements maps a phase to the conditions checked before a work
+	// item advances out of it (default: empty, no extra conditions)
+	PhaseRequirements map[WorkPhase]PhaseRequirement
+	// PostmortemTemplate is the markdown file POSTMORTEM.md is generated from on
+	// archive, resolved like BacklogDir (default: "", the embedded template)
+	PostmortemTemplate string
+	// TemplatesDir holds workitem-<type>.md files that replace the embedded
+	// work item templates, resolved like BacklogDir; types without a file there
+	// use the embedded template (default: "", embedded templates only)
+	TemplatesDir string
+	// WarnPhaseMismatch prints a warning whenever a work item is read whose
+	// phase doesn't match its status (default: false)
+	WarnPhaseMismatch bool
+	// StatusDirectories groups backlog work items into proposed, in-progress,
+	// review and done subdirectories and moves them as their status changes
+	// (default: false, a flat backlog)
+	StatusDirectories bool
+	// ListConcurrency is how many work item READMEs listing, search and
+	// validation parse in parallel; 1 parses them one at a time (default: the
+	// number of CPUs)
+	ListConcurrency int
+	// ProgressMismatchThreshold is how many percentage points a work item's
+	// "## Progress:" may differ from its task completion before reading it
+	// prints a warning and sets WorkItem.ProgressMismatch; 0 disables the
+	// check (default: 20)
+	ProgressMismatchThreshold int
+
+	// overrides are the settings DefaultConfigWithOverrides applied, reported
+	// as such by EffectiveConfig
+	overrides ConfigOverrides
 }
 
-// detectRepoRoot attempts to detect the git repository root directory
+// DefaultWorkItemFile is the work item file name used when Config.WorkItemFile is empty
+const DefaultWorkItemFile = "README.md"
+
+// detectRepoRoot attempts to detect the git repository root directory containing repoDir
 func detectRepoRoot() string {
-	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	cmd := exec.Command("git", "-C", repoDir, "rev-parse", "--show-toplevel")
 	output, err := cmd.Output()
 	if err != nil {
-		// Not a git repo or git not available, use current directory
-		return "."
+		// Not a git repo or git not available, use the starting directory
+		return repoDir
 	}
 	return string(output[:len(output)-1]) // Remove trailing newline
 }
 
 // DefaultConfig returns the default configuration with file and environment variable support
 func DefaultConfig() Config {
-	autoDetect := configViper.GetBool("auto_detect_repo_root")
+	return configFromViper(configViper)
+}
+
+// configFromViper builds a Config from the settings loaded into v
+func configFromViper(v *viper.Viper) Config {
+	autoDetect := v.GetBool("auto_detect_repo_root")
 
 	// Ensure backlog and completed dirs are absolute paths
-	backlogDir := configViper.GetString("backlog_dir")
-	completedDir := configViper.GetString("completed_dir")
+	backlogDir := v.GetString("backlog_dir")
+	completedDir := v.GetString("completed_dir")
+	postmortemTemplate := v.GetString("postmortem_template")
+	templatesDir := v.GetString("templates_dir")
 
 	if autoDetect {
 		// When auto-detecting, use repo root as base
@@ -284,21 +898,63 @@ func DefaultConfig() Config {
 		if !filepath.IsAbs(completedDir) {
 			completedDir = filepath.Join(baseDir, completedDir)
 		}
+		if postmortemTemplate != "" && !filepath.IsAbs(postmortemTemplate) {
+			postmortemTemplate = filepath.Join(baseDir, postmortemTemplate)
+		}
+		if templatesDir != "" && !filepath.IsAbs(templatesDir) {
+			templatesDir = filepath.Join(baseDir, templatesDir)
+		}
 	} else {
 		// When not auto-detecting, treat relative paths as relative to current directory
 		if !filepath.IsAbs(backlogDir) {
-			backlogDir = filepath.Join(".", backlogDir)
+			backlogDir = filepath.Join(repoDir, backlogDir)
 		}
 		if !filepath.IsAbs(completedDir) {
-			completedDir = filepath.Join(".", completedDir)
+			completedDir = filepath.Join(repoDir, completedDir)
+		}
+		if postmortemTemplate != "" && !filepath.IsAbs(postmortemTemplate) {
+			postmortemTemplate = filepath.Join(repoDir, postmortemTemplate)
+		}
+		if templatesDir != "" && !filepath.IsAbs(templatesDir) {
+			templatesDir = filepath.Join(repoDir, templatesDir)
 		}
 	}
 
 	return Config{
-		AutoDetectRepoRoot: autoDetect,
-		BacklogDir:         backlogDir,
-		CompletedDir:       completedDir,
-		PhaseTimeoutDays:   configViper.GetInt("phase_timeout_days"),
-		EnableGit:          configViper.GetBool("enable_git"),
+		AutoDetectRepoRoot:        autoDetect,
+		BacklogDir:                backlogDir,
+		CompletedDir:              completedDir,
+		PhaseTimeoutDays:          v.GetInt("phase_timeout_days"),
+		EnableGit:                 v.GetBool("enable_git"),
+		BranchPerPhase:            v.GetBool("branch_per_phase"),
+		AutoCommit:                v.GetBool("auto_commit"),
+		BranchPrefix:              v.GetString("branch_prefix"),
+		BranchSeparator:           v.GetString("branch_separator"),
+		WebhookURL:                v.GetString("webhook_url"),
+		WorkItemFile:              v.GetString("work_item_file"),
+		Reviewer:                  v.GetString("reviewer"),
+		APIToken:                  v.GetString("api_token"),
+		GitHubToken:               v.GetString("github_token"),
+		DefaultAssigneeByType:     v.GetStringMapString("default_assignee_by_type"),
+		PhaseAdvanceStrict:        v.GetBool("phase_advance_strict"),
+		PhaseRequirements:         configPhaseRequirements(v),
+		PostmortemTemplate:        postmortemTemplate,
+		TemplatesDir:              templatesDir,
+		WarnPhaseMismatch:         v.GetBool("warn_phase_mismatch"),
+		StatusDirectories:         v.GetBool("status_directories"),
+		ListConcurrency:           v.GetInt("list_concurrency"),
+		ProgressMismatchThreshold: v.GetInt("progress_mismatch_threshold"),
+	}
+}
+
+// configPhaseRequirements reads phase_requirements, which is a mapping in config
+// files and a JSON object in PM_PHASE_REQUIREMENTS. Unparseable values are ignored.
+func configPhaseRequirements(v *viper.Viper) map[WorkPhase]PhaseRequirement {
+	requirements := make(map[WorkPhase]PhaseRequirement)
+	if raw, ok := v.Get("phase_requirements").(string); ok {
+		_ = json.Unmarshal([]byte(raw), &requirements)
+		return requirements
 	}
+	_ = v.UnmarshalKey("phase_requirements", &requirements)
+	return requirements
 }
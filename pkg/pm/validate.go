@@ -0,0 +1,287 @@
+package pm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Severity is how serious a problem reported by ValidateWorkItem is
+type Severity string
+
+const (
+	// SeverityError marks a work item that is malformed or inconsistent
+	SeverityError Severity = "error"
+	// SeverityWarning marks drift that can be legitimate, such as a git branch
+	// deleted after merging
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationSeverity returns the severity of a problem reported by
+// ValidateWorkItem. Git branch problems are warnings; everything else is an error.
+func ValidationSeverity(problem ValidationError) Severity {
+	if problem.Field == "branch" {
+		return SeverityWarning
+	}
+	return SeverityError
+}
+
+// Patterns checkReadmeStructure looks for in raw README content, since the
+// parser defaults a missing phase to discovery and a missing progress to 0
+var (
+	phaseHeadingRegex    = regexp.MustCompile(`(?m)^##\s*Phase:`)
+	progressHeadingRegex = regexp.MustCompile(`(?m)^##\s*Progress:[ \t]*(.*?)\s*$`)
+	phaseSectionRegex    = regexp.MustCompile(`(?m)^##\s+(?:Discovery|Planning|Execution|Cleanup)\s+Phase\b`)
+)
+
+// checkReadmeStructure reports the structural problems of a work item README
+// that parsing papers over: a missing title heading, a missing or invalid
+// "## Status:", a missing "## Phase:", a missing or non-numeric
+// "## Progress:", and no phase task section. content is the raw README.
+func (s *WorkItemService) checkReadmeStructure(item WorkItem, content string) []ValidationError {
+	var problems []ValidationError
+	if item.Title == "" {
+		problems = append(problems, ValidationError{Field: "title", Message: `missing "# Feature|Bug|Experiment: <title>" heading`})
+	}
+
+	if item.Status == "UNKNOWN" {
+		problems = append(problems, ValidationError{Field: "status", Message: `missing "## Status:" heading`})
+	} else if s.validateStatus(item.Status) != nil {
+		problems = append(problems, ValidationError{Field: "status", Value: string(item.Status), Message: "invalid status"})
+		// checkPhaseMatchesStatus skips items with an invalid status
+		if unknown := checkKnownPhase(item); unknown != nil {
+			problems = append(problems, *unknown)
+		}
+	}
+
+	if !phaseHeadingRegex.MatchString(content) {
+		problems = append(problems, ValidationError{Field: "phase", Message: `missing "## Phase:" heading`})
+	}
+
+	if matches := progressHeadingRegex.FindStringSubmatch(content); matches == nil {
+		problems = append(problems, ValidationError{Field: "progress", Message: `missing "## Progress:" heading`})
+	} else if percent, err := strconv.Atoi(strings.TrimSuffix(matches[1], "%")); err != nil || percent < 0 || percent > 100 {
+		problems = append(problems, ValidationError{Field: "progress", Value: matches[1], Message: "progress must be a percentage from 0% to 100%"})
+	}
+
+	if !phaseSectionRegex.MatchString(content) {
+		problems = append(problems, ValidationError{Field: "tasks", Message: `no phase task section such as "## Discovery Phase"`})
+	}
+	return problems
+}
+
+// checkKnownPhase reports an item whose "## Phase:" isn't one of the known
+// phases, e.g. "design". The parser keeps such values as-is, and no task is
+// ever filed under them.
+func checkKnownPhase(item WorkItem) *ValidationError {
+	switch item.Phase {
+	case PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup:
+		return nil
+	}
+	return &ValidationError{
+		Field:   "phase",
+		Value:   string(item.Phase),
+		Message: "unknown phase (valid: discovery, planning, execution, cleanup)",
+	}
+}
+
+// checkPhaseMatchesStatus reports a mismatch between an item's "## Phase:" and
+// the phase its "## Status:" is worked in, e.g. IN_PROGRESS_PLANNING with phase
+// discovery. An unknown phase is reported as such. Items with an invalid status
+// are not checked.
+func (s *WorkItemService) checkPhaseMatchesStatus(item WorkItem) *ValidationError {
+	if s.validateStatus(item.Status) != nil {
+		return nil
+	}
+	if unknown := checkKnownPhase(item); unknown != nil {
+		return unknown
+	}
+	if expected := phaseForStatus(item.Status); item.Phase != expected {
+		return &ValidationError{
+			Field:   "phase",
+			Value:   string(item.Phase),
+			Message: fmt.Sprintf("status %s expects phase %s", item.Status, expected),
+		}
+	}
+	return nil
+}
+
+// checkBranchMatchesPhase reports a work item whose git branch doesn't reflect
+// its README: the item's branch is missing, or with Config.BranchPerPhase the
+// branch for its current phase is, which suggests the item was advanced
+// without go-pm. Items are only checked when Config.EnableGit is set.
+func (s *WorkItemService) checkBranchMatchesPhase(item WorkItem) *ValidationError {
+	if !s.config.EnableGit || item.Type == "" {
+		return nil
+	}
+	// Phase branches are created on advancing, so proposed items only have the item branch
+	if !s.config.BranchPerPhase || item.Status == StatusProposed {
+		branch := s.git.BranchName(item.Type, strings.TrimPrefix(item.Name, string(item.Type)+"-"))
+		if s.git.BranchExists(branch) {
+			return nil
+		}
+		return &ValidationError{Field: "branch", Value: branch, Message: "work item branch does not exist"}
+	}
+
+	if checkKnownPhase(item) != nil {
+		return nil
+	}
+	// Phase branches are named after the directory name, like AdvancePhase creates them
+	branch := s.git.PhaseBranchName(item.Type, item.Name, item.Phase)
+	if s.git.BranchExists(branch) {
+		return nil
+	}
+	message := fmt.Sprintf("branch for phase %s does not exist", item.Phase)
+	phases := []WorkPhase{PhaseDiscovery, PhasePlanning, PhaseExecution, PhaseCleanup}
+	for i := len(phases) - 1; i >= 0; i-- {
+		if s.git.BranchExists(s.git.PhaseBranchName(item.Type, item.Name, phases[i])) {
+			message = fmt.Sprintf("branch for phase %s does not exist, the latest phase branch is for %s", item.Phase, phases[i])
+			break
+		}
+	}
+	return &ValidationError{Field: "branch", Value: branch, Message: message}
+}
+
+// warnPhaseMismatch prints a warning to stderr when Config.WarnPhaseMismatch is
+// set and the item's phase doesn't match its status
+func (s *WorkItemService) warnPhaseMismatch(item WorkItem) {
+	if !s.config.WarnPhaseMismatch {
+		return
+	}
+	if mismatch := s.checkPhaseMatchesStatus(item); mismatch != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s: %s (run 'go-pm repair --fix-phase %s' to fix it)\n", item.Name, mismatch, item.Name)
+	}
+}
+
+// taskProgress returns the percentage of an item's tasks that are completed,
+// not counting not-applicable ones, and false when it has no such tasks
+func taskProgress(item WorkItem) (completed, total, percent int, ok bool) {
+	for _, task := range item.Tasks {
+		if task.NotApplicable {
+			continue
+		}
+		total++
+		if task.Completed {
+			completed++
+		}
+	}
+	if total == 0 {
+		return 0, 0, 0, false
+	}
+	return completed, total, completed * 100 / total, true
+}
+
+// checkProgressMismatch sets item.ProgressMismatch when the stored progress
+// differs from task completion by more than Config.ProgressMismatchThreshold
+// points, and prints a warning to stderr when warn is set
+func (s *WorkItemService) checkProgressMismatch(item *WorkItem, warn bool) {
+	threshold := s.config.ProgressMismatchThreshold
+	if threshold <= 0 {
+		return
+	}
+	completed, total, percent, ok := taskProgress(*item)
+	if !ok {
+		return
+	}
+	diff := item.Progress - percent
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= threshold {
+		return
+	}
+	item.ProgressMismatch = true
+	if warn {
+		fmt.Fprintf(os.Stderr, "Warning: %s: progress is %d%% but %d/%d tasks are done (%d%%) (run 'go-pm progress recalc %s' to fix it)\n",
+			item.Name, item.Progress, completed, total, percent, item.Name)
+	}
+}
+
+// ValidateWorkItem checks a backlog work item for inconsistencies that the
+// parser would otherwise accept silently: README structure problems such as a
+// missing title, status, phase or progress heading or no phase task section,
+// a phase that doesn't match the status, or, when git is enabled, a branch
+// that doesn't match the phase.
+// It returns one ValidationError per problem found; an error is
+// returned only when the item can't be read.
+//
+// Example:
+//
+//	problems, err := service.ValidateWorkItem(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, problem := range problems {
+//		fmt.Println(problem.Error())
+//	}
+func (s *WorkItemService) ValidateWorkItem(ctx context.Context, name string) ([]ValidationError, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.fs.ReadFile(item.Path)
+	if err != nil {
+		return nil, &WorkItemError{Op: "validate", Name: name, Err: err}
+	}
+
+	problems := s.checkReadmeStructure(*item, string(content))
+	if mismatch := s.checkPhaseMatchesStatus(*item); mismatch != nil {
+		problems = append(problems, *mismatch)
+	}
+	if mismatch := s.checkBranchMatchesPhase(*item); mismatch != nil {
+		problems = append(problems, *mismatch)
+	}
+	return problems, nil
+}
+
+// RepairPhase sets a work item's phase to the one its status is worked in,
+// fixing READMEs whose "## Phase:" drifted from "## Status:". It reports
+// whether the phase was changed.
+//
+// Example:
+//
+//	fixed, err := service.RepairPhase(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if fixed {
+//		fmt.Println("Phase now matches status")
+//	}
+func (s *WorkItemService) RepairPhase(ctx context.Context, name string) (bool, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return false, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return false, &WorkItemError{Op: "repair", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return false, &WorkItemError{Op: "repair", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	if err := s.validateStatus(item.Status); err != nil {
+		return false, err
+	}
+	if s.checkPhaseMatchesStatus(item) == nil {
+		return false, nil
+	}
+
+	if err := s.SetPhase(ctx, name, phaseForStatus(item.Status)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
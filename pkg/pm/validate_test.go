@@ -0,0 +1,163 @@
+package pm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWorkItemPhaseMatchesStatus(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "drift"})
+	require.NoError(t, err)
+
+	problems, err := manager.ValidateWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// A status change without the matching phase change drifts
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-drift", StatusInProgressPlanning))
+	problems, err = manager.ValidateWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "phase", problems[0].Field)
+	assert.Equal(t, string(PhaseDiscovery), problems[0].Value)
+	assert.Contains(t, problems[0].Message, "expects phase planning")
+	assert.Equal(t, SeverityError, ValidationSeverity(problems[0]))
+
+	_, err = manager.ValidateWorkItem(ctx, "feature-missing")
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestRepairPhase(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "drift"})
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-drift", StatusInProgressReview))
+
+	fixed, err := manager.RepairPhase(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.True(t, fixed)
+
+	item, err := manager.GetWorkItem(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseCleanup, item.Phase)
+	assert.Equal(t, StatusInProgressReview, item.Status)
+
+	// Already consistent items are left alone
+	fixed, err = manager.RepairPhase(ctx, "feature-drift")
+	require.NoError(t, err)
+	assert.False(t, fixed)
+}
+
+func TestUnknownPhase(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "design"})
+	require.NoError(t, err)
+	content, err := fs.ReadFile(item.Path)
+	require.NoError(t, err)
+	require.NoError(t, fs.WriteFile(item.Path, []byte(strings.Replace(string(content), "## Phase: discovery", "## Phase: design", 1))))
+
+	// Phase tasks signal the unknown phase instead of returning nothing
+	var validationErr *ValidationError
+	_, err = manager.GetPhaseTasks(ctx, "feature-design")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "design", validationErr.Value)
+
+	problems, err := manager.ValidateWorkItem(ctx, "feature-design")
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, "unknown phase")
+
+	// Repairing the phase maps it back to the one the status is worked in
+	fixed, err := manager.RepairPhase(ctx, "feature-design")
+	require.NoError(t, err)
+	assert.True(t, fixed)
+	tasks, err := manager.GetPhaseTasks(ctx, "feature-design")
+	require.NoError(t, err)
+	assert.NotEmpty(t, tasks)
+}
+
+func TestValidateWorkItemBranchMatchesPhase(t *testing.T) {
+	config := DefaultConfig()
+	config.EnableGit = true
+	config.BranchPerPhase = true
+	config.PhaseAdvanceStrict = false
+	fs := NewMockFileSystem()
+	git := NewMockGitClient()
+	manager := NewDefaultManagerWithDeps(config, fs, git)
+	ctx := context.Background()
+
+	_, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "login"})
+	require.NoError(t, err)
+	require.NoError(t, manager.AdvancePhase(ctx, "feature-login"))
+	problems, err := manager.ValidateWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// Moving the README to execution by hand leaves the branches behind
+	require.NoError(t, manager.UpdateStatus(ctx, "feature-login", StatusInProgressExecution))
+	_, err = manager.RepairPhase(ctx, "feature-login")
+	require.NoError(t, err)
+	problems, err = manager.ValidateWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "branch", problems[0].Field)
+	assert.Equal(t, "feature/feature-login/execution", problems[0].Value)
+	assert.Contains(t, problems[0].Message, "latest phase branch is for discovery")
+	assert.Equal(t, SeverityWarning, ValidationSeverity(problems[0]))
+
+	// Without branch-per-phase only the item branch is expected
+	config.BranchPerPhase = false
+	manager = NewDefaultManagerWithDeps(config, fs, git)
+	problems, err = manager.ValidateWorkItem(ctx, "feature-login")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestValidateWorkItemReadmeStructure(t *testing.T) {
+	config := DefaultConfig()
+	fs := NewMockFileSystem()
+	manager := NewDefaultManagerWithDeps(config, fs, NewNoOpGitClient())
+	ctx := context.Background()
+
+	item, err := manager.CreateWorkItem(ctx, CreateRequest{Type: TypeFeature, Name: "broken"})
+	require.NoError(t, err)
+
+	content := "Some notes\n\n## Phase: discovery\n## Progress: lots\n"
+	require.NoError(t, fs.WriteFile(item.Path, []byte(content)))
+
+	problems, err := manager.ValidateWorkItem(ctx, "feature-broken")
+	require.NoError(t, err)
+	fields := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		fields = append(fields, problem.Field)
+		assert.Equal(t, SeverityError, ValidationSeverity(problem))
+	}
+	assert.ElementsMatch(t, []string{"title", "status", "progress", "tasks"}, fields)
+
+	content = "# Feature: broken\n\n## Status: IN_PROGRESS_DISCOVERY\n## Progress: 140%\n\n## Discovery Phase\n- [ ] Research\n"
+	require.NoError(t, fs.WriteFile(item.Path, []byte(content)))
+	problems, err = manager.ValidateWorkItem(ctx, "feature-broken")
+	require.NoError(t, err)
+	fields = fields[:0]
+	for _, problem := range problems {
+		fields = append(fields, problem.Field)
+	}
+	assert.ElementsMatch(t, []string{"phase", "progress"}, fields)
+}
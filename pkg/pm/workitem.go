@@ -3,11 +3,22 @@ package pm
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// notesFileName is the per-work-item scratch notes file. It lives next to
+// README.md but never contributes to the work item's metadata.
+const notesFileName = "NOTES.md"
+
 // WorkItemService provides operations for managing work items.
 // It coordinates between filesystem operations, git integration, and business logic
 // to provide a complete work item management system.
@@ -20,6 +31,7 @@ type WorkItemService struct {
 	git        *GitIntegration
 	postmortem *PostmortemGenerator
 	progress   *ProgressTracker
+	locks      *itemLocks
 }
 
 // NewWorkItemService creates a new work item service with the given dependencies.
@@ -32,29 +44,49 @@ type WorkItemService struct {
 //	git := NewOSGitClient()
 //	service := NewWorkItemService(config, fs, git)
 func NewWorkItemService(config Config, fs FileSystem, gitClient GitClient) *WorkItemService {
+	if config.WorkItemFile == "" {
+		config.WorkItemFile = DefaultWorkItemFile
+	}
+
 	return &WorkItemService{
 		config:     config,
 		fs:         fs,
 		parser:     NewWorkItemParser(fs),
 		updater:    NewStatusUpdater(fs),
 		templater:  NewTemplateProcessor(fs, config),
-		git:        NewGitIntegration(gitClient),
-		postmortem: NewPostmortemGenerator(fs),
+		git:        NewGitIntegrationWithNamer(gitClient, NewBranchNamerWithConfig(config)),
+		postmortem: NewPostmortemGenerator(fs, config),
 		progress:   NewProgressTracker(fs),
+		locks:      &itemLocks{},
 	}
 }
 
 // CreateWorkItem creates a new work item with the given parameters.
 // It generates the directory structure, applies templates, creates a git branch,
 // and returns the created work item. The work item starts in PROPOSED status
-// in the discovery phase.
+// in the discovery phase unless req.InitialStatus or req.InitialPhase say
+// otherwise. With req.IfNotExists an existing work item is
+// returned unchanged instead of failing with a ValidationError. With
+// req.Parent the new item and its parent are linked under "## Related Items".
 func (s *WorkItemService) CreateWorkItem(ctx context.Context, req CreateRequest) (*WorkItem, error) {
 	if err := s.validateCreateRequest(req); err != nil {
 		return nil, err
 	}
+	parentReadme := filepath.Join(s.itemDir(req.Parent), s.config.WorkItemFile)
+	if req.Parent != "" && !s.fs.FileExists(parentReadme) {
+		return nil, &ValidationError{Field: "parent", Value: req.Parent, Message: "parent work item not found"}
+	}
 
 	workDir := s.getWorkItemPath(req.Type, req.Name)
-	readmePath := filepath.Join(workDir, "README.md")
+	readmePath := filepath.Join(workDir, s.config.WorkItemFile)
+
+	if req.IfNotExists && s.fs.DirectoryExists(workDir) {
+		return s.GetWorkItem(ctx, s.getWorkItemDirName(req.Type, req.Name))
+	}
+
+	if err := s.checkBacklogWritable(); err != nil {
+		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: err}
+	}
 
 	// Create directory
 	if err := s.fs.CreateDirectory(workDir); err != nil {
@@ -62,10 +94,36 @@ func (s *WorkItemService) CreateWorkItem(ctx context.Context, req CreateRequest)
 	}
 
 	// Process template
-	if err := s.templater.ProcessTemplate(readmePath, req.Name, req.Type); err != nil {
+	if err := s.templater.ProcessTemplate(readmePath, req.Name, req.Type, req.TemplateVars); err != nil {
 		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: fmt.Errorf("failed to process template: %w", err)}
 	}
 
+	// Route unassigned items to the configured default for their type
+	if req.Assignee == "" {
+		req.Assignee = s.config.DefaultAssigneeByType[string(req.Type)]
+	}
+
+	// Apply optional fields over the template defaults
+	if err := s.applyCreateFields(readmePath, req); err != nil {
+		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: fmt.Errorf("failed to apply work item fields: %w", err)}
+	}
+
+	// Record the author
+	if err := s.updater.UpdateCreatedBy(readmePath, s.CurrentUser()); err != nil {
+		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: fmt.Errorf("failed to record author: %w", err)}
+	}
+
+	// Link the parent and child both ways
+	if req.Parent != "" {
+		if err := s.updater.AddRelatedItem(readmePath, RelatedItem{Relation: RelationChildOf, Name: req.Parent}); err != nil {
+			return nil, &WorkItemError{Op: "create", Name: req.Name, Err: fmt.Errorf("failed to link parent: %w", err)}
+		}
+		child := RelatedItem{Relation: RelationParentOf, Name: s.getWorkItemDirName(req.Type, req.Name)}
+		if err := s.updater.AddRelatedItem(parentReadme, child); err != nil {
+			return nil, &WorkItemError{Op: "create", Name: req.Parent, Err: fmt.Errorf("failed to link child: %w", err)}
+		}
+	}
+
 	// Create git branch
 	if s.config.EnableGit {
 		if err := s.git.CreateWorkItemBranch(req.Type, req.Name); err != nil {
@@ -80,11 +138,29 @@ func (s *WorkItemService) CreateWorkItem(ctx context.Context, req CreateRequest)
 		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: fmt.Errorf("failed to parse created work item: %w", err)}
 	}
 
+	s.recordChanges(workDir,
+		fieldChange{field: HistoryFieldStatus, new: string(item.Status)},
+		fieldChange{field: HistoryFieldPhase, new: string(item.Phase)},
+	)
+
+	if err := s.relocateByStatus(item.Name, item.Status); err != nil {
+		return nil, &WorkItemError{Op: "create", Name: req.Name, Err: err}
+	}
+	item.Path = filepath.Join(s.itemDir(item.Name), s.config.WorkItemFile)
+
+	// Commit the new item, history and move included, and the parent it was linked to
+	committed := []string{filepath.Dir(item.Path)}
+	if req.Parent != "" {
+		committed = append(committed, filepath.Dir(parentReadme))
+	}
+	s.autoCommit("pm: create "+item.Name, committed...)
+
 	return &item, nil
 }
 
 // ListWorkItems returns work items matching the filter criteria.
-// It searches the backlog directory and applies the provided filter.
+// It searches the directories selected by filter.Scope (the backlog by default)
+// and applies the provided filter.
 // If no filter is provided (empty ListFilter), all work items are returned.
 //
 // Example:
@@ -99,25 +175,78 @@ func (s *WorkItemService) CreateWorkItem(ctx context.Context, req CreateRequest)
 //	}
 func (s *WorkItemService) ListWorkItems(ctx context.Context, filter ListFilter) ([]WorkItem, error) {
 	var items []WorkItem
+	err := s.StreamWorkItems(ctx, filter, func(item WorkItem) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// List from backlog directory
-	if s.fs.DirectoryExists(s.config.BacklogDir) {
-		backlogItems, err := s.listWorkItemsInDir(s.config.BacklogDir)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list backlog items: %w", err)
+	return items, nil
+}
+
+// StreamWorkItems calls fn for each work item matching the filter, parsing
+// one README at a time instead of materializing the whole backlog. It stops
+// and returns the error as soon as fn returns one or ctx is cancelled.
+//
+// Example:
+//
+//	err := service.StreamWorkItems(ctx, ListFilter{}, func(item WorkItem) error {
+//		fmt.Printf("Found: %s (%s)\n", item.Name, item.Status)
+//		return nil
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) StreamWorkItems(ctx context.Context, filter ListFilter, fn func(WorkItem) error) error {
+	if filter.Phase != "" {
+		if err := s.validatePhase(filter.Phase); err != nil {
+			return err
 		}
-		items = append(items, backlogItems...)
+	}
+	if _, err := path.Match(filter.NameGlob, ""); err != nil {
+		return &ValidationError{Field: "name_glob", Value: filter.NameGlob, Message: "invalid glob pattern"}
+	}
+	if s.config.ListConcurrency < 1 {
+		return &ValidationError{Field: "list_concurrency", Value: strconv.Itoa(s.config.ListConcurrency), Message: "must be at least 1"}
 	}
 
-	// Apply filters
-	var filtered []WorkItem
-	for _, item := range items {
-		if s.matchesFilter(item, filter) {
-			filtered = append(filtered, item)
+	dirs, err := s.scopeDirs(filter.Scope)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if !s.fs.DirectoryExists(dir) {
+			continue
+		}
+		archived := dir == s.config.CompletedDir
+		err := s.streamWorkItemsInDir(ctx, dir, func(item WorkItem) error {
+			item.Archived = archived
+			if !s.matchesFilter(item, filter) {
+				return nil
+			}
+			return fn(item)
+		})
+		if err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return filtered, nil
+// scopeDirs returns the work item directories a list scope covers
+func (s *WorkItemService) scopeDirs(scope ListScope) ([]string, error) {
+	switch scope {
+	case "", ScopeBacklog:
+		return s.backlogDirs(), nil
+	case ScopeCompleted:
+		return []string{s.config.CompletedDir}, nil
+	case ScopeAll:
+		return append(s.backlogDirs(), s.config.CompletedDir), nil
+	}
+	return nil, &ValidationError{Field: "scope", Value: string(scope), Message: "invalid scope (valid: backlog, completed, all)"}
 }
 
 // GetWorkItem retrieves a specific work item by name from the backlog directory.
@@ -132,10 +261,154 @@ func (s *WorkItemService) ListWorkItems(ctx context.Context, filter ListFilter)
 //	}
 //	fmt.Printf("Work item: %s, Status: %s\n", item.Name, item.Status)
 func (s *WorkItemService) GetWorkItem(ctx context.Context, name string) (*WorkItem, error) {
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "get", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "get", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	s.warnPhaseMismatch(item)
+	s.checkProgressMismatch(&item, true)
+
+	return &item, nil
+}
+
+// missingReadmeError explains why a work item directory has no README.md:
+// either the directory is gone entirely, or only the README was removed.
+func (s *WorkItemService) missingReadmeError(dir string) error {
+	if s.fs.DirectoryExists(dir) {
+		return fmt.Errorf("%w: %s (run 'go-pm repair %s' to regenerate it)", ErrReadmeMissing, s.config.WorkItemFile, filepath.Base(dir))
+	}
+	return ErrWorkItemNotFound
+}
+
+// RepairWorkItem regenerates a minimal README.md for a backlog directory whose
+// README is missing. The type is inferred from the directory prefix and the
+// README is rendered from the type's template, so the item restarts as PROPOSED.
+func (s *WorkItemService) RepairWorkItem(ctx context.Context, name string) (*WorkItem, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	dir := s.itemDir(name)
+	readmePath := filepath.Join(dir, s.config.WorkItemFile)
+
+	if !s.fs.DirectoryExists(dir) {
+		return nil, &WorkItemError{Op: "repair", Name: name, Err: ErrWorkItemNotFound}
+	}
+	if s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "repair", Name: name, Err: fmt.Errorf("%s already exists, nothing to repair", s.config.WorkItemFile)}
+	}
+
+	itemType := itemTypeFromDirName(name)
+	if itemType == "" {
+		return nil, &ValidationError{Field: "name", Value: name, Message: "cannot infer work item type from directory name"}
+	}
+
+	baseName := strings.TrimPrefix(name, string(itemType)+"-")
+	if err := s.templater.ProcessTemplate(readmePath, baseName, itemType, nil); err != nil {
+		return nil, &WorkItemError{Op: "repair", Name: name, Err: fmt.Errorf("failed to process template: %w", err)}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "repair", Name: name, Err: fmt.Errorf("failed to parse repaired work item: %w", err)}
+	}
+
+	return &item, nil
+}
+
+// FindMissingReadmes lists backlog directories that have no README.md
+func (s *WorkItemService) FindMissingReadmes(ctx context.Context) ([]string, error) {
+	var missing []string
+	for _, backlogDir := range s.backlogDirs() {
+		dirs, err := s.fs.ListDirectories(backlogDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list backlog directories: %w", err)
+		}
+
+		for _, name := range dirs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if backlogDir == s.config.BacklogDir && s.isStatusDir(name) {
+				continue
+			}
+			if !s.fs.FileExists(filepath.Join(backlogDir, name, s.config.WorkItemFile)) {
+				missing = append(missing, name)
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+// FindOrphanedWorkItems reports work items left between the backlog and the
+// completed directory by an interrupted archive: items archived without a
+// postmortem, and items present in both directories. Each problem's Value is
+// the work item name.
+func (s *WorkItemService) FindOrphanedWorkItems(ctx context.Context) ([]ValidationError, error) {
+	if !s.fs.DirectoryExists(s.config.CompletedDir) {
+		return nil, nil
+	}
+	dirs, err := s.fs.ListDirectories(s.config.CompletedDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list completed directories: %w", err)
+	}
+
+	var orphaned []ValidationError
+	for _, name := range dirs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		switch {
+		case s.fs.DirectoryExists(s.itemDir(name)):
+			orphaned = append(orphaned, ValidationError{Field: "archive", Value: name,
+				Message: "work item is in both the backlog and the completed directory; remove the stale copy"})
+		case s.isHalfArchived(name):
+			orphaned = append(orphaned, ValidationError{Field: "archive", Value: name,
+				Message: fmt.Sprintf("archived without a postmortem, likely interrupted (run 'go-pm archive %s' to finish it)", name)})
+		}
+	}
+	return orphaned, nil
+}
+
+// GetWorkItemByPath retrieves a work item from its directory path.
+// The directory may be in the backlog, the completed directory, or anywhere
+// else; the work item name is inferred from the directory basename. A path
+// pointing at the README.md file itself is also accepted.
+//
+// Example:
+//
+//	item, err := service.GetWorkItemByPath(ctx, "work-items/completed/feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Work item: %s, Status: %s\n", item.Name, item.Status)
+func (s *WorkItemService) GetWorkItemByPath(ctx context.Context, path string) (*WorkItem, error) {
+	dir := filepath.Clean(path)
+	if filepath.Base(dir) == s.config.WorkItemFile {
+		dir = filepath.Dir(dir)
+	}
+
+	name := filepath.Base(dir)
+	readmePath := filepath.Join(dir, s.config.WorkItemFile)
 
 	if !s.fs.FileExists(readmePath) {
-		return nil, &WorkItemError{Op: "get", Name: name, Err: fmt.Errorf("work item not found")}
+		return nil, &WorkItemError{Op: "get", Name: name, Err: fmt.Errorf("%w at %s", s.missingReadmeError(dir), dir)}
 	}
 
 	item, err := s.parser.ParseWorkItem(name, readmePath)
@@ -157,13 +430,24 @@ func (s *WorkItemService) GetWorkItem(ctx context.Context, name string) (*WorkIt
 //		log.Fatal(err)
 //	}
 func (s *WorkItemService) UpdateStatus(ctx context.Context, name string, status ItemStatus) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
 	if err := s.validateStatus(status); err != nil {
 		return err
 	}
 
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
 	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "update", Name: name, Err: fmt.Errorf("work item not found")}
+		return &WorkItemError{Op: "update", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "update", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
 	}
 
 	// Update status in file
@@ -171,17 +455,40 @@ func (s *WorkItemService) UpdateStatus(ctx context.Context, name string, status
 		return &WorkItemError{Op: "update", Name: name, Err: fmt.Errorf("failed to update status: %w", err)}
 	}
 
-	// Move to appropriate directory based on status (future enhancement)
-	// For now, items stay in backlog until archived
+	s.recordChanges(filepath.Dir(readmePath), fieldChange{field: HistoryFieldStatus, old: string(item.Status), new: string(status)})
+
+	// Move to the matching status directory when those are enabled; without
+	// them items stay in the backlog until archived
+	if err := s.relocateByStatus(name, status); err != nil {
+		return &WorkItemError{Op: "update", Name: name, Err: err}
+	}
 
+	s.autoCommit(fmt.Sprintf("pm: set %s status to %s", name, status), filepath.Dir(readmePath), s.itemDir(name))
 	return nil
 }
 
+// autoCommit commits the work item directories paths with message when git
+// and auto_commit are enabled. Directories are deduplicated, so a work item
+// that didn't move between status directories is passed once. Like branch
+// creation, a failed commit is only a warning.
+func (s *WorkItemService) autoCommit(message string, paths ...string) {
+	if !s.config.EnableGit || !s.config.AutoCommit {
+		return
+	}
+	slices.Sort(paths)
+	if err := s.git.CommitWorkItem(slices.Compact(paths), message); err != nil {
+		fmt.Printf("Warning: Git commit failed: %v\n", err)
+	}
+}
+
 // ArchiveWorkItem moves a completed work item to the completed directory.
 // It creates a postmortem template and moves the entire work item directory
 // from the backlog to the completed location. The work item should be in
 // COMPLETED status before archiving.
 //
+// Archiving is idempotent: if a previous archive was interrupted after the
+// move but before the postmortem was written, archiving again writes it.
+//
 // Example:
 //
 //	err := service.ArchiveWorkItem(ctx, "feature-user-auth")
@@ -190,10 +497,22 @@ func (s *WorkItemService) UpdateStatus(ctx context.Context, name string, status
 //	}
 //	// Work item is now in completed/ directory with postmortem template
 func (s *WorkItemService) ArchiveWorkItem(ctx context.Context, name string) error {
-	source := filepath.Join(s.config.BacklogDir, name)
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	source := s.itemDir(name)
 	dest := filepath.Join(s.config.CompletedDir, name)
 
 	if !s.fs.DirectoryExists(source) {
+		// Finish an archive that died between the move and the postmortem
+		if s.isHalfArchived(name) {
+			s.writeArchivePostmortem(name, dest)
+			return nil
+		}
 		return &WorkItemError{Op: "archive", Name: name, Err: fmt.Errorf("work item not found in backlog")}
 	}
 
@@ -207,171 +526,657 @@ func (s *WorkItemService) ArchiveWorkItem(ctx context.Context, name string) erro
 		return &WorkItemError{Op: "archive", Name: name, Err: fmt.Errorf("failed to move work item: %w", err)}
 	}
 
-	// Generate postmortem
-	if err := s.postmortem.GeneratePostmortem(dest, name); err != nil {
+	s.writeArchivePostmortem(name, dest)
+	return nil
+}
+
+// writeArchivePostmortem generates the postmortem template of a work item
+// moved to dest, warning rather than failing when it can't be written
+func (s *WorkItemService) writeArchivePostmortem(name, dest string) {
+	item, err := s.parser.ParseWorkItem(name, filepath.Join(dest, s.config.WorkItemFile))
+	if err != nil {
+		item = WorkItem{Name: name}
+	}
+	if err := s.postmortem.GeneratePostmortem(dest, item); err != nil {
 		fmt.Printf("Warning: Could not create postmortem template: %v\n", err)
 	}
+}
 
-	return nil
+// isHalfArchived reports whether name was moved to the completed directory
+// but has no postmortem, i.e. its archive was interrupted
+func (s *WorkItemService) isHalfArchived(name string) bool {
+	dest := filepath.Join(s.config.CompletedDir, name)
+	return s.fs.FileExists(filepath.Join(dest, s.config.WorkItemFile)) &&
+		!s.fs.FileExists(filepath.Join(dest, postmortemFile))
 }
 
-// SetPhase sets the phase of a work item to a specific value (admin override).
-// This bypasses normal phase advancement rules and should be used with caution.
-// The phase must be a valid WorkPhase constant.
+// UnarchiveWorkItem moves an archived work item from the completed directory
+// back into the backlog, for items archived prematurely. Its POSTMORTEM.md
+// stays in place. With reopen, the item is also set back to
+// IN_PROGRESS_REVIEW in the cleanup phase; otherwise its status is unchanged.
 //
 // Example:
 //
-//	err := service.SetPhase(ctx, "feature-user-auth", PhaseExecution)
+//	item, err := service.UnarchiveWorkItem(ctx, "feature-user-auth", true)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	// Work item phase is now set to execution regardless of current state
-func (s *WorkItemService) SetPhase(ctx context.Context, name string, phase WorkPhase) error {
-	if err := s.validatePhase(phase); err != nil {
-		return err
+//	fmt.Printf("Unarchived %s (%s)\n", item.Name, item.Status)
+func (s *WorkItemService) UnarchiveWorkItem(ctx context.Context, name string, reopen bool) (*WorkItem, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
 	}
 
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
-	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "set_phase", Name: name, Err: fmt.Errorf("work item not found")}
+	source := filepath.Join(s.config.CompletedDir, name)
+	if !s.fs.DirectoryExists(source) {
+		return nil, &WorkItemError{Op: "unarchive", Name: name, Err: fmt.Errorf("%w in completed directory", ErrWorkItemNotFound)}
+	}
+	if s.fs.DirectoryExists(s.itemDir(name)) {
+		return nil, &WorkItemError{Op: "unarchive", Name: name, Err: fmt.Errorf("work item already exists in backlog")}
 	}
 
-	// Update phase in file
-	if err := s.updater.UpdatePhase(readmePath, phase); err != nil {
-		return &WorkItemError{Op: "set_phase", Name: name, Err: fmt.Errorf("failed to update phase: %w", err)}
+	if err := s.fs.CreateDirectory(s.config.BacklogDir); err != nil {
+		return nil, &WorkItemError{Op: "unarchive", Name: name, Err: fmt.Errorf("failed to create backlog directory: %w", err)}
+	}
+	dest := filepath.Join(s.config.BacklogDir, name)
+	if err := s.fs.MoveDirectory(source, dest); err != nil {
+		return nil, &WorkItemError{Op: "unarchive", Name: name, Err: fmt.Errorf("failed to move work item: %w", err)}
 	}
 
-	return nil
+	item, err := s.GetWorkItem(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if reopen && item.Status != StatusInProgressReview {
+		readmePath := filepath.Join(dest, s.config.WorkItemFile)
+		if err := s.updater.UpdatePhaseAndStatus(readmePath, PhaseCleanup, StatusInProgressReview); err != nil {
+			return nil, &WorkItemError{Op: "unarchive", Name: name, Err: fmt.Errorf("failed to update status: %w", err)}
+		}
+		s.recordChanges(dest,
+			fieldChange{field: HistoryFieldStatus, old: string(item.Status), new: string(StatusInProgressReview)},
+			fieldChange{field: HistoryFieldPhase, old: string(item.Phase), new: string(PhaseCleanup)},
+		)
+		item.Status, item.Phase = StatusInProgressReview, PhaseCleanup
+	}
+
+	if err := s.relocateByStatus(name, item.Status); err != nil {
+		return nil, &WorkItemError{Op: "unarchive", Name: name, Err: err}
+	}
+	item.Path = filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	return item, nil
 }
 
-// GetPhaseTasks returns all tasks for the current phase of a work item.
-// Tasks are parsed from the work item's README.md file and filtered by the
-// work item's current phase. Returns an empty slice if no tasks are found.
+// ArchiveCompletedWorkItems archives every work item in the backlog whose
+// status is COMPLETED. Items in any other status are skipped so in-progress
+// work is never archived by accident. It returns the names of the archived
+// items along with an aggregate of any per-item failures.
 //
 // Example:
 //
-//	tasks, err := service.GetPhaseTasks(ctx, "feature-user-auth")
+//	archived, err := service.ArchiveCompletedWorkItems(ctx)
 //	if err != nil {
-//		log.Fatal(err)
+//		log.Printf("some items could not be archived: %v", err)
 //	}
-//	for i, task := range tasks {
-//		status := "[ ]"
-//		if task.Completed {
-//			status = "[x]"
-//		}
-//		fmt.Printf("%d. %s %s\n", i, status, task.Description)
+//	for _, name := range archived {
+//		fmt.Printf("Archived: %s\n", name)
 //	}
-func (s *WorkItemService) GetPhaseTasks(ctx context.Context, name string) ([]Task, error) {
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
-	if !s.fs.FileExists(readmePath) {
-		return nil, &WorkItemError{Op: "get_phase_tasks", Name: name, Err: fmt.Errorf("work item not found")}
-	}
-
-	// Get current work item to determine phase
-	item, err := s.parser.ParseWorkItem(name, readmePath)
+func (s *WorkItemService) ArchiveCompletedWorkItems(ctx context.Context) ([]string, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{Status: StatusCompleted})
 	if err != nil {
-		return nil, &WorkItemError{Op: "get_phase_tasks", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+		return nil, err
 	}
 
-	// Filter tasks by current phase
-	var phaseTasks []Task
-	for _, task := range item.Tasks {
-		if task.Phase == item.Phase {
-			phaseTasks = append(phaseTasks, task)
+	var archived []string
+	var errs []error
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return archived, errors.Join(append(errs, err)...)
 		}
+		if err := s.ArchiveWorkItem(ctx, item.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		archived = append(archived, item.Name)
 	}
 
-	return phaseTasks, nil
+	return archived, errors.Join(errs...)
 }
 
-// GetProgressMetrics returns detailed progress metrics for a work item.
-// This includes task completion statistics, phase progress, and overall
-// completion percentage. The metrics are calculated from the work item's
-// current state and task completion status.
+// BuildDigest builds a digest of backlog work items that need follow-up:
+// in-progress items not updated within PhaseTimeoutDays (stale), unfinished
+// items past their due date (overdue) and unfinished items waiting on
+// dependencies that aren't COMPLETED (blocked, as ListBlocked reports them).
+// The digest content is independent of delivery; render it with
+// Digest.Markdown.
 //
 // Example:
 //
-//	metrics, err := service.GetProgressMetrics(ctx, "feature-user-auth")
+//	digest, err := service.BuildDigest(ctx)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	// The returned WorkItemMetrics contains OverallProgress, TotalTasks and
-//	// CompletedTasks fields. Use them to display a concise progress summary:
-//	fmt.Printf("Progress: %d%% (%d/%d tasks completed)\n", metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
-func (s *WorkItemService) GetProgressMetrics(ctx context.Context, name string) (*WorkItemMetrics, error) {
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
-	if !s.fs.FileExists(readmePath) {
-		return nil, &WorkItemError{Op: "get_progress_metrics", Name: name, Err: fmt.Errorf("work item not found")}
+//	fmt.Print(digest.Markdown(nil))
+func (s *WorkItemService) BuildDigest(ctx context.Context) (Digest, error) {
+	digest := Digest{GeneratedAt: time.Now()}
+
+	items, err := s.ListWorkItems(ctx, ListFilter{})
+	if err != nil {
+		return digest, err
 	}
 
-	// Get current work item
-	item, err := s.parser.ParseWorkItem(name, readmePath)
+	for _, item := range items {
+		if isStale(item, s.config.PhaseTimeoutDays, digest.GeneratedAt) {
+			digest.Stale = append(digest.Stale, item)
+		}
+		if isOverdue(item, digest.GeneratedAt) {
+			digest.Overdue = append(digest.Overdue, item)
+		}
+	}
+
+	blocked, err := s.ListBlocked(ctx)
 	if err != nil {
-		return nil, &WorkItemError{Op: "get_progress_metrics", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+		return digest, err
 	}
+	digest.Blocked = blocked
 
-	// Calculate metrics
-	metrics := s.progress.CalculateWorkItemMetrics(&item)
-	return &metrics, nil
+	return digest, nil
 }
 
-// CompleteTask marks a specific task as completed in a work item.
-// The taskId corresponds to the index of the task in the current phase's task list.
-// Task IDs can be obtained using GetPhaseTasks(). This updates the work item's
-// README.md file to mark the task as completed ([x] instead of [ ]).
+// GetBacklogStats computes summary counts for the work items in scope (the
+// backlog when empty), such as items per status and type, average progress,
+// and stale/overdue totals.
+func (s *WorkItemService) GetBacklogStats(ctx context.Context, scope ListScope) (BacklogStats, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{Scope: scope})
+	if err != nil {
+		return BacklogStats{}, err
+	}
+
+	return newBacklogStats(items, s.config.PhaseTimeoutDays, time.Now()), nil
+}
+
+// GetAssigneeWorkload groups the unfinished work items by assignee, counting
+// in-progress items, tasks and overdue items for each. Items without an
+// assignee are grouped under UnassignedKey.
+func (s *WorkItemService) GetAssigneeWorkload(ctx context.Context) (map[string]AssigneeStats, error) {
+	items, err := s.ListWorkItems(ctx, ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAssigneeWorkload(items, time.Now()), nil
+}
+
+// GetVelocity counts the tasks completed in each of the last weeks weeks,
+// oldest first and ending with the current week, across the backlog and
+// archived work items. Completion dates come from the "(done: YYYY-MM-DD)"
+// annotations CompleteTask records.
+func (s *WorkItemService) GetVelocity(ctx context.Context, weeks int) ([]WeeklyVelocity, error) {
+	if weeks <= 0 {
+		return nil, &ValidationError{Field: "weeks", Value: fmt.Sprintf("%d", weeks), Message: "weeks must be positive"}
+	}
+
+	items, err := s.ListWorkItems(ctx, ListFilter{Scope: ScopeAll})
+	if err != nil {
+		return nil, err
+	}
+
+	return newVelocity(items, weeks, time.Now()), nil
+}
+
+// GetCompletionTimeseries counts the tasks completed in each bucket (BucketDay,
+// BucketWeek, or any whole number of days), from the first completion through
+// now, across the backlog and archived work items. Completion dates come from
+// the "(done: YYYY-MM-DD)" annotations CompleteTask records; tasks without one
+// contribute nothing. Each bucket carries a running total for burn-up charts.
 //
 // Example:
 //
-//	// Get tasks first to find the right task ID
-//	tasks, err := service.GetPhaseTasks(ctx, "feature-user-auth")
+//	series, err := service.GetCompletionTimeseries(ctx, BucketWeek)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	// Complete the first task (index 0)
-//	err = service.CompleteTask(ctx, "feature-user-auth", 0)
-//	if err != nil {
-//		log.Fatal(err)
+//	for _, b := range series {
+//		fmt.Printf("%s: %d (%d total)\n", b.Start.Format("2006-01-02"), b.Completed, b.Cumulative)
 //	}
-func (s *WorkItemService) CompleteTask(ctx context.Context, name string, taskId int) error {
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
-	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "complete_task", Name: name, Err: fmt.Errorf("work item not found")}
+func (s *WorkItemService) GetCompletionTimeseries(ctx context.Context, bucket time.Duration) ([]TimeBucket, error) {
+	if bucket < BucketDay || bucket%BucketDay != 0 {
+		return nil, &ValidationError{Field: "bucket", Value: bucket.String(), Message: "bucket must be a whole number of days"}
 	}
 
-	// Get current work item to find the task
-	item, err := s.parser.ParseWorkItem(name, readmePath)
+	items, err := s.ListWorkItems(ctx, ListFilter{Scope: ScopeAll})
 	if err != nil {
-		return &WorkItemError{Op: "complete_task", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+		return nil, err
 	}
 
-	// Filter tasks by current phase to get phase-specific tasks
-	var phaseTasks []Task
+	return newCompletionTimeseries(items, bucket, time.Now()), nil
+}
+
+// BuildBurndown tracks how many tasks of the named work items were left on
+// each day, from the earliest creation or task completion through today, for
+// sprint tracking. The ideal line burns the tasks down at a steady pace to the
+// latest due date among the items, or to today when none has one. Completion
+// dates come from the "(done: YYYY-MM-DD)" annotations CompleteTask records;
+// completed tasks without one are left out.
+//
+// Example:
+//
+//	burndown, err := service.BuildBurndown(ctx, []string{"feature-login", "bug-crash"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, p := range burndown.Points {
+//		fmt.Printf("%s: %d left (ideal %.1f)\n", p.Date.Format("2006-01-02"), p.Remaining, p.Ideal)
+//	}
+func (s *WorkItemService) BuildBurndown(ctx context.Context, names []string) (Burndown, error) {
+	if len(names) == 0 {
+		return Burndown{}, &ValidationError{Field: "names", Value: "", Message: "at least one work item is required"}
+	}
+
+	items := make([]WorkItem, 0, len(names))
+	for _, name := range names {
+		item, err := s.GetWorkItem(ctx, name)
+		if err != nil {
+			return Burndown{}, err
+		}
+		items = append(items, *item)
+	}
+
+	return newBurndown(items, time.Now()), nil
+}
+
+// GetPhaseTimeline returns the phases a work item has visited, in order, with
+// when each was entered and how long it lasted. The current phase's duration
+// runs up to now (or up to completion). The timeline is the README's
+// "## Phase History" (WorkItem.PhaseHistory), the same record progress
+// reports use; items without that section fall back to the phase changes in
+// their history.jsonl log, so items created before either have none.
+//
+// Example:
+//
+//	timeline, err := service.GetPhaseTimeline(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, t := range timeline {
+//		fmt.Printf("%s: %v\n", t.Phase, t.Duration)
+//	}
+func (s *WorkItemService) GetPhaseTimeline(ctx context.Context, name string) ([]PhaseTransition, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+	dir := s.itemDir(name)
+	readmePath := filepath.Join(dir, s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "phase_timeline", Name: name, Err: s.missingReadmeError(dir)}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "phase_timeline", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	if len(item.PhaseHistory) > 0 {
+		return item.PhaseHistory, nil
+	}
+
+	entries, err := s.readHistory(dir)
+	if err != nil {
+		return nil, &WorkItemError{Op: "phase_timeline", Name: name, Err: fmt.Errorf("failed to read history: %w", err)}
+	}
+
+	return buildPhaseTimeline(entries, time.Now()), nil
+}
+
+// GetStatusDurations returns how long a work item has spent in each status,
+// summed over repeat visits, including the time in its current status up to
+// now. COMPLETED accrues no time. Durations are read from the work item's
+// history.jsonl log, so items created before history logging have none.
+//
+// Example:
+//
+//	durations, err := service.GetStatusDurations(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("In review for %v\n", durations[StatusInProgressReview])
+func (s *WorkItemService) GetStatusDurations(ctx context.Context, name string) (map[ItemStatus]time.Duration, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	dir := s.itemDir(name)
+	if !s.fs.FileExists(filepath.Join(dir, s.config.WorkItemFile)) {
+		return nil, &WorkItemError{Op: "status_durations", Name: name, Err: s.missingReadmeError(dir)}
+	}
+
+	entries, err := s.readHistory(dir)
+	if err != nil {
+		return nil, &WorkItemError{Op: "status_durations", Name: name, Err: fmt.Errorf("failed to read history: %w", err)}
+	}
+
+	return buildStatusDurations(entries, time.Now()), nil
+}
+
+// AppendNote appends a timestamped note to the work item's NOTES.md file.
+// The file is created with a heading on first use. Notes are kept separate
+// from README.md so scratch content doesn't end up in the tracked document.
+//
+// Example:
+//
+//	err := service.AppendNote(ctx, "feature-user-auth", "Check token refresh edge cases")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) AppendNote(ctx context.Context, name, note string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return &ValidationError{Field: "note", Value: note, Message: "note cannot be empty"}
+	}
+
+	workDir := s.itemDir(name)
+	if !s.fs.FileExists(filepath.Join(workDir, s.config.WorkItemFile)) {
+		return &WorkItemError{Op: "append_note", Name: name, Err: s.missingReadmeError(workDir)}
+	}
+
+	notesPath := filepath.Join(workDir, notesFileName)
+	content := fmt.Sprintf("# Notes: %s\n", name)
+	if s.fs.FileExists(notesPath) {
+		data, err := s.fs.ReadFile(notesPath)
+		if err != nil {
+			return &WorkItemError{Op: "append_note", Name: name, Err: fmt.Errorf("failed to read notes: %w", err)}
+		}
+		content = strings.TrimRight(string(data), "\n") + "\n"
+	}
+
+	content += fmt.Sprintf("\n## %s\n\n%s\n", time.Now().Format("2006-01-02 15:04"), note)
+
+	if err := s.fs.WriteFile(notesPath, []byte(content)); err != nil {
+		return &WorkItemError{Op: "append_note", Name: name, Err: fmt.Errorf("failed to write notes: %w", err)}
+	}
+
+	return nil
+}
+
+// GetNotes returns the contents of the work item's NOTES.md file.
+// Returns an empty string if the work item has no notes yet.
+//
+// Example:
+//
+//	notes, err := service.GetNotes(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(notes)
+func (s *WorkItemService) GetNotes(ctx context.Context, name string) (string, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return "", err
+	}
+
+	workDir := s.itemDir(name)
+	if !s.fs.FileExists(filepath.Join(workDir, s.config.WorkItemFile)) {
+		return "", &WorkItemError{Op: "get_notes", Name: name, Err: s.missingReadmeError(workDir)}
+	}
+
+	notesPath := filepath.Join(workDir, notesFileName)
+	if !s.fs.FileExists(notesPath) {
+		return "", nil
+	}
+
+	data, err := s.fs.ReadFile(notesPath)
+	if err != nil {
+		return "", &WorkItemError{Op: "get_notes", Name: name, Err: fmt.Errorf("failed to read notes: %w", err)}
+	}
+
+	return string(data), nil
+}
+
+// SetPhase sets the phase of a work item to a specific value (admin override).
+// This bypasses normal phase advancement rules and should be used with caution.
+// The phase must be a valid WorkPhase constant.
+//
+// Example:
+//
+//	err := service.SetPhase(ctx, "feature-user-auth", PhaseExecution)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// Work item phase is now set to execution regardless of current state
+func (s *WorkItemService) SetPhase(ctx context.Context, name string, phase WorkPhase) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if err := s.validatePhase(phase); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "set_phase", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "set_phase", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	// Update phase in file
+	if err := s.updater.UpdatePhase(readmePath, phase); err != nil {
+		return &WorkItemError{Op: "set_phase", Name: name, Err: fmt.Errorf("failed to update phase: %w", err)}
+	}
+	if phase != item.Phase {
+		if err := s.updater.AppendPhaseHistory(readmePath, phase, time.Now()); err != nil {
+			return &WorkItemError{Op: "set_phase", Name: name, Err: fmt.Errorf("failed to record phase history: %w", err)}
+		}
+	}
+
+	s.recordChanges(filepath.Dir(readmePath), fieldChange{field: HistoryFieldPhase, old: string(item.Phase), new: string(phase)})
+
+	return nil
+}
+
+// SyncPhaseTasks adds tasks from the work item type's template that are missing
+// from the item's current phase section, matching by description
+// (case-insensitively). New tasks are appended unchecked and progress is
+// recalculated. Items already containing every template task are left unchanged.
+//
+// Example:
+//
+//	err := service.SyncPhaseTasks(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) SyncPhaseTasks(ctx context.Context, name string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "sync_tasks", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "sync_tasks", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	if item.Type == "" {
+		return &ValidationError{Field: "name", Value: name, Message: "cannot infer work item type from directory name"}
+	}
+
+	template, err := s.templater.RenderTemplate(strings.TrimPrefix(name, string(item.Type)+"-"), item.Type, nil)
+	if err != nil {
+		return &WorkItemError{Op: "sync_tasks", Name: name, Err: fmt.Errorf("failed to render template: %w", err)}
+	}
+
+	existing := make(map[string]bool)
 	for _, task := range item.Tasks {
 		if task.Phase == item.Phase {
-			phaseTasks = append(phaseTasks, task)
+			existing[strings.ToLower(task.Description)] = true
 		}
 	}
 
-	// Validate task ID against phase tasks
-	if taskId < 0 || taskId >= len(phaseTasks) {
-		return &ValidationError{Field: "taskId", Value: fmt.Sprintf("%d", taskId), Message: "invalid task ID for current phase"}
+	var missing []string
+	for _, description := range phaseTaskDescriptions(template, item.Phase) {
+		if !existing[strings.ToLower(description)] {
+			missing = append(missing, description)
+			existing[strings.ToLower(description)] = true
+		}
+	}
+	if len(missing) == 0 {
+		return nil
 	}
 
-	// Find the global index of the phase task
-	globalTaskId := -1
-	phaseTaskIndex := 0
-	for i, task := range item.Tasks {
+	if err := s.updater.AppendPhaseTasks(readmePath, item.Phase, missing); err != nil {
+		return &WorkItemError{Op: "sync_tasks", Name: name, Err: fmt.Errorf("failed to add tasks: %w", err)}
+	}
+
+	if err := s.updateProgressFromTasks(readmePath); err != nil {
+		return &WorkItemError{Op: "sync_tasks", Name: name, Err: fmt.Errorf("failed to update progress: %w", err)}
+	}
+
+	return nil
+}
+
+// GetPhaseTasks returns all tasks for the current phase of a work item.
+// Tasks are parsed from the work item's README.md file and filtered by the
+// work item's current phase. Returns an empty slice if no tasks are found.
+// A "## Phase:" the build doesn't know, which no task could be filed under,
+// is reported as a ValidationError rather than as an empty task list.
+//
+// Example:
+//
+//	tasks, err := service.GetPhaseTasks(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for i, task := range tasks {
+//		status := "[ ]"
+//		if task.Completed {
+//			status = "[x]"
+//		}
+//		fmt.Printf("%d. %s %s\n", i, status, task.Description)
+//	}
+func (s *WorkItemService) GetPhaseTasks(ctx context.Context, name string) ([]Task, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "get_phase_tasks", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	// Get current work item to determine phase
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "get_phase_tasks", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	if unknown := checkKnownPhase(item); unknown != nil {
+		return nil, &WorkItemError{Op: "get_phase_tasks", Name: name, Err: fmt.Errorf("%w (run 'go-pm repair --fix-phase %s' to fix it)", unknown, name)}
+	}
+	s.warnPhaseMismatch(item)
+
+	// Filter tasks by current phase
+	phaseTasks := []Task{}
+	for _, task := range item.Tasks {
 		if task.Phase == item.Phase {
-			if phaseTaskIndex == taskId {
-				globalTaskId = i
-				break
-			}
-			phaseTaskIndex++
+			phaseTasks = append(phaseTasks, task)
 		}
 	}
 
-	if globalTaskId == -1 {
-		return &ValidationError{Field: "taskId", Value: fmt.Sprintf("%d", taskId), Message: "could not find task"}
+	return phaseTasks, nil
+}
+
+// GetProgressMetrics returns detailed progress metrics for a work item.
+// This includes task completion statistics, phase progress, and overall
+// completion percentage. The metrics are calculated from the work item's
+// current state and task completion status.
+//
+// Example:
+//
+//	metrics, err := service.GetProgressMetrics(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// The returned WorkItemMetrics contains OverallProgress, TotalTasks and
+//	// CompletedTasks fields. Use them to display a concise progress summary:
+//	fmt.Printf("Progress: %d%% (%d/%d tasks completed)\n", metrics.OverallProgress, metrics.CompletedTasks, metrics.TotalTasks)
+func (s *WorkItemService) GetProgressMetrics(ctx context.Context, name string) (*WorkItemMetrics, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "get_progress_metrics", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	// Get current work item
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "get_progress_metrics", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	// Calculate metrics
+	metrics := s.progress.CalculateWorkItemMetrics(&item)
+	return &metrics, nil
+}
+
+// CompleteTask marks a specific task as completed in a work item.
+// The taskId corresponds to the index of the task in the current phase's task list.
+// Task IDs can be obtained using GetPhaseTasks(). This updates the work item's
+// README.md file to mark the task as completed ([x] instead of [ ]).
+//
+// Example:
+//
+//	// Get tasks first to find the right task ID
+//	tasks, err := service.GetPhaseTasks(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// Complete the first task (index 0)
+//	err = service.CompleteTask(ctx, "feature-user-auth", 0)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) CompleteTask(ctx context.Context, name string, taskId int) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "complete_task", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	// Get current work item to find the task
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "complete_task", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	globalTaskId, err := globalTaskIndex(item, taskId)
+	if err != nil {
+		return err
 	}
 
 	// Mark task as completed in file using global index
@@ -388,6 +1193,219 @@ func (s *WorkItemService) CompleteTask(ctx context.Context, name string, taskId
 	return nil
 }
 
+// globalTaskIndex returns the index in item.Tasks of the current phase's task
+// taskId, as listed by GetPhaseTasks
+func globalTaskIndex(item WorkItem, taskId int) (int, error) {
+	phaseTaskIndex := 0
+	for i, task := range item.Tasks {
+		if task.Phase == item.Phase {
+			if phaseTaskIndex == taskId {
+				return i, nil
+			}
+			phaseTaskIndex++
+		}
+	}
+	return -1, &ValidationError{Field: "taskId", Value: fmt.Sprintf("%d", taskId), Message: "invalid task ID for current phase"}
+}
+
+// UncompleteTask reopens a completed task in the current phase ("- [x]" back
+// to "- [ ]"), dropping its "(done: YYYY-MM-DD)" annotation, to undo an
+// accidental completion. Progress is recalculated as for CompleteTask. Task IDs
+// are the same as for CompleteTask.
+//
+// Example:
+//
+//	err := service.UncompleteTask(ctx, "feature-user-auth", 2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) UncompleteTask(ctx context.Context, name string, taskId int) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "uncomplete_task", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "uncomplete_task", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	globalTaskId, err := globalTaskIndex(item, taskId)
+	if err != nil {
+		return err
+	}
+	if !item.Tasks[globalTaskId].Completed {
+		return &ValidationError{Field: "taskId", Value: fmt.Sprintf("%d", taskId), Message: "task is not completed"}
+	}
+
+	if err := s.updater.UncompleteTask(readmePath, globalTaskId); err != nil {
+		return &WorkItemError{Op: "uncomplete_task", Name: name, Err: fmt.Errorf("failed to uncomplete task: %w", err)}
+	}
+
+	if err := s.updateProgressFromTasks(readmePath); err != nil {
+		return &WorkItemError{Op: "uncomplete_task", Name: name, Err: fmt.Errorf("failed to update progress: %w", err)}
+	}
+	return nil
+}
+
+// MarkTaskNotApplicable marks an open task in the current phase as not
+// applicable ("- [~]"), for template tasks that don't apply to this work item.
+// N/A tasks don't block advancing the phase, and are left out of the progress
+// percentage rather than counted as completed. Task IDs are the same as for
+// CompleteTask.
+//
+// Example:
+//
+//	err := service.MarkTaskNotApplicable(ctx, "feature-user-auth", 2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) MarkTaskNotApplicable(ctx context.Context, name string, taskId int) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "task_na", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "task_na", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	globalTaskId, err := globalTaskIndex(item, taskId)
+	if err != nil {
+		return err
+	}
+	if item.Tasks[globalTaskId].Completed {
+		return &ValidationError{Field: "taskId", Value: fmt.Sprintf("%d", taskId), Message: "task is already completed"}
+	}
+
+	if err := s.updater.MarkTaskNotApplicable(readmePath, globalTaskId); err != nil {
+		return &WorkItemError{Op: "task_na", Name: name, Err: fmt.Errorf("failed to mark task not applicable: %w", err)}
+	}
+
+	if err := s.updateProgressFromTasks(readmePath); err != nil {
+		return &WorkItemError{Op: "task_na", Name: name, Err: fmt.Errorf("failed to update progress: %w", err)}
+	}
+	return nil
+}
+
+// AssignTask assigns a task in the current phase to assignee by annotating the
+// task line with "(@assignee)", replacing any existing annotation. A leading
+// "@" is optional; an empty assignee removes the annotation, so the task falls
+// back to the work item assignee. Task IDs are the same as for CompleteTask.
+//
+// Example:
+//
+//	err := service.AssignTask(ctx, "feature-user-auth", 2, "alice")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) AssignTask(ctx context.Context, name string, taskId int, assignee string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	assignee = strings.TrimPrefix(strings.TrimSpace(assignee), "@")
+	if strings.ContainsAny(assignee, " \t()@") {
+		return &ValidationError{Field: "assignee", Value: assignee, Message: "assignee cannot contain whitespace, parentheses or '@'"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "assign_task", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "assign_task", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	globalTaskId, err := globalTaskIndex(item, taskId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.updater.AssignTask(readmePath, globalTaskId, assignee); err != nil {
+		return &WorkItemError{Op: "assign_task", Name: name, Err: fmt.Errorf("failed to assign task: %w", err)}
+	}
+	return nil
+}
+
+// CompleteTaskByDescription marks the incomplete task in the current phase whose
+// description contains query (case-insensitive) as completed. It returns a
+// ValidationError when no task or more than one task matches.
+//
+// Example:
+//
+//	err := service.CompleteTaskByDescription(ctx, "feature-user-auth", "write tests")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) CompleteTaskByDescription(ctx context.Context, name, query string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &ValidationError{Field: "query", Value: query, Message: "task query cannot be empty"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "complete_task", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return &WorkItemError{Op: "complete_task", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	// Match against incomplete tasks, keeping their phase-relative IDs
+	var matches []string
+	taskId := -1
+	phaseTaskIndex := 0
+	for _, task := range item.Tasks {
+		if task.Phase != item.Phase {
+			continue
+		}
+		if !task.Resolved() && strings.Contains(strings.ToLower(task.Description), strings.ToLower(query)) {
+			matches = append(matches, task.Description)
+			taskId = phaseTaskIndex
+		}
+		phaseTaskIndex++
+	}
+
+	switch len(matches) {
+	case 0:
+		return &ValidationError{Field: "query", Value: query, Message: "no incomplete task in the current phase matches"}
+	case 1:
+		return s.CompleteTask(ctx, name, taskId)
+	default:
+		return &ValidationError{Field: "query", Value: query, Message: fmt.Sprintf("matches %d tasks: %s", len(matches), strings.Join(matches, "; "))}
+	}
+}
+
 // UpdateProgress updates the overall progress percentage of a work item.
 // Progress should be an integer between 0 and 100 representing completion percentage.
 // This updates the work item's README.md file with the new progress value.
@@ -400,13 +1418,19 @@ func (s *WorkItemService) CompleteTask(ctx context.Context, name string, taskId
 //	}
 //	// Work item now shows 75% progress
 func (s *WorkItemService) UpdateProgress(ctx context.Context, name string, progress int) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
 	if progress < 0 || progress > 100 {
 		return &ValidationError{Field: "progress", Value: fmt.Sprintf("%d", progress), Message: "progress must be between 0 and 100"}
 	}
 
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
 	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "update_progress", Name: name, Err: fmt.Errorf("work item not found")}
+		return &WorkItemError{Op: "update_progress", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
 	}
 
 	// Update progress in file
@@ -417,6 +1441,41 @@ func (s *WorkItemService) UpdateProgress(ctx context.Context, name string, progr
 	return nil
 }
 
+// RecalculateProgress sets a work item's progress from its task completion,
+// for items whose "## Progress:" was edited by hand and drifted from the
+// checklist. Not-applicable tasks aren't counted. Returns the new progress.
+//
+// Example:
+//
+//	progress, err := service.RecalculateProgress(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Progress is now %d%%\n", progress)
+func (s *WorkItemService) RecalculateProgress(ctx context.Context, name string) (int, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return 0, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return 0, &WorkItemError{Op: "recalc_progress", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	if err := s.updateProgressFromTasks(readmePath); err != nil {
+		return 0, &WorkItemError{Op: "recalc_progress", Name: name, Err: fmt.Errorf("failed to update progress: %w", err)}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return 0, &WorkItemError{Op: "recalc_progress", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	return item.Progress, nil
+}
+
 // AssignWorkItem assigns a work item to a specific assignee.
 // The assignee can be "human", "agent", or a specific user identifier.
 // This updates the work item's README.md file with the new assignee.
@@ -435,18 +1494,90 @@ func (s *WorkItemService) UpdateProgress(ctx context.Context, name string, progr
 //		log.Fatal(err)
 //	}
 func (s *WorkItemService) AssignWorkItem(ctx context.Context, name, assignee string) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
 	if assignee == "" {
 		return &ValidationError{Field: "assignee", Value: assignee, Message: "assignee cannot be empty"}
 	}
 
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "assign", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	// Update assignee in file
+	if err := s.updater.UpdateAssignee(readmePath, assignee); err != nil {
+		return &WorkItemError{Op: "assign", Name: name, Err: fmt.Errorf("failed to update assignee: %w", err)}
+	}
+
+	return nil
+}
+
+// SetPriority sets the triage priority of a work item (LOW, MEDIUM, HIGH or
+// CRITICAL), replacing its "## Priority:" heading or adding one.
+//
+// Example:
+//
+//	err := service.SetPriority(ctx, "bug-login-crash", PriorityCritical)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) SetPriority(ctx context.Context, name string, priority Priority) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if err := s.validatePriority(priority); err != nil {
+		return err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return &WorkItemError{Op: "set_priority", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	if err := s.updater.UpdatePriority(readmePath, priority); err != nil {
+		return &WorkItemError{Op: "set_priority", Name: name, Err: fmt.Errorf("failed to update priority: %w", err)}
+	}
+
+	return nil
+}
+
+// SetEstimate sets the total effort estimate of a work item, in hours,
+// written as its "## Estimate:" heading
+//
+// Example:
+//
+//	err := service.SetEstimate(ctx, "feature-user-auth", 16)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (s *WorkItemService) SetEstimate(ctx context.Context, name string, hours float64) error {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return err
+	}
+
+	if hours <= 0 || math.IsInf(hours, 0) || math.IsNaN(hours) {
+		return &ValidationError{Field: "estimate", Value: strconv.FormatFloat(hours, 'f', -1, 64), Message: "estimate must be a positive number of hours"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
 	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "assign", Name: name, Err: fmt.Errorf("work item not found")}
+		return &WorkItemError{Op: "set_estimate", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
 	}
 
-	// Update assignee in file
-	if err := s.updater.UpdateAssignee(readmePath, assignee); err != nil {
-		return &WorkItemError{Op: "assign", Name: name, Err: fmt.Errorf("failed to update assignee: %w", err)}
+	if err := s.updater.UpdateEstimate(readmePath, hours); err != nil {
+		return &WorkItemError{Op: "set_estimate", Name: name, Err: fmt.Errorf("failed to update estimate: %w", err)}
 	}
 
 	return nil
@@ -473,42 +1604,278 @@ func (s *WorkItemService) AssignWorkItem(ctx context.Context, name, assignee str
 //	}
 //	// Work item advances to next phase if all current tasks are completed
 func (s *WorkItemService) AdvancePhase(ctx context.Context, name string) error {
-	readmePath := filepath.Join(s.config.BacklogDir, name, "README.md")
+	_, err := s.AdvancePhaseWithWarnings(ctx, name)
+	return err
+}
+
+// AdvancePhaseWithWarnings advances a work item like AdvancePhase. When
+// Config.PhaseAdvanceStrict is false, incomplete current-phase tasks don't
+// block the advance; they are returned as warnings instead.
+func (s *WorkItemService) AdvancePhaseWithWarnings(ctx context.Context, name string) ([]Task, error) {
+	ctx, unlock := s.lockItem(ctx, name)
+	defer unlock()
+
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
 	if !s.fs.FileExists(readmePath) {
-		return &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("work item not found")}
+		return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
 	}
 
 	// Get current work item to determine next phase
 	item, err := s.parser.ParseWorkItem(name, readmePath)
 	if err != nil {
-		return &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+		return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
 	}
 
-	// Validate that all tasks in current phase are completed
-	if err := s.validatePhaseTasksCompleted(item); err != nil {
-		return err
+	// A completed item has nowhere left to go
+	if item.Status == StatusCompleted {
+		return nil, &AlreadyCompletedError{WorkItem: name}
+	}
+
+	// Validate that all tasks in current phase are completed, or collect
+	// them as warnings when strict validation is disabled
+	var incomplete []Task
+	if s.config.PhaseAdvanceStrict {
+		if err := s.validatePhaseTasksCompleted(item); err != nil {
+			return nil, err
+		}
+	} else {
+		incomplete = s.incompletePhaseTasks(item)
 	}
 
 	// Determine next phase and status
 	nextPhase, nextStatus, err := s.getNextPhase(item.Phase, item.Status)
 	if err != nil {
-		return err
+		var phaseErr *PhaseError
+		if errors.As(err, &phaseErr) {
+			phaseErr.WorkItem = name
+		}
+		return nil, err
+	}
+
+	// A proposed item can't start until its dependencies are completed
+	if item.Status == StatusProposed {
+		if unmet := unmetDependencies(item, s.dependencyStatus); len(unmet) > 0 {
+			return nil, &PhaseError{WorkItem: name, CurrentPhase: item.Phase, TargetPhase: nextPhase, Reason: blockedReason(unmet)}
+		}
+	}
+
+	// Check the configured preconditions for leaving the current phase
+	if err := s.validatePhaseRequirements(item, nextPhase); err != nil {
+		return nil, err
 	}
 
 	// Update phase and status in file
 	if err := s.updater.UpdatePhaseAndStatus(readmePath, nextPhase, nextStatus); err != nil {
-		return &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to update phase: %w", err)}
+		return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to update phase: %w", err)}
 	}
 
-	// Create git branch for new phase if git is enabled
-	if s.config.EnableGit {
+	// Starting a proposed item enters discovery even though its phase line
+	// already says so
+	if nextPhase != item.Phase || item.Status == StatusProposed {
+		if err := s.updater.AppendPhaseHistory(readmePath, nextPhase, time.Now()); err != nil {
+			return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to record phase history: %w", err)}
+		}
+	}
+
+	s.recordChanges(filepath.Dir(readmePath),
+		fieldChange{field: HistoryFieldStatus, old: string(item.Status), new: string(nextStatus)},
+		fieldChange{field: HistoryFieldPhase, old: string(item.Phase), new: string(nextPhase)},
+	)
+
+	// Hand the item to the configured reviewer on entering review
+	if nextStatus == StatusInProgressReview && s.needsReviewer(item) {
+		if err := s.updater.UpdateAssignee(readmePath, s.config.Reviewer); err != nil {
+			return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: fmt.Errorf("failed to assign reviewer: %w", err)}
+		}
+	}
+
+	if err := s.relocateByStatus(name, nextStatus); err != nil {
+		return nil, &WorkItemError{Op: "advance_phase", Name: name, Err: err}
+	}
+
+	s.autoCommit(fmt.Sprintf("pm: advance %s to %s", name, nextPhase), filepath.Dir(readmePath), s.itemDir(name))
+
+	// Create git branch for new phase if git and branch-per-phase are enabled;
+	// otherwise work continues on the work item's single branch
+	if s.config.EnableGit && s.config.BranchPerPhase {
 		if err := s.git.CreateWorkItemBranchForPhase(item.Type, item.Name, nextPhase); err != nil {
 			// Log but don't fail
 			fmt.Printf("Warning: Git branch creation failed: %v\n", err)
 		}
 	}
 
-	return nil
+	return incomplete, nil
+}
+
+// PreviewAdvance reports what AdvancePhase would do to a work item without
+// writing anything: the current and next phase and status, the current phase's
+// incomplete tasks, unmet phase requirements, and the reviewer the item would
+// be handed to. Blockers lists every reason the advance would fail, using the
+// same checks as AdvancePhase, so CanAdvance predicts its outcome.
+//
+// Example:
+//
+//	preview, err := service.PreviewAdvance(ctx, "feature-user-auth")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if !preview.CanAdvance() {
+//		fmt.Println(strings.Join(preview.Blockers, "\n"))
+//	}
+func (s *WorkItemService) PreviewAdvance(ctx context.Context, name string) (*AdvancePreview, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "preview_advance", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "preview_advance", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+
+	preview := &AdvancePreview{
+		Name:              name,
+		CurrentPhase:      item.Phase,
+		CurrentStatus:     item.Status,
+		IncompleteTasks:   []Task{},
+		UnmetRequirements: []string{},
+		Blockers:          []string{},
+	}
+	if item.Status == StatusCompleted {
+		preview.Blockers = append(preview.Blockers, (&AlreadyCompletedError{WorkItem: name}).Error())
+		return preview, nil
+	}
+
+	preview.IncompleteTasks = append(preview.IncompleteTasks, s.incompletePhaseTasks(item)...)
+	if s.config.PhaseAdvanceStrict {
+		for _, task := range preview.IncompleteTasks {
+			preview.Blockers = append(preview.Blockers, fmt.Sprintf("task '%s' is not completed", task.Description))
+		}
+	}
+
+	nextPhase, nextStatus, err := s.getNextPhase(item.Phase, item.Status)
+	if err != nil {
+		var phaseErr *PhaseError
+		if !errors.As(err, &phaseErr) {
+			return nil, err
+		}
+		preview.Blockers = append(preview.Blockers, phaseErr.Reason)
+		return preview, nil
+	}
+	preview.NextPhase, preview.NextStatus = nextPhase, nextStatus
+
+	if item.Status == StatusProposed {
+		if unmet := unmetDependencies(item, s.dependencyStatus); len(unmet) > 0 {
+			preview.Blockers = append(preview.Blockers, blockedReason(unmet))
+		}
+	}
+
+	preview.UnmetRequirements = append(preview.UnmetRequirements, s.unmetPhaseRequirements(item)...)
+	if len(preview.UnmetRequirements) > 0 {
+		preview.Blockers = append(preview.Blockers, "phase requirements not met: "+strings.Join(preview.UnmetRequirements, "; "))
+	}
+
+	if nextStatus == StatusInProgressReview && s.needsReviewer(item) {
+		preview.NewAssignee = s.config.Reviewer
+	}
+	return preview, nil
+}
+
+// needsReviewer reports whether an item entering review should be assigned to
+// the configured reviewer. Items already handed to someone other than their
+// author keep their assignee; the template's generic "agent" and "human"
+// assignees do not count as a reviewer.
+func (s *WorkItemService) needsReviewer(item WorkItem) bool {
+	if s.config.Reviewer == "" {
+		return false
+	}
+	switch strings.ToLower(item.AssignedTo) {
+	case "", "agent", "human":
+		return true
+	}
+	return item.CreatedBy != "" && strings.EqualFold(item.AssignedTo, item.CreatedBy)
+}
+
+// SplitWorkItem breaks a work item into child items of the same type.
+// Each child is created from the template, inherits the parent's priority and
+// links back with a "child-of" related item; the parent gains a "parent-of"
+// link per child and the "tracking" label. Tasks are not moved: the children
+// start with their own template tasks. No child is created if any already exists.
+//
+// Example:
+//
+//	children, err := service.SplitWorkItem(ctx, "feature-checkout", []string{"checkout-api", "checkout-ui"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// Created feature-checkout-api and feature-checkout-ui, linked to feature-checkout
+func (s *WorkItemService) SplitWorkItem(ctx context.Context, name string, newNames []string) ([]*WorkItem, error) {
+	if err := validateWorkItemName(name); err != nil {
+		return nil, err
+	}
+
+	if len(newNames) == 0 {
+		return nil, &ValidationError{Field: "newNames", Value: "", Message: "at least one child name is required"}
+	}
+
+	readmePath := filepath.Join(s.itemDir(name), s.config.WorkItemFile)
+	if !s.fs.FileExists(readmePath) {
+		return nil, &WorkItemError{Op: "split", Name: name, Err: s.missingReadmeError(filepath.Dir(readmePath))}
+	}
+
+	parent, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		return nil, &WorkItemError{Op: "split", Name: name, Err: fmt.Errorf("failed to parse work item: %w", err)}
+	}
+	if parent.Type == "" {
+		return nil, &WorkItemError{Op: "split", Name: name, Err: fmt.Errorf("cannot infer work item type from name")}
+	}
+
+	// Validate every child up front so a bad name doesn't leave a partial split
+	seen := make(map[string]bool)
+	for _, newName := range newNames {
+		req := CreateRequest{Type: parent.Type, Name: newName}
+		if err := s.validateCreateRequest(req); err != nil {
+			return nil, err
+		}
+		if seen[newName] {
+			return nil, &ValidationError{Field: "newNames", Value: newName, Message: "duplicate child name"}
+		}
+		seen[newName] = true
+		if s.fs.DirectoryExists(s.getWorkItemPath(parent.Type, newName)) {
+			return nil, &ValidationError{Field: "newNames", Value: newName, Message: "work item already exists"}
+		}
+	}
+
+	var children []*WorkItem
+	for _, newName := range newNames {
+		if err := ctx.Err(); err != nil {
+			return children, err
+		}
+		child, err := s.CreateWorkItem(ctx, CreateRequest{Type: parent.Type, Name: newName, Priority: parent.Priority, Parent: name})
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+
+	// Mark the parent as a tracking item
+	labels := parent.Labels
+	if !slices.Contains(labels, TrackingLabel) {
+		labels = append(labels, TrackingLabel)
+		if err := s.updater.UpdateLabels(readmePath, labels); err != nil {
+			return nil, &WorkItemError{Op: "split", Name: name, Err: fmt.Errorf("failed to label parent: %w", err)}
+		}
+	}
+
+	return children, nil
 }
 
 // updateProgressFromTasks recalculates and updates progress based on task completion
@@ -532,39 +1899,119 @@ func (s *WorkItemService) updateProgressFromTasks(readmePath string) error {
 
 // validatePhaseTasksCompleted checks that all tasks in the current phase are completed
 func (s *WorkItemService) validatePhaseTasksCompleted(item WorkItem) error {
+	// Check if all phase tasks are completed
+	if incomplete := s.incompletePhaseTasks(item); len(incomplete) > 0 {
+		return &PhaseError{
+			WorkItem:     item.Name,
+			CurrentPhase: item.Phase,
+			TargetPhase:  "",
+			Reason:       fmt.Sprintf("task '%s' is not completed", incomplete[0].Description),
+		}
+	}
+
+	return nil
+}
+
+// validatePhaseRequirements checks the Config.PhaseRequirements for the item's
+// current phase, reporting every unmet condition in one PhaseError. Like task
+// completion, requirements aren't checked when starting work on a PROPOSED item.
+func (s *WorkItemService) validatePhaseRequirements(item WorkItem, nextPhase WorkPhase) error {
+	unmet := s.unmetPhaseRequirements(item)
+	if len(unmet) == 0 {
+		return nil
+	}
+	return &PhaseError{
+		WorkItem:     item.Name,
+		CurrentPhase: item.Phase,
+		TargetPhase:  nextPhase,
+		Reason:       "phase requirements not met: " + strings.Join(unmet, "; "),
+	}
+}
+
+// unmetPhaseRequirements lists the Config.PhaseRequirements for the item's
+// current phase that it doesn't meet. PROPOSED items have none.
+func (s *WorkItemService) unmetPhaseRequirements(item WorkItem) []string {
+	req, ok := s.config.PhaseRequirements[item.Phase]
+	if !ok || item.Status == StatusProposed {
+		return nil
+	}
+
+	var unmet []string
+	if req.Overview && !s.overviewFilled(item) {
+		unmet = append(unmet, "the overview is not filled in")
+	}
+	if req.Assignee && item.AssignedTo == "" {
+		unmet = append(unmet, "no assignee is set")
+	}
+	if req.MinTasks > 0 {
+		count := 0
+		for _, task := range item.Tasks {
+			if task.Phase == item.Phase {
+				count++
+			}
+		}
+		if count < req.MinTasks {
+			unmet = append(unmet, fmt.Sprintf("the phase has %d task(s), at least %d required", count, req.MinTasks))
+		}
+	}
+	return unmet
+}
+
+// overviewFilled reports whether the item's overview has content of its own,
+// rather than being empty or still reading as its template's text
+func (s *WorkItemService) overviewFilled(item WorkItem) bool {
+	if item.Summary == "" {
+		return false
+	}
+	slug := strings.TrimPrefix(item.Name, string(item.Type)+"-")
+	template, err := s.templater.RenderTemplate(slug, item.Type, nil)
+	if err != nil {
+		return true
+	}
+	return !strings.Contains(strings.Join(strings.Fields(template), " "), item.Summary)
+}
+
+// incompletePhaseTasks returns the current phase's unresolved tasks that block
+// advancing the item; not applicable tasks count as resolved
+func (s *WorkItemService) incompletePhaseTasks(item WorkItem) []Task {
 	// Only validate task completion when actively working in a phase (IN_PROGRESS statuses)
 	// PROPOSED status allows advancing to start working without requiring task completion
 	if item.Status == StatusProposed {
 		return nil
 	}
 
-	// Filter tasks by current phase
-	var phaseTasks []Task
+	var incomplete []Task
 	for _, task := range item.Tasks {
-		if task.Phase == item.Phase {
-			phaseTasks = append(phaseTasks, task)
+		if task.Phase == item.Phase && !task.Resolved() {
+			incomplete = append(incomplete, task)
 		}
 	}
+	return incomplete
+}
 
-	// Check if all phase tasks are completed
-	for _, task := range phaseTasks {
-		if !task.Completed {
-			return &PhaseError{
-				WorkItem:     item.Name,
-				CurrentPhase: item.Phase,
-				TargetPhase:  "",
-				Reason:       fmt.Sprintf("task '%s' is not completed", task.Description),
-			}
-		}
+// validateWorkItemName rejects names that are empty or could resolve outside
+// the backlog directory: path separators, "." and "..", names that change when
+// cleaned, and absolute or volume paths. Every public method taking a work item
+// name calls it before resolving the name with itemDir.
+func validateWorkItemName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Value: name, Message: "name cannot be empty"}
+	}
+	if name == "." || filepath.Clean(name) != name || strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return &ValidationError{Field: "name", Value: name, Message: "name cannot contain path separators, \".\" or \"..\""}
 	}
-
 	return nil
 }
 
 // validateCreateRequest validates a create request
 func (s *WorkItemService) validateCreateRequest(req CreateRequest) error {
-	if req.Name == "" {
-		return &ValidationError{Field: "name", Value: req.Name, Message: "name cannot be empty"}
+	if err := validateWorkItemName(req.Name); err != nil {
+		return err
+	}
+	if req.Parent != "" {
+		if err := validateWorkItemName(req.Parent); err != nil {
+			return err
+		}
 	}
 
 	if req.Type == "" {
@@ -581,10 +2028,130 @@ func (s *WorkItemService) validateCreateRequest(req CreateRequest) error {
 		return &ValidationError{Field: "type", Value: string(req.Type), Message: "invalid work item type"}
 	}
 
-	// Check if work item already exists
-	workDir := s.getWorkItemPath(req.Type, req.Name)
-	if s.fs.DirectoryExists(workDir) {
-		return &ValidationError{Field: "name", Value: req.Name, Message: "work item already exists"}
+	if req.Priority != "" {
+		if err := s.validatePriority(req.Priority); err != nil {
+			return err
+		}
+	}
+
+	if err := s.validateInitialState(req); err != nil {
+		return err
+	}
+
+	if !req.IfNotExists {
+		return s.checkNameAvailable(req.Type, req.Name)
+	}
+
+	return nil
+}
+
+// checkNameAvailable returns a ValidationError when a backlog work item of
+// itemType named name already exists
+func (s *WorkItemService) checkNameAvailable(itemType ItemType, name string) error {
+	if s.fs.DirectoryExists(s.getWorkItemPath(itemType, name)) {
+		return &ValidationError{Field: "name", Value: name, Message: "work item already exists"}
+	}
+	return nil
+}
+
+// checkBacklogWritable fails with the resolved absolute path when the backlog
+// directory can't be created or written to, e.g. in a read-only checkout
+func (s *WorkItemService) checkBacklogWritable() error {
+	if err := s.fs.CheckWritable(s.config.BacklogDir); err != nil {
+		dir := s.config.BacklogDir
+		if abs, absErr := filepath.Abs(dir); absErr == nil {
+			dir = abs
+		}
+		return fmt.Errorf("backlog directory %s is not writable (check the path and its permissions, or set backlog_dir): %w", dir, err)
+	}
+	return nil
+}
+
+// validateInitialState checks the optional initial status and phase of a create
+// request, and that the phase is the one the status is worked in
+func (s *WorkItemService) validateInitialState(req CreateRequest) error {
+	if req.InitialStatus != "" {
+		if err := s.validateStatus(req.InitialStatus); err != nil {
+			return err
+		}
+	}
+	if req.InitialPhase == "" {
+		return nil
+	}
+	if err := s.validatePhase(req.InitialPhase); err != nil {
+		return err
+	}
+
+	status := req.InitialStatus
+	if status == "" {
+		status = StatusProposed
+	}
+	if expected := phaseForStatus(status); req.InitialPhase != expected {
+		return &ValidationError{
+			Field:   "phase",
+			Value:   string(req.InitialPhase),
+			Message: fmt.Sprintf("status %s expects phase %s", status, expected),
+		}
+	}
+	return nil
+}
+
+// CurrentUser returns the identity of whoever runs go-pm: the git user name,
+// falling back to the OS user when git is unavailable or has no user
+// configured. New work items record it as their author.
+func (s *WorkItemService) CurrentUser() string {
+	if name, err := s.git.GetUserName(); err == nil && name != "" {
+		return name
+	}
+	return historyActor()
+}
+
+// applyCreateFields writes the optional create request fields into a freshly
+// rendered README. Empty fields keep the template defaults.
+func (s *WorkItemService) applyCreateFields(readmePath string, req CreateRequest) error {
+	if req.Title != "" {
+		if err := s.updater.UpdateTitle(readmePath, req.Title); err != nil {
+			return err
+		}
+	}
+	if req.Priority != "" {
+		if err := s.updater.UpdatePriority(readmePath, req.Priority); err != nil {
+			return err
+		}
+	}
+	if len(req.Labels) > 0 {
+		if err := s.updater.UpdateLabels(readmePath, req.Labels); err != nil {
+			return err
+		}
+	}
+	if req.Assignee != "" {
+		if err := s.updater.UpdateAssignee(readmePath, req.Assignee); err != nil {
+			return err
+		}
+	}
+	if req.InitialStatus != "" || req.InitialPhase != "" {
+		status := req.InitialStatus
+		if status == "" {
+			status = StatusProposed
+		}
+		if err := s.updater.UpdatePhaseAndStatus(readmePath, phaseForStatus(status), status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePriority validates a work item priority
+func (s *WorkItemService) validatePriority(priority Priority) error {
+	validPriorities := map[Priority]bool{
+		PriorityLow:      true,
+		PriorityMedium:   true,
+		PriorityHigh:     true,
+		PriorityCritical: true,
+	}
+
+	if !validPriorities[priority] {
+		return &ValidationError{Field: "priority", Value: string(priority), Message: "invalid priority"}
 	}
 
 	return nil
@@ -627,8 +2194,13 @@ func (s *WorkItemService) validatePhase(phase WorkPhase) error {
 
 // getWorkItemPath returns the full path for a work item
 func (s *WorkItemService) getWorkItemPath(itemType ItemType, name string) string {
-	dirName := s.getWorkItemDirName(itemType, name)
-	return filepath.Join(s.config.BacklogDir, dirName)
+	return s.itemDir(s.getWorkItemDirName(itemType, name))
+}
+
+// BranchName returns the git branch CreateWorkItem creates for a work item of
+// itemType named name, following the configured branch prefix and separator.
+func (s *WorkItemService) BranchName(itemType ItemType, name string) string {
+	return s.git.BranchName(itemType, name)
 }
 
 // getWorkItemDirName returns the directory name for a work item
@@ -636,6 +2208,9 @@ func (s *WorkItemService) getWorkItemDirName(itemType ItemType, name string) str
 	return fmt.Sprintf("%s-%s", itemType, name)
 }
 
+//go:embed templates/postmortem.md
+var embeddedTemplatePostmortem string
+
 //go:embed templates/workitem-bug.md
 var embeddedTemplateWorkItemBug string
 
@@ -645,30 +2220,94 @@ var embeddedTemplateWorkItemExperiment string
 //go:embed templates/workitem-feature.md
 var embeddedTemplateWorkItemFeature string
 
-// listWorkItemsInDir lists all work items in a directory
-func (s *WorkItemService) listWorkItemsInDir(dir string) ([]WorkItem, error) {
+// streamWorkItemsInDir calls fn for each work item in a directory, in
+// directory order. Up to Config.ListConcurrency READMEs are parsed ahead of fn
+// in parallel; with 1 they are parsed one at a time on the calling goroutine.
+// Only each item's README.md drives its metadata; sibling files such as
+// NOTES.md, POSTMORTEM.md and history.jsonl are never parsed.
+func (s *WorkItemService) streamWorkItemsInDir(ctx context.Context, dir string, fn func(WorkItem) error) error {
 	dirs, err := s.fs.ListDirectories(dir)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return []WorkItem{}, nil
+			return nil
 		}
-		return nil, err
+		return fmt.Errorf("failed to list backlog items: %w", err)
 	}
 
-	var items []WorkItem
-	for _, name := range dirs {
-		readmePath := filepath.Join(dir, name, "README.md")
-		if s.fs.FileExists(readmePath) {
-			item, err := s.parser.ParseWorkItem(name, readmePath)
-			if err != nil {
-				// Skip items that can't be parsed
-				continue
+	if s.config.ListConcurrency == 1 {
+		for _, name := range dirs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if item, ok := s.parseDirItem(dir, name); ok {
+				if err := fn(item); err != nil {
+					return err
+				}
 			}
-			items = append(items, item)
 		}
+		return nil
 	}
 
-	return items, nil
+	type parsed struct {
+		item WorkItem
+		ok   bool
+	}
+	results := make([]chan parsed, len(dirs))
+	for i := range results {
+		results[i] = make(chan parsed, 1)
+	}
+
+	// A slot is taken per README being parsed and freed once fn has seen it,
+	// so at most ListConcurrency items are held in memory
+	slots := make(chan struct{}, s.config.ListConcurrency)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for i, name := range dirs {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+			go func() {
+				item, ok := s.parseDirItem(dir, name)
+				results[i] <- parsed{item: item, ok: ok}
+			}()
+		}
+	}()
+
+	for i := range dirs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result := <-results[i]
+		<-slots
+		if !result.ok {
+			continue
+		}
+		if err := fn(result.item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDirItem parses the work item in directory name under dir, reporting
+// false for directories without a README and READMEs that can't be parsed
+func (s *WorkItemService) parseDirItem(dir, name string) (WorkItem, bool) {
+	readmePath := filepath.Join(dir, name, s.config.WorkItemFile)
+	// Directories without a README are reported by FindMissingReadmes
+	if !s.fs.FileExists(readmePath) {
+		return WorkItem{}, false
+	}
+	item, err := s.parser.ParseWorkItem(name, readmePath)
+	if err != nil {
+		// Skip items that can't be parsed
+		return WorkItem{}, false
+	}
+	s.checkProgressMismatch(&item, false)
+	return item, true
 }
 
 // matchesFilter checks if a work item matches the filter criteria
@@ -681,6 +2320,38 @@ func (s *WorkItemService) matchesFilter(item WorkItem, filter ListFilter) bool {
 		return false
 	}
 
+	if filter.Phase != "" && item.Phase != filter.Phase {
+		return false
+	}
+
+	if filter.Priority != "" && item.Priority != filter.Priority {
+		return false
+	}
+
+	for _, label := range filter.Labels {
+		if !slices.Contains(item.Labels, label) {
+			return false
+		}
+	}
+
+	if filter.CreatedBy != "" && !strings.EqualFold(item.CreatedBy, filter.CreatedBy) {
+		return false
+	}
+
+	names := []string{item.Name, strings.TrimPrefix(item.Name, string(item.Type)+"-")}
+	if filter.NamePrefix != "" && !slices.ContainsFunc(names, func(name string) bool {
+		return strings.HasPrefix(name, filter.NamePrefix)
+	}) {
+		return false
+	}
+
+	if filter.NameGlob != "" && !slices.ContainsFunc(names, func(name string) bool {
+		matched, _ := path.Match(filter.NameGlob, name)
+		return matched
+	}) {
+		return false
+	}
+
 	return true
 }
 
@@ -704,7 +2375,7 @@ func (s *WorkItemService) getNextPhase(currentPhase WorkPhase, currentStatus Ite
 			WorkItem:     "",
 			CurrentPhase: currentPhase,
 			TargetPhase:  "",
-			Reason:       "cannot advance from current status",
+			Reason:       fmt.Sprintf("cannot advance from status %q", currentStatus),
 		}
 	}
 }
@@ -1,7 +1,9 @@
 package pm
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,6 +52,169 @@ User authentication feature
 	assert.Equal(t, "Interview stakeholders", item.Tasks[1].Description)
 }
 
+func TestWorkItemParserTaskGroups(t *testing.T) {
+	fs := NewMockFileSystem()
+	parser := NewWorkItemParser(fs)
+
+	content := `# Feature: groups
+
+## Execution Phase
+
+### Tasks
+- [ ] Write docs
+
+### Backend
+- [x] Add API endpoint
+- [ ] Add migration
+
+### Frontend
+- [ ] Build form
+
+## Cleanup Phase
+- [ ] Final review
+`
+
+	fs.WriteFile("/tmp/test.md", []byte(content)) //nolint:errcheck
+
+	item, err := parser.ParseWorkItem("feature-groups", "/tmp/test.md")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 5)
+
+	assert.Equal(t, "", item.Tasks[0].Group)
+	assert.Equal(t, "Backend", item.Tasks[1].Group)
+	assert.Equal(t, "Backend", item.Tasks[2].Group)
+	assert.Equal(t, "Frontend", item.Tasks[3].Group)
+	// A new "##" section ends the group
+	assert.Equal(t, "", item.Tasks[4].Group)
+	assert.Equal(t, PhaseCleanup, item.Tasks[4].Phase)
+}
+
+func TestWorkItemParserTaskDueDates(t *testing.T) {
+	fs := NewMockFileSystem()
+	parser := NewWorkItemParser(fs)
+
+	content := `# Feature: deadlines
+
+## Execution Phase
+
+### Tasks
+- [ ] Ship beta (due: 2024-06-01)
+- [x] Write docs (due: 2024-05-01)
+- [ ] Refactor (see design doc)
+- [ ] Fix typo (due: soon)
+`
+	require.NoError(t, fs.WriteFile("/tmp/test.md", []byte(content)))
+
+	item, err := parser.ParseWorkItem("feature-deadlines", "/tmp/test.md")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 4)
+
+	assert.Equal(t, "Ship beta", item.Tasks[0].Description)
+	assert.Equal(t, "2024-06-01", item.Tasks[0].DueDate.Format("2006-01-02"))
+	assert.Equal(t, "Refactor (see design doc)", item.Tasks[2].Description, "other parentheses are kept")
+	assert.True(t, item.Tasks[2].DueDate.IsZero())
+	assert.Equal(t, "Fix typo (due: soon)", item.Tasks[3].Description, "invalid dates are kept")
+
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.Local)
+	assert.True(t, item.Tasks[0].IsOverdue(now))
+	assert.False(t, item.Tasks[0].IsOverdue(time.Date(2024, 6, 1, 12, 0, 0, 0, time.Local)), "due through the end of the day")
+	assert.False(t, item.Tasks[1].IsOverdue(now), "completed tasks are never overdue")
+	assert.False(t, item.Tasks[2].IsOverdue(now))
+}
+
+func TestWorkItemParserTaskEstimates(t *testing.T) {
+	fs := NewMockFileSystem()
+	parser := NewWorkItemParser(fs)
+
+	content := `# Feature: estimates
+
+## Execution Phase
+
+### Tasks
+- [ ] Ship beta (estimate: 2h) (due: 2024-06-01)
+- [ ] Write docs (estimate: 1.5d)
+- [ ] Load test (estimate: 1w)
+- [ ] Refactor (estimate: soon)
+`
+	require.NoError(t, fs.WriteFile("/tmp/test.md", []byte(content)))
+
+	item, err := parser.ParseWorkItem("feature-estimates", "/tmp/test.md")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 4)
+
+	assert.Equal(t, "Ship beta", item.Tasks[0].Description)
+	assert.Equal(t, 2*time.Hour, item.Tasks[0].Estimate)
+	assert.Equal(t, "2024-06-01", item.Tasks[0].DueDate.Format("2006-01-02"))
+	assert.Equal(t, 12*time.Hour, item.Tasks[1].Estimate, "a day is 8 working hours")
+	assert.Equal(t, 40*time.Hour, item.Tasks[2].Estimate)
+	assert.Equal(t, "Refactor (estimate: soon)", item.Tasks[3].Description, "invalid estimates are kept")
+	assert.Zero(t, item.Tasks[3].Estimate)
+}
+
+func TestWorkItemParserSummary(t *testing.T) {
+	fs := NewMockFileSystem()
+	parser := NewWorkItemParser(fs)
+
+	testCases := []struct {
+		name     string
+		overview string
+		expected string
+	}{
+		{"paragraph", "## Overview\n\nLets users sign in\nwith SSO.\n\nSecond paragraph.\n", "Lets users sign in with SSO."},
+		{"stops at heading", "## Overview\nShort summary.\n## Requirements\n- Requirement 1\n", "Short summary."},
+		{"stops at separator", "## Overview\nAbove the line.\n---\nBelow the line.\n", "Above the line."},
+		{"empty overview", "## Overview\n\n## Requirements\nNot the overview.\n", ""},
+		{"no overview", "## Requirements\nNot the overview.\n", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := "# Feature: summary\n\n## Status: PROPOSED\n\n" + tc.overview
+			require.NoError(t, fs.WriteFile("/tmp/test.md", []byte(content)))
+
+			item, err := parser.ParseWorkItem("feature-summary", "/tmp/test.md")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, item.Summary)
+		})
+	}
+}
+
+func TestStatusUpdaterCompleteTaskRecordsDate(t *testing.T) {
+	fs := NewMockFileSystem()
+	updater := NewStatusUpdater(fs)
+	parser := NewWorkItemParser(fs)
+
+	content := `# Feature: stamps
+
+## Execution Phase
+
+### Tasks
+- [ ] Ship beta (due: 2024-06-01)
+- [x] Write docs (done: 2024-05-10)
+`
+	require.NoError(t, fs.WriteFile("/tmp/test.md", []byte(content)))
+
+	require.NoError(t, updater.CompleteTask("/tmp/test.md", 0))
+	require.NoError(t, updater.CompleteTask("/tmp/test.md", 0))
+	require.NoError(t, updater.CompleteTask("/tmp/test.md", 1))
+
+	data, err := fs.ReadFile("/tmp/test.md")
+	require.NoError(t, err)
+	today := time.Now().Format("2006-01-02")
+	assert.Contains(t, string(data), "- [x] Ship beta (due: 2024-06-01) (done: "+today+")\n")
+	assert.Equal(t, 1, strings.Count(string(data), "(done: "+today+")"), "re-completing doesn't add another annotation")
+	assert.Contains(t, string(data), "- [x] Write docs (done: 2024-05-10)\n")
+
+	item, err := parser.ParseWorkItem("feature-stamps", "/tmp/test.md")
+	require.NoError(t, err)
+	require.Len(t, item.Tasks, 2)
+	assert.Equal(t, "Ship beta", item.Tasks[0].Description)
+	assert.Equal(t, today, item.Tasks[0].CompletedAt.Format("2006-01-02"))
+	assert.Equal(t, "2024-06-01", item.Tasks[0].DueDate.Format("2006-01-02"))
+	assert.Equal(t, "Write docs", item.Tasks[1].Description)
+	assert.Equal(t, "2024-05-10", item.Tasks[1].CompletedAt.Format("2006-01-02"))
+}
+
 func TestStatusUpdater(t *testing.T) {
 	fs := NewMockFileSystem()
 	updater := NewStatusUpdater(fs)
@@ -185,9 +350,9 @@ func TestTaskParser(t *testing.T) {
 
 func TestPostmortemGenerator(t *testing.T) {
 	fs := NewMockFileSystem()
-	gen := NewPostmortemGenerator(fs)
+	gen := NewPostmortemGenerator(fs, DefaultConfig())
 
-	err := gen.GeneratePostmortem("/tmp/completed/feature-test", "feature-test")
+	err := gen.GeneratePostmortem("/tmp/completed/feature-test", WorkItem{Name: "feature-test"})
 	require.NoError(t, err)
 
 	content, err := fs.ReadFile("/tmp/completed/feature-test/POSTMORTEM.md")
@@ -195,4 +360,33 @@ func TestPostmortemGenerator(t *testing.T) {
 	assert.Contains(t, string(content), "# Postmortem: feature-test")
 	assert.Contains(t, string(content), "## What Went Well")
 	assert.Contains(t, string(content), "## What Could Be Improved")
+	assert.Contains(t, string(content), time.Now().Format("2006-01-02"))
+}
+
+func TestPostmortemGeneratorCustomTemplate(t *testing.T) {
+	fs := NewMockFileSystem()
+	config := DefaultConfig()
+	config.PostmortemTemplate = "/templates/incident-review.md"
+	require.NoError(t, fs.WriteFile(config.PostmortemTemplate, []byte(
+		"# Incident Review: {{title}} ({{name}})\nTasks: {{completed_tasks}}/{{total_tasks}}\nTime spent: {{time_spent}}\nOpened: {{created}}\n")))
+	gen := NewPostmortemGenerator(fs, config)
+
+	item := WorkItem{
+		Name:      "bug-outage",
+		Title:     "Outage",
+		Type:      TypeBug,
+		CreatedAt: time.Now().Add(-50 * time.Hour),
+		Tasks:     []Task{{Completed: true}, {Completed: false}, {Completed: true}},
+	}
+	require.NoError(t, gen.GeneratePostmortem("/completed/bug-outage", item))
+
+	content, err := fs.ReadFile("/completed/bug-outage/POSTMORTEM.md")
+	require.NoError(t, err)
+	assert.Equal(t, "# Incident Review: Outage (bug-outage)\nTasks: 2/3\nTime spent: 2d 2h\nOpened: "+
+		item.CreatedAt.Format("2006-01-02")+"\n", string(content))
+
+	// A configured template that can't be read is an error rather than a silent fallback
+	config.PostmortemTemplate = "/templates/missing.md"
+	err = NewPostmortemGenerator(fs, config).GeneratePostmortem("/completed/bug-outage", item)
+	assert.Error(t, err)
 }